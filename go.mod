@@ -3,15 +3,20 @@ module github.com/itiky/mdb-tutorial
 go 1.14
 
 require (
+	github.com/aws/aws-sdk-go v1.34.28
 	github.com/docker/go-connections v0.4.0
 	github.com/golang/protobuf v1.4.2
+	github.com/klauspost/compress v1.9.7
+	github.com/pkg/sftp v1.12.0
 	github.com/sirupsen/logrus v1.7.0
 	github.com/spf13/cobra v1.0.0
 	github.com/spf13/pflag v1.0.3
 	github.com/spf13/viper v1.7.1
 	github.com/stretchr/testify v1.6.1
 	github.com/testcontainers/testcontainers-go v0.9.0
+	github.com/xitongsys/parquet-go v1.5.1
 	go.mongodb.org/mongo-driver v1.4.2
+	golang.org/x/crypto v0.0.0-20200820211705-5c72a883971a
 	golang.org/x/net v0.0.0-20201010224723-4f7140c49acb // indirect
 	golang.org/x/sys v0.0.0-20201009025420-dfb3f7c4e634 // indirect
 	google.golang.org/genproto v0.0.0-20201009135657-4d944d34d83c // indirect
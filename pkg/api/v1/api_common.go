@@ -2,6 +2,7 @@ package v1
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/itiky/mdb-tutorial/pkg/common"
 )
@@ -31,3 +32,13 @@ func NewOrderOption(apiParam SortOrder) (common.Order, bool) {
 		return common.AscOrder, false
 	}
 }
+
+// unixMilli converts DateTime to UNIX-time [ms] (stored DateTimes have milliseconds precision).
+func unixMilli(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+// fromUnixMilli converts UNIX-time [ms] to UTC DateTime.
+func fromUnixMilli(ms int64) time.Time {
+	return time.Unix(0, ms*int64(time.Millisecond)).UTC()
+}
@@ -3,45 +3,276 @@ package v1
 import (
 	"context"
 	"errors"
-	"os"
+	"fmt"
+	"io"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/itiky/mdb-tutorial/pkg/common"
+	"github.com/itiky/mdb-tutorial/pkg/model"
 )
 
 // Fetch implements CSVFetcherServer interface.
 func (s gRPCServer) Fetch(ctx context.Context, req *CSVFetchRequest) (*CSVFetchResponse, error) {
-	// download file
-	tmpFilePath, importTimestamp, err := s.service.CSVProcessor().Download(req.Url)
+	// parse inputs
+	csvParams, err := s.newCSVProcessParams(req.Dialect)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	// enqueue import job (download and processing are done in background)
+	job, err := s.service.ImportJobs().Enqueue(ctx, model.ImportJobParams{
+		URL:       req.Url,
+		Force:     req.Force,
+		CSVParams: csvParams,
+	})
 	if err != nil {
 		if errors.Is(err, common.ErrInvalidInput) {
 			return nil, status.Errorf(codes.InvalidArgument, err.Error())
 		}
-		if errors.Is(err, common.ErrNotFound) {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return &CSVFetchResponse{
+		JobId: job.ID.Hex(),
+	}, nil
+}
+
+// Upload implements CSVFetcherServer interface.
+func (s gRPCServer) Upload(stream CSVFetcher_UploadServer) error {
+	// the first message defines the file name and format
+	req, err := stream.Recv()
+	if err != nil {
+		if err == io.EOF {
+			return status.Errorf(codes.InvalidArgument, "empty upload stream")
+		}
+		return status.Errorf(codes.Internal, "receiving: %v", err)
+	}
+	fileName, force := req.FileName, req.Force
+
+	csvParams, err := s.newCSVProcessParams(req.Dialect)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	// pipe received file parts to the processor
+	pipeReader, pipeWriter := io.Pipe()
+	go func(req *CSVUploadRequest) {
+		for {
+			if len(req.Data) > 0 {
+				if _, err := pipeWriter.Write(req.Data); err != nil {
+					// reader is closed: processing is over
+					return
+				}
+			}
+
+			nextReq, err := stream.Recv()
+			if err != nil {
+				if err == io.EOF {
+					err = nil
+				}
+				pipeWriter.CloseWithError(err)
+				return
+			}
+			req = nextReq
+		}
+	}(req)
+	defer pipeReader.Close()
+
+	job, err := s.service.ImportJobs().Upload(stream.Context(), fileName, pipeReader, csvParams, force)
+	if err != nil {
+		if errors.Is(err, common.ErrInvalidInput) {
+			return status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		return status.Errorf(codes.Internal, err.Error())
+	}
+
+	return stream.SendAndClose(&CSVUploadResponse{
+		Job: NewImportJob(job),
+	})
+}
+
+// GetImportJob implements CSVFetcherServer interface.
+func (s gRPCServer) GetImportJob(ctx context.Context, req *GetImportJobRequest) (*ImportJob, error) {
+	job, err := s.service.ImportJobs().Get(ctx, req.JobId)
+	if err != nil {
+		if errors.Is(err, common.ErrInvalidInput) {
 			return nil, status.Errorf(codes.InvalidArgument, err.Error())
 		}
+		if errors.Is(err, common.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
-	// cleanup
-	defer func() {
-		os.Remove(tmpFilePath)
-	}()
+	return NewImportJob(job), nil
+}
 
-	// open tmp file
-	file, err := os.Open(tmpFilePath)
+// ListImportJobs implements CSVFetcherServer interface.
+func (s gRPCServer) ListImportJobs(ctx context.Context, req *ListImportJobsRequest) (*ListImportJobsResponse, error) {
+	// parse inputs
+	paginationOption, err := NewPaginationOption(req.Pagination)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "tmp file open failed: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
-	defer file.Close()
 
-	// process with CSVImporter service handler
-	err = s.service.CSVProcessor().Process(ctx, file, importTimestamp, s.csvChunkSize, s.service.CSVImporter().ImportPrices)
+	// query and build response
+	jobs, err := s.service.ImportJobs().List(ctx, paginationOption)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "processing: %v", err)
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	response := &ListImportJobsResponse{}
+	for _, job := range jobs {
+		response.Jobs = append(response.Jobs, NewImportJob(job))
+	}
+
+	return response, nil
+}
+
+// NewImportJob converts model.ImportJob to gRPC ImportJob.
+func NewImportJob(inJob model.ImportJob) *ImportJob {
+	outJob := &ImportJob{
+		Id:               inJob.ID.Hex(),
+		Url:              inJob.URL,
+		Force:            inJob.Force,
+		State:            NewImportJobState(inJob.State),
+		ChunksProcessed:  int32(inJob.Progress.ChunksProcessed),
+		ChunksFailed:     int32(inJob.Progress.ChunksFailed),
+		EntriesProcessed: int32(inJob.Progress.EntriesProcessed),
+		Error:            inJob.Error,
+		CreatedAt:        inJob.CreatedAt.Unix(),
+		UpdatedAt:        inJob.UpdatedAt.Unix(),
+		ContentHash:      inJob.Source.ContentHash,
+		Etag:             inJob.Source.ETag,
+		Size:             inJob.Source.Size,
+		Dialect:          NewCSVDialect(inJob.Dialect),
+		Compression:      string(inJob.Source.Compression),
+		Atomicity:        string(inJob.Atomicity),
+	}
+	if !inJob.ImportTimestamp.IsZero() {
+		outJob.ImportTimestamp = inJob.ImportTimestamp.Unix()
+		outJob.ImportTimestampMs = unixMilli(inJob.ImportTimestamp)
+	}
+
+	for _, entry := range inJob.ArchiveEntries {
+		outJob.ArchiveEntries = append(outJob.ArchiveEntries, &ImportArchiveEntry{
+			Name:              entry.Name,
+			ImportTimestamp:   entry.ImportTimestamp.Unix(),
+			ImportTimestampMs: unixMilli(entry.ImportTimestamp),
+		})
+	}
+
+	for _, chunkErr := range inJob.ChunkErrors {
+		outJob.ChunkErrors = append(outJob.ChunkErrors, &ImportChunkError{
+			ChunkId:        int32(chunkErr.ChunkID),
+			ArchiveEntry:   chunkErr.ArchiveEntry,
+			ParsingErrors:  chunkErr.ParsingErrors,
+			ExecutionError: chunkErr.ExecutionError,
+		})
+	}
+
+	return outJob
+}
+
+// NewCSVDialect converts model.CSVDialect to gRPC CSVDialect.
+func NewCSVDialect(inDialect model.CSVDialect) *CSVDialect {
+	outDialect := &CSVDialect{
+		HasHeader:      inDialect.HasHeader,
+		ProductColumn:  inDialect.ProductColumn,
+		PriceColumn:    inDialect.PriceColumn,
+		IgnoreColumns:  inDialect.IgnoreColumns,
+		Currency:       inDialect.Currency,
+		CurrencyColumn: inDialect.CurrencyColumn,
+	}
+	if inDialect.Delimiter != 0 {
+		outDialect.Delimiter = string(inDialect.Delimiter)
+	}
+	if inDialect.Quote != 0 {
+		outDialect.Quote = string(inDialect.Quote)
 	}
+	if inDialect.Comment != 0 {
+		outDialect.Comment = string(inDialect.Comment)
+	}
+	if inDialect.DecimalSeparator != 0 {
+		outDialect.DecimalSeparator = string(inDialect.DecimalSeparator)
+	}
+
+	return outDialect
+}
 
-	return &CSVFetchResponse{}, nil
+// newCSVProcessParams builds model.CSVProcessParams using server defaults overridden by optional gRPC CSVDialect.
+func (s gRPCServer) newCSVProcessParams(apiDialect *CSVDialect) (model.CSVProcessParams, error) {
+	params := model.CSVProcessParams{
+		ChunkSize: s.csvChunkSize,
+		Workers:   s.csvChunkWorkers,
+		Dialect:   s.csvDialect,
+	}
+	if apiDialect == nil {
+		return params, nil
+	}
+
+	dialect := &params.Dialect
+	dialect.HasHeader = apiDialect.HasHeader
+	for _, charParam := range []struct {
+		name   string
+		value  string
+		target *rune
+	}{
+		{name: "delimiter", value: apiDialect.Delimiter, target: &dialect.Delimiter},
+		{name: "quote", value: apiDialect.Quote, target: &dialect.Quote},
+		{name: "comment", value: apiDialect.Comment, target: &dialect.Comment},
+		{name: "decimalSeparator", value: apiDialect.DecimalSeparator, target: &dialect.DecimalSeparator},
+	} {
+		if charParam.value == "" {
+			continue
+		}
+		chars := []rune(charParam.value)
+		if len(chars) != 1 {
+			return model.CSVProcessParams{}, fmt.Errorf("dialect: %s: should be a single char (%q)", charParam.name, charParam.value)
+		}
+		*charParam.target = chars[0]
+	}
+	if apiDialect.ProductColumn != "" {
+		dialect.ProductColumn = apiDialect.ProductColumn
+	}
+	if apiDialect.PriceColumn != "" {
+		dialect.PriceColumn = apiDialect.PriceColumn
+	}
+	if len(apiDialect.IgnoreColumns) > 0 {
+		dialect.IgnoreColumns = apiDialect.IgnoreColumns
+	}
+	if apiDialect.Currency != "" {
+		dialect.Currency = apiDialect.Currency
+	}
+	if apiDialect.CurrencyColumn != "" {
+		dialect.CurrencyColumn = apiDialect.CurrencyColumn
+	}
+
+	if err := params.Validate(); err != nil {
+		return model.CSVProcessParams{}, err
+	}
+
+	return params, nil
+}
+
+// NewImportJobState converts model.ImportJobState to gRPC ImportJobState.
+func NewImportJobState(state model.ImportJobState) ImportJobState {
+	switch state {
+	case model.ImportJobStateDownloading:
+		return ImportJobState_Downloading
+	case model.ImportJobStateProcessing:
+		return ImportJobState_Processing
+	case model.ImportJobStateDone:
+		return ImportJobState_Done
+	case model.ImportJobStateFailed:
+		return ImportJobState_Failed
+	case model.ImportJobStateSkipped:
+		return ImportJobState_Skipped
+	case model.ImportJobStateRolledBack:
+		return ImportJobState_RolledBack
+	default:
+		return ImportJobState_Queued
+	}
 }
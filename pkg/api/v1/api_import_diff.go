@@ -0,0 +1,94 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+	"github.com/itiky/mdb-tutorial/pkg/model"
+)
+
+// DiffImports implements PriceEntryReaderServer interface.
+func (s gRPCServer) DiffImports(ctx context.Context, req *DiffImportsRequest) (*DiffImportsResponse, error) {
+	// parse inputs
+	if req.FromTimestamp <= 0 && req.FromTimestampMs <= 0 || req.ToTimestamp <= 0 && req.ToTimestampMs <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "fromTimestamp / toTimestamp: should be GT 0")
+	}
+	from, to := time.Unix(req.FromTimestamp, 0).UTC(), time.Unix(req.ToTimestamp, 0).UTC()
+	if req.FromTimestampMs > 0 {
+		from = fromUnixMilli(req.FromTimestampMs)
+	}
+	if req.ToTimestampMs > 0 {
+		to = fromUnixMilli(req.ToTimestampMs)
+	}
+
+	// compare
+	diff, err := s.service.ImportDiff().Diff(ctx, from, to)
+	if err != nil {
+		if errors.Is(err, common.ErrInvalidInput) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, common.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	return NewDiffImportsResponse(diff), nil
+}
+
+// NewDiffImportsResponse converts model.ImportDiff to gRPC DiffImportsResponse.
+func NewDiffImportsResponse(diff model.ImportDiff) *DiffImportsResponse {
+	resp := &DiffImportsResponse{
+		FromTimestamp:   diff.FromTimestamp.Unix(),
+		ToTimestamp:     diff.ToTimestamp.Unix(),
+		FromTimestampMs: unixMilli(diff.FromTimestamp),
+		ToTimestampMs:   unixMilli(diff.ToTimestamp),
+		UnchangedCount:  int64(diff.UnchangedCount),
+	}
+
+	for _, products := range []struct {
+		in  []model.ProductPrices
+		out *[]*ProductPrices
+	}{
+		{in: diff.NewProducts, out: &resp.NewProducts},
+		{in: diff.RemovedProducts, out: &resp.RemovedProducts},
+	} {
+		for _, product := range products.in {
+			outProduct := &ProductPrices{ProductName: product.Name}
+			for _, price := range product.Prices {
+				outProduct.Prices = append(outProduct.Prices, NewPriceValue(price))
+			}
+			*products.out = append(*products.out, outProduct)
+		}
+	}
+
+	for _, changes := range []struct {
+		in  []model.PriceChange
+		out *[]*PriceChange
+	}{
+		{in: diff.Increased, out: &resp.Increased},
+		{in: diff.Decreased, out: &resp.Decreased},
+	} {
+		for _, change := range changes.in {
+			*changes.out = append(*changes.out, NewPriceChange(change))
+		}
+	}
+
+	return resp
+}
+
+// NewPriceChange converts model.PriceChange to gRPC PriceChange.
+func NewPriceChange(change model.PriceChange) *PriceChange {
+	return &PriceChange{
+		ProductName:  change.Name,
+		From:         NewPriceValue(change.From),
+		To:           NewPriceValue(change.To),
+		Delta:        change.Delta.Amount.String(),
+		DeltaPercent: change.DeltaPercent,
+	}
+}
@@ -0,0 +1,138 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+	"github.com/itiky/mdb-tutorial/pkg/model"
+)
+
+// DeleteImport implements CSVFetcherServer interface.
+func (s gRPCServer) DeleteImport(ctx context.Context, req *DeleteImportRequest) (*DeleteImportResponse, error) {
+	// parse inputs
+	if req.Timestamp <= 0 && req.TimestampMs <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "timestamp / timestamp_ms: should be GT 0")
+	}
+	timestamp := time.Unix(req.Timestamp, 0).UTC()
+	if req.TimestampMs > 0 {
+		timestamp = fromUnixMilli(req.TimestampMs)
+	}
+
+	params, err := NewImportRollbackParams(ctx, req.Params)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	// delete
+	entry, err := s.service.ImportRollback().DeleteImport(ctx, timestamp, params)
+	if err != nil {
+		return nil, newImportRollbackError(err)
+	}
+
+	return &DeleteImportResponse{
+		Audit: NewImportAuditEntry(entry),
+	}, nil
+}
+
+// DeleteImportByJobID implements CSVFetcherServer interface.
+func (s gRPCServer) DeleteImportByJobID(ctx context.Context, req *DeleteImportByJobIDRequest) (*DeleteImportResponse, error) {
+	// parse inputs
+	params, err := NewImportRollbackParams(ctx, req.Params)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	// delete
+	entry, err := s.service.ImportRollback().DeleteImportByJobID(ctx, req.JobId, params)
+	if err != nil {
+		return nil, newImportRollbackError(err)
+	}
+
+	return &DeleteImportResponse{
+		Audit: NewImportAuditEntry(entry),
+	}, nil
+}
+
+// ListImportAudit implements CSVFetcherServer interface.
+func (s gRPCServer) ListImportAudit(ctx context.Context, req *ListImportAuditRequest) (*ListImportAuditResponse, error) {
+	// parse inputs
+	paginationOption, err := NewPaginationOption(req.Pagination)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	// query and build response
+	entries, err := s.service.ImportRollback().ListAudit(ctx, paginationOption)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	response := &ListImportAuditResponse{}
+	for _, entry := range entries {
+		response.Entries = append(response.Entries, NewImportAuditEntry(entry))
+	}
+
+	return response, nil
+}
+
+// NewImportRollbackParams converts gRPC ImportRollbackParams to model.ImportRollbackParams.
+// Client address is taken from the request peer info.
+func NewImportRollbackParams(ctx context.Context, apiParams *ImportRollbackParams) (model.ImportRollbackParams, error) {
+	if apiParams == nil {
+		return model.ImportRollbackParams{}, fmt.Errorf("params: nil")
+	}
+
+	params := model.ImportRollbackParams{
+		Actor:                apiParams.Actor,
+		Reason:               apiParams.Reason,
+		DeleteOrphanProducts: apiParams.DeleteOrphanProducts,
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		params.ClientAddr = p.Addr.String()
+	}
+
+	if err := params.Validate(); err != nil {
+		return model.ImportRollbackParams{}, err
+	}
+
+	return params, nil
+}
+
+// NewImportAuditEntry converts model.ImportAuditEntry to gRPC ImportAuditEntry.
+func NewImportAuditEntry(inEntry model.ImportAuditEntry) *ImportAuditEntry {
+	outEntry := &ImportAuditEntry{
+		Id:                  inEntry.ID.Hex(),
+		Action:              string(inEntry.Action),
+		Actor:               inEntry.Actor,
+		ClientAddr:          inEntry.ClientAddr,
+		Reason:              inEntry.Reason,
+		JobId:               inEntry.JobID,
+		DeletedPriceImports: inEntry.DeletedPriceImports,
+		DeletedProducts:     inEntry.DeletedProducts,
+		CreatedAt:           inEntry.CreatedAt.Unix(),
+	}
+	for _, timestamp := range inEntry.Timestamps {
+		outEntry.Timestamps = append(outEntry.Timestamps, timestamp.Unix())
+	}
+
+	return outEntry
+}
+
+// newImportRollbackError converts ImportRollback service error to gRPC status error.
+func newImportRollbackError(err error) error {
+	if errors.Is(err, common.ErrInvalidInput) {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, common.ErrNotFound) {
+		return status.Errorf(codes.NotFound, err.Error())
+	}
+
+	return status.Errorf(codes.Internal, err.Error())
+}
@@ -0,0 +1,179 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+	"github.com/itiky/mdb-tutorial/pkg/model"
+)
+
+// CreatePriceAlert implements PriceAlertsServer interface.
+func (s gRPCServer) CreatePriceAlert(ctx context.Context, req *CreatePriceAlertRequest) (*PriceAlertRule, error) {
+	// parse inputs
+	rule, err := NewPriceAlertRuleOption(req.Rule)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	// create
+	createdRule, err := s.service.PriceAlerts().Create(ctx, rule)
+	if err != nil {
+		return nil, newPriceAlertsError(err)
+	}
+
+	return NewPriceAlertRule(createdRule), nil
+}
+
+// GetPriceAlert implements PriceAlertsServer interface.
+func (s gRPCServer) GetPriceAlert(ctx context.Context, req *GetPriceAlertRequest) (*PriceAlertRule, error) {
+	rule, err := s.service.PriceAlerts().Get(ctx, req.Id)
+	if err != nil {
+		return nil, newPriceAlertsError(err)
+	}
+
+	return NewPriceAlertRule(rule), nil
+}
+
+// ListPriceAlerts implements PriceAlertsServer interface.
+func (s gRPCServer) ListPriceAlerts(ctx context.Context, req *ListPriceAlertsRequest) (*ListPriceAlertsResponse, error) {
+	// parse inputs
+	paginationOption, err := NewPaginationOption(req.Pagination)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	// query and build response
+	rules, err := s.service.PriceAlerts().List(ctx, paginationOption)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	response := &ListPriceAlertsResponse{}
+	for _, rule := range rules {
+		response.Rules = append(response.Rules, NewPriceAlertRule(rule))
+	}
+
+	return response, nil
+}
+
+// UpdatePriceAlert implements PriceAlertsServer interface.
+func (s gRPCServer) UpdatePriceAlert(ctx context.Context, req *UpdatePriceAlertRequest) (*PriceAlertRule, error) {
+	// parse inputs
+	rule, err := NewPriceAlertRuleOption(req.Rule)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	id, err := primitive.ObjectIDFromHex(req.Rule.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "id: %v", err)
+	}
+	rule.ID = id
+
+	// update
+	updatedRule, err := s.service.PriceAlerts().Update(ctx, rule)
+	if err != nil {
+		return nil, newPriceAlertsError(err)
+	}
+
+	return NewPriceAlertRule(updatedRule), nil
+}
+
+// DeletePriceAlert implements PriceAlertsServer interface.
+func (s gRPCServer) DeletePriceAlert(ctx context.Context, req *DeletePriceAlertRequest) (*DeletePriceAlertResponse, error) {
+	if err := s.service.PriceAlerts().Delete(ctx, req.Id); err != nil {
+		return nil, newPriceAlertsError(err)
+	}
+
+	return &DeletePriceAlertResponse{}, nil
+}
+
+// NewPriceAlertRule converts model.PriceAlertRule to gRPC PriceAlertRule.
+func NewPriceAlertRule(inRule model.PriceAlertRule) *PriceAlertRule {
+	outRule := &PriceAlertRule{
+		Id:            inRule.ID.Hex(),
+		Name:          inRule.Name,
+		ProductName:   inRule.ProductName,
+		Currency:      inRule.Currency,
+		ChangePercent: inRule.ChangePercent,
+		Enabled:       inRule.Enabled,
+		CreatedAt:     inRule.CreatedAt.Unix(),
+		UpdatedAt:     inRule.UpdatedAt.Unix(),
+	}
+
+	switch inRule.Condition {
+	case model.PriceAlertConditionAbove:
+		outRule.Condition = PriceAlertCondition_Above
+	case model.PriceAlertConditionBelow:
+		outRule.Condition = PriceAlertCondition_Below
+	case model.PriceAlertConditionChange:
+		outRule.Condition = PriceAlertCondition_Change
+	}
+	if outRule.Condition != PriceAlertCondition_Change {
+		outRule.Threshold = inRule.Threshold.Amount.String()
+	}
+	if !inRule.LastTriggeredAt.IsZero() {
+		outRule.LastTriggeredAt = inRule.LastTriggeredAt.Unix()
+	}
+
+	return outRule
+}
+
+// NewPriceAlertRuleOption converts gRPC PriceAlertRule to model.PriceAlertRule (server set fields are skipped).
+func NewPriceAlertRuleOption(apiRule *PriceAlertRule) (model.PriceAlertRule, error) {
+	if apiRule == nil {
+		return model.PriceAlertRule{}, fmt.Errorf("rule: nil")
+	}
+
+	rule := model.PriceAlertRule{
+		Name:          strings.TrimSpace(apiRule.Name),
+		ProductName:   apiRule.ProductName,
+		Currency:      strings.ToUpper(strings.TrimSpace(apiRule.Currency)),
+		ChangePercent: apiRule.ChangePercent,
+		Enabled:       apiRule.Enabled,
+	}
+
+	switch apiRule.Condition {
+	case PriceAlertCondition_Above:
+		rule.Condition = model.PriceAlertConditionAbove
+	case PriceAlertCondition_Below:
+		rule.Condition = model.PriceAlertConditionBelow
+	case PriceAlertCondition_Change:
+		rule.Condition = model.PriceAlertConditionChange
+	default:
+		return model.PriceAlertRule{}, fmt.Errorf("condition: not set or unknown (%d)", apiRule.Condition)
+	}
+
+	if rule.Condition != model.PriceAlertConditionChange {
+		threshold, err := model.ParseMoney(apiRule.Threshold, '.', rule.Currency)
+		if err != nil {
+			return model.PriceAlertRule{}, fmt.Errorf("threshold: %v", err)
+		}
+		rule.Threshold = threshold
+	}
+
+	if err := rule.Validate(); err != nil {
+		return model.PriceAlertRule{}, err
+	}
+
+	return rule, nil
+}
+
+// newPriceAlertsError converts PriceAlerts service error to gRPC status error.
+func newPriceAlertsError(err error) error {
+	if errors.Is(err, common.ErrInvalidInput) {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, common.ErrNotFound) {
+		return status.Errorf(codes.NotFound, err.Error())
+	}
+
+	return status.Errorf(codes.Internal, err.Error())
+}
@@ -2,10 +2,15 @@ package v1
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/itiky/mdb-tutorial/pkg/common"
 	"github.com/itiky/mdb-tutorial/pkg/model"
 )
 
@@ -16,41 +21,474 @@ func (s gRPCServer) List(ctx context.Context, req *ListRequest) (*ListResponse,
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
+	paginationOption.Cursor = req.PageToken
+	if err := paginationOption.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "pagination params validation failed: %v", err)
+	}
 
-	peSortOptions := make([]model.PriceEntriesSortOption, 0)
-	if order, ok := NewOrderOption(req.SortByName); ok {
-		peSortOptions = append(peSortOptions, model.PriceEntrySortByProductName(order))
+	filter, err := NewPriceEntriesFilterOption(req.Filter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
-	if order, ok := NewOrderOption(req.SortByPrice); ok {
-		peSortOptions = append(peSortOptions, model.PriceEntrySortByPrice(order))
+
+	sortOptions, appliedSort := NewPriceEntriesSortOptions(req.SortByName, req.SortByPrice, req.SortByTimestamp)
+
+	// query and build response
+	page, err := s.service.PriceEntries().List(ctx, filter, paginationOption, sortOptions, !req.SkipTotalCount)
+	if err != nil {
+		if errors.Is(err, common.ErrInvalidInput) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
 	}
-	if order, ok := NewOrderOption(req.SortByTimestamp); ok {
-		peSortOptions = append(peSortOptions, model.PriceEntrySortByImportTimestamp(order))
+
+	response := &ListResponse{
+		Entries:       NewPriceEntries(page.Entries),
+		NextPageToken: page.NextCursor,
+		TotalCount:    page.TotalCount,
+		HasMore:       page.HasMore,
+		Filter:        NewPriceEntriesFilter(filter),
+		Sort:          appliedSort,
+	}
+
+	return response, nil
+}
+
+// Stream implements PriceEntryReaderServer interface.
+func (s gRPCServer) Stream(req *StreamRequest, stream PriceEntryReader_StreamServer) error {
+	// parse inputs
+	filter, err := NewPriceEntriesFilterOption(req.Filter)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if req.Limit < 0 {
+		return status.Errorf(codes.InvalidArgument, "limit: should be GTE 0")
+	}
+
+	sortOptions, _ := NewPriceEntriesSortOptions(req.SortByName, req.SortByPrice, req.SortByTimestamp)
+
+	// query and send entries as those are decoded
+	err = s.service.PriceEntries().Stream(stream.Context(), filter, sortOptions, int(req.Limit), func(entry model.PriceEntry) error {
+		return stream.Send(NewPriceEntry(entry))
+	})
+	if err != nil {
+		if errors.Is(err, common.ErrInvalidInput) {
+			return status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		if stream.Context().Err() != nil {
+			return status.FromContextError(stream.Context().Err()).Err()
+		}
+		return status.Errorf(codes.Internal, err.Error())
+	}
+
+	return nil
+}
+
+// GetLatestPrices implements PriceEntryReaderServer interface.
+func (s gRPCServer) GetLatestPrices(ctx context.Context, req *GetLatestPricesRequest) (*GetLatestPricesResponse, error) {
+	// parse inputs
+	paginationOption, err := NewPaginationOption(req.Pagination)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	filter := model.LatestPricesFilter{
+		ProductNames: req.ProductNames,
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "filter: %v", err)
 	}
-	sortOptions := model.NewPriceEntriesSortOptions(peSortOptions...)
 
 	// query and build response
-	entries, err := s.service.PriceEntries().List(ctx, paginationOption, sortOptions)
+	entries, err := s.service.PriceEntries().Latest(ctx, filter, paginationOption)
 	if err != nil {
+		if errors.Is(err, common.ErrInvalidInput) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
-	response := &ListResponse{
-		Entries: NewPriceEntries(entries),
+	response := &GetLatestPricesResponse{}
+	for _, entry := range entries {
+		response.Entries = append(response.Entries, NewLatestPrices(entry))
+	}
+
+	return response, nil
+}
+
+// GetProductHistory implements PriceEntryReaderServer interface.
+func (s gRPCServer) GetProductHistory(ctx context.Context, req *GetProductHistoryRequest) (*GetProductHistoryResponse, error) {
+	// parse inputs
+	params, err := NewPriceHistoryParams(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	// query and build response
+	points, err := s.service.PriceEntries().History(ctx, req.ProductName, params)
+	if err != nil {
+		if errors.Is(err, common.ErrInvalidInput) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, common.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	response := &GetProductHistoryResponse{}
+	for _, point := range points {
+		response.Points = append(response.Points, &PriceHistoryPoint{
+			Timestamp: point.Timestamp.Unix(),
+			Price:     NewPriceValue(point.Price),
+		})
+	}
+
+	return response, nil
+}
+
+// Stats implements PriceEntryReaderServer interface.
+func (s gRPCServer) Stats(ctx context.Context, req *StatsRequest) (*StatsResponse, error) {
+	// parse inputs
+	paginationOption, err := NewPaginationOption(req.Pagination)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	filter, err := NewPriceEntriesFilterOption(req.Filter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	// query and build response
+	stats, err := s.service.PriceEntries().Stats(ctx, filter, paginationOption)
+	if err != nil {
+		if errors.Is(err, common.ErrInvalidInput) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	response := &StatsResponse{}
+	for _, productStats := range stats {
+		response.Stats = append(response.Stats, &PriceStats{
+			ProductName:  productStats.Name,
+			Currency:     productStats.Currency,
+			Min:          productStats.Min.Amount.String(),
+			Max:          productStats.Max.Amount.String(),
+			Mean:         productStats.Mean.Amount.String(),
+			Median:       productStats.Median.Amount.String(),
+			StdDev:       productStats.StdDev,
+			PricesCount:  productStats.PricesCount,
+			ImportsCount: productStats.ImportsCount,
+		})
+	}
+
+	return response, nil
+}
+
+// GetCandles implements PriceEntryReaderServer interface.
+func (s gRPCServer) GetCandles(ctx context.Context, req *GetCandlesRequest) (*GetCandlesResponse, error) {
+	// parse inputs
+	params, err := NewCandlesParams(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	// query and build response
+	candles, err := s.service.PriceEntries().Candles(ctx, req.ProductName, params)
+	if err != nil {
+		if errors.Is(err, common.ErrInvalidInput) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, common.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	response := &GetCandlesResponse{}
+	for _, candle := range candles {
+		response.Candles = append(response.Candles, &Candle{
+			Start:    candle.Start.Unix(),
+			Currency: candle.Currency,
+			Open:     candle.Open.Amount.String(),
+			High:     candle.High.Amount.String(),
+			Low:      candle.Low.Amount.String(),
+			Close:    candle.Close.Amount.String(),
+			Count:    candle.Count,
+		})
+	}
+
+	return response, nil
+}
+
+// TopMovers implements PriceEntryReaderServer interface.
+func (s gRPCServer) TopMovers(ctx context.Context, req *TopMoversRequest) (*TopMoversResponse, error) {
+	// parse inputs
+	params, err := NewTopMoversParams(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	// query and build response
+	topMovers, err := s.service.PriceEntries().TopMovers(ctx, params)
+	if err != nil {
+		if errors.Is(err, common.ErrInvalidInput) {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, common.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	response := &TopMoversResponse{
+		FromTimestamp:   topMovers.FromTimestamp.Unix(),
+		ToTimestamp:     topMovers.ToTimestamp.Unix(),
+		FromTimestampMs: unixMilli(topMovers.FromTimestamp),
+		ToTimestampMs:   unixMilli(topMovers.ToTimestamp),
+	}
+	for _, change := range topMovers.Movers {
+		response.Movers = append(response.Movers, NewPriceChange(change))
 	}
 
 	return response, nil
 }
 
+// NewTopMoversParams converts gRPC TopMoversRequest to model.TopMoversParams.
+func NewTopMoversParams(req *TopMoversRequest) (model.TopMoversParams, error) {
+	params := model.TopMoversParams{
+		Relative: req.Relative,
+		Currency: strings.ToUpper(req.Currency),
+		Limit:    int(req.Limit),
+	}
+
+	if req.TimestampFrom < 0 || req.TimestampTo < 0 {
+		return model.TopMoversParams{}, fmt.Errorf("timestampFrom / timestampTo: should be GTE 0")
+	}
+	if req.TimestampFrom > 0 {
+		params.TimestampFrom = time.Unix(req.TimestampFrom, 0).UTC()
+	}
+	if req.TimestampTo > 0 {
+		params.TimestampTo = time.Unix(req.TimestampTo, 0).UTC()
+	}
+
+	switch req.Direction {
+	case TopMoversDirection_Gainers:
+		params.Direction = model.TopMoversDirectionGainers
+	case TopMoversDirection_Losers:
+		params.Direction = model.TopMoversDirectionLosers
+	default:
+		return model.TopMoversParams{}, fmt.Errorf("direction: not set or unknown (%d)", req.Direction)
+	}
+
+	if err := params.Validate(); err != nil {
+		return model.TopMoversParams{}, err
+	}
+
+	return params, nil
+}
+
+// NewCandlesParams converts gRPC GetCandlesRequest to model.CandlesParams.
+func NewCandlesParams(req *GetCandlesRequest) (model.CandlesParams, error) {
+	params := model.CandlesParams{}
+
+	if req.TimestampFrom < 0 || req.TimestampTo < 0 {
+		return model.CandlesParams{}, fmt.Errorf("timestampFrom / timestampTo: should be GTE 0")
+	}
+	if req.TimestampFrom > 0 {
+		params.TimestampFrom = time.Unix(req.TimestampFrom, 0).UTC()
+	}
+	if req.TimestampTo > 0 {
+		params.TimestampTo = time.Unix(req.TimestampTo, 0).UTC()
+	}
+
+	switch req.Interval {
+	case CandleInterval_Hour:
+		params.Interval = model.CandleIntervalHour
+	case CandleInterval_Day:
+		params.Interval = model.CandleIntervalDay
+	case CandleInterval_Week:
+		params.Interval = model.CandleIntervalWeek
+	default:
+		return model.CandlesParams{}, fmt.Errorf("interval: not set or unknown (%d)", req.Interval)
+	}
+
+	if err := params.Validate(); err != nil {
+		return model.CandlesParams{}, err
+	}
+
+	return params, nil
+}
+
+// NewPriceHistoryParams converts gRPC GetProductHistoryRequest to model.PriceHistoryParams.
+func NewPriceHistoryParams(req *GetProductHistoryRequest) (model.PriceHistoryParams, error) {
+	params := model.PriceHistoryParams{}
+
+	if req.TimestampFrom < 0 || req.TimestampTo < 0 {
+		return model.PriceHistoryParams{}, fmt.Errorf("timestampFrom / timestampTo: should be GTE 0")
+	}
+	if req.TimestampFrom > 0 {
+		params.TimestampFrom = time.Unix(req.TimestampFrom, 0).UTC()
+	}
+	if req.TimestampTo > 0 {
+		params.TimestampTo = time.Unix(req.TimestampTo, 0).UTC()
+	}
+
+	if req.BucketSize < 0 {
+		return model.PriceHistoryParams{}, fmt.Errorf("bucketSize: should be GTE 0")
+	}
+	params.BucketSize = time.Duration(req.BucketSize) * time.Second
+
+	switch req.Downsampling {
+	case Downsampling_NoDownsampling:
+	case Downsampling_First:
+		params.Downsampling = model.PriceHistoryDownsamplingFirst
+	case Downsampling_Last:
+		params.Downsampling = model.PriceHistoryDownsamplingLast
+	case Downsampling_Min:
+		params.Downsampling = model.PriceHistoryDownsamplingMin
+	case Downsampling_Max:
+		params.Downsampling = model.PriceHistoryDownsamplingMax
+	default:
+		return model.PriceHistoryParams{}, fmt.Errorf("downsampling: unknown (%d)", req.Downsampling)
+	}
+
+	if err := params.Validate(); err != nil {
+		return model.PriceHistoryParams{}, err
+	}
+
+	return params, nil
+}
+
+// NewPriceEntriesSortOptions converts gRPC sort orders to price entries sort options (in the name, price, timestamp priority order).
+// Applied sort fields are returned as well.
+func NewPriceEntriesSortOptions(byName, byPrice, byTimestamp SortOrder) (common.SortOptions, []*SortField) {
+	peSortOptions, appliedSort := make([]model.PriceEntriesSortOption, 0), make([]*SortField, 0)
+	if order, ok := NewOrderOption(byName); ok {
+		peSortOptions = append(peSortOptions, model.PriceEntrySortByProductName(order))
+		appliedSort = append(appliedSort, &SortField{Field: "product_name", Order: byName})
+	}
+	if order, ok := NewOrderOption(byPrice); ok {
+		peSortOptions = append(peSortOptions, model.PriceEntrySortByPrice(order))
+		appliedSort = append(appliedSort, &SortField{Field: "price", Order: byPrice})
+	}
+	if order, ok := NewOrderOption(byTimestamp); ok {
+		peSortOptions = append(peSortOptions, model.PriceEntrySortByImportTimestamp(order))
+		appliedSort = append(appliedSort, &SortField{Field: "timestamp", Order: byTimestamp})
+	}
+
+	return model.NewPriceEntriesSortOptions(peSortOptions...), appliedSort
+}
+
+// NewPriceEntry converts model.PriceEntry to gRPC PriceEntry.
+func NewPriceEntry(inEntry model.PriceEntry) *PriceEntry {
+	return &PriceEntry{
+		ProductName: inEntry.Name,
+		Timestamp:   inEntry.Timestamp.Unix(),
+		Price:       inEntry.Price.Amount.String(),
+		Currency:    inEntry.Price.Currency,
+	}
+}
+
+// NewLatestPrices converts model.LatestPrices to gRPC LatestPrices.
+func NewLatestPrices(inEntry model.LatestPrices) *LatestPrices {
+	outEntry := &LatestPrices{
+		ProductName: inEntry.Name,
+		Timestamp:   inEntry.Timestamp.Unix(),
+	}
+	for _, price := range inEntry.Prices {
+		outEntry.Prices = append(outEntry.Prices, NewPriceValue(price))
+	}
+
+	return outEntry
+}
+
+// NewPriceValue converts model.Money to gRPC PriceValue.
+func NewPriceValue(m model.Money) *PriceValue {
+	return &PriceValue{
+		Price:    m.Amount.String(),
+		Currency: m.Currency,
+	}
+}
+
 // NewPriceEntries converts model.PriceEntries to gRPC PriceEntry list.
 func NewPriceEntries(inEntries model.PriceEntries) (outEntries []*PriceEntry) {
 	for _, inEntry := range inEntries {
-		outEntries = append(outEntries, &PriceEntry{
-			ProductName: inEntry.Name,
-			Timestamp:   inEntry.Timestamp.Unix(),
-			Price:       int32(inEntry.Price),
-		})
+		outEntries = append(outEntries, NewPriceEntry(inEntry))
 	}
 
 	return
 }
+
+// NewPriceEntriesFilter converts model.PriceEntriesFilter to gRPC PriceEntriesFilter.
+func NewPriceEntriesFilter(inFilter model.PriceEntriesFilter) *PriceEntriesFilter {
+	outFilter := &PriceEntriesFilter{
+		ProductName:       inFilter.ProductName,
+		ProductNamePrefix: inFilter.ProductNamePrefix,
+		ProductNameRegex:  inFilter.ProductNameRegex,
+		Currency:          inFilter.Currency,
+	}
+	if inFilter.PriceMin != nil {
+		outFilter.PriceMin = inFilter.PriceMin.Amount.String()
+	}
+	if inFilter.PriceMax != nil {
+		outFilter.PriceMax = inFilter.PriceMax.Amount.String()
+	}
+	if !inFilter.TimestampFrom.IsZero() {
+		outFilter.TimestampFrom = inFilter.TimestampFrom.Unix()
+	}
+	if !inFilter.TimestampTo.IsZero() {
+		outFilter.TimestampTo = inFilter.TimestampTo.Unix()
+	}
+
+	return outFilter
+}
+
+// NewPriceEntriesFilterOption converts optional gRPC PriceEntriesFilter to model.PriceEntriesFilter.
+func NewPriceEntriesFilterOption(apiFilter *PriceEntriesFilter) (model.PriceEntriesFilter, error) {
+	filter := model.PriceEntriesFilter{}
+	if apiFilter == nil {
+		return filter, nil
+	}
+
+	filter.ProductName = apiFilter.ProductName
+	filter.ProductNamePrefix = apiFilter.ProductNamePrefix
+	filter.ProductNameRegex = apiFilter.ProductNameRegex
+	filter.Currency = strings.ToUpper(apiFilter.Currency)
+
+	for _, priceParam := range []struct {
+		name   string
+		value  string
+		target **model.Money
+	}{
+		{name: "priceMin", value: apiFilter.PriceMin, target: &filter.PriceMin},
+		{name: "priceMax", value: apiFilter.PriceMax, target: &filter.PriceMax},
+	} {
+		if priceParam.value == "" {
+			continue
+		}
+		price, err := model.ParseAmount(priceParam.value)
+		if err != nil {
+			return model.PriceEntriesFilter{}, fmt.Errorf("filter: %s: %w", priceParam.name, err)
+		}
+		*priceParam.target = &price
+	}
+
+	if apiFilter.TimestampFrom < 0 || apiFilter.TimestampTo < 0 {
+		return model.PriceEntriesFilter{}, fmt.Errorf("filter: timestampFrom / timestampTo: should be GTE 0")
+	}
+	if apiFilter.TimestampFrom > 0 {
+		filter.TimestampFrom = time.Unix(apiFilter.TimestampFrom, 0).UTC()
+	}
+	if apiFilter.TimestampTo > 0 {
+		filter.TimestampTo = time.Unix(apiFilter.TimestampTo, 0).UTC()
+	}
+
+	if err := filter.Validate(); err != nil {
+		return model.PriceEntriesFilter{}, fmt.Errorf("filter: %w", err)
+	}
+
+	return filter, nil
+}
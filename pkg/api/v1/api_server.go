@@ -9,23 +9,28 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 
+	"github.com/itiky/mdb-tutorial/pkg/model"
 	"github.com/itiky/mdb-tutorial/pkg/service"
 )
 
 var _ CSVFetcherServer = (*gRPCServer)(nil)
 var _ PriceEntryReaderServer = (*gRPCServer)(nil)
+var _ PriceAlertsServer = (*gRPCServer)(nil)
 
 // gRPCServer implement gRPC services.
 type gRPCServer struct {
 	service service.Service
 	logger  *logrus.Logger
 	//
-	csvChunkSize   int
-	tlsCertificate *tls.Certificate
+	csvChunkSize    int
+	csvChunkWorkers int
+	csvDialect      model.CSVDialect
+	tlsCertificate  *tls.Certificate
 }
 
 func (s gRPCServer) mustEmbedUnimplementedCSVFetcherServer()       {}
 func (s gRPCServer) mustEmbedUnimplementedPriceEntryReaderServer() {}
+func (s gRPCServer) mustEmbedUnimplementedPriceAlertsServer()      {}
 
 // Option specifies functional argument used by NewServer function.
 type Option func(server *gRPCServer) error
@@ -63,6 +68,30 @@ func WithCSVChunkSize(size int) Option {
 	}
 }
 
+// WithCSVChunkWorkers sets CSV-processor number of concurrent chunk workers for server.
+func WithCSVChunkWorkers(workers int) Option {
+	return func(server *gRPCServer) error {
+		if workers <= 0 {
+			return fmt.Errorf("csvChunkWorkers option: should be GT 0")
+		}
+		server.csvChunkWorkers = workers
+
+		return nil
+	}
+}
+
+// WithCSVDialect sets default CSV-file format for server.
+func WithCSVDialect(dialect model.CSVDialect) Option {
+	return func(server *gRPCServer) error {
+		if err := dialect.Validate(); err != nil {
+			return fmt.Errorf("csvDialect option: %w", err)
+		}
+		server.csvDialect = dialect
+
+		return nil
+	}
+}
+
 // WithTLS enabled TLS encryption fro server.
 func WithTLS(certificate *tls.Certificate) Option {
 	return func(server *gRPCServer) error {
@@ -77,7 +106,9 @@ func NewServer(options ...Option) (*grpc.Server, error) {
 	var serverOptions []grpc.ServerOption
 
 	s := &gRPCServer{
-		csvChunkSize: 1000,
+		csvChunkSize:    1000,
+		csvChunkWorkers: 1,
+		csvDialect:      model.NewDefaultCSVDialect(),
 	}
 	for _, option := range options {
 		if err := option(s); err != nil {
@@ -103,6 +134,7 @@ func NewServer(options ...Option) (*grpc.Server, error) {
 	gRPCServer := grpc.NewServer(serverOptions...)
 	RegisterCSVFetcherServer(gRPCServer, s)
 	RegisterPriceEntryReaderServer(gRPCServer, s)
+	RegisterPriceAlertsServer(gRPCServer, s)
 
 	return gRPCServer, nil
 }
@@ -25,6 +25,68 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+// Import job state enum.
+type ImportJobState int32
+
+const (
+	ImportJobState_Queued      ImportJobState = 0
+	ImportJobState_Downloading ImportJobState = 1
+	ImportJobState_Processing  ImportJobState = 2
+	ImportJobState_Done        ImportJobState = 3
+	ImportJobState_Failed      ImportJobState = 4
+	ImportJobState_Skipped     ImportJobState = 5 // the same content has already been imported
+	ImportJobState_RolledBack  ImportJobState = 6 // imported prices have been deleted
+)
+
+// Enum value maps for ImportJobState.
+var (
+	ImportJobState_name = map[int32]string{
+		0: "Queued",
+		1: "Downloading",
+		2: "Processing",
+		3: "Done",
+		4: "Failed",
+		5: "Skipped",
+		6: "RolledBack",
+	}
+	ImportJobState_value = map[string]int32{
+		"Queued":      0,
+		"Downloading": 1,
+		"Processing":  2,
+		"Done":        3,
+		"Failed":      4,
+		"Skipped":     5,
+		"RolledBack":  6,
+	}
+)
+
+func (x ImportJobState) Enum() *ImportJobState {
+	p := new(ImportJobState)
+	*p = x
+	return p
+}
+
+func (x ImportJobState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportJobState) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_proto_enumTypes[0].Descriptor()
+}
+
+func (ImportJobState) Type() protoreflect.EnumType {
+	return &file_v1_proto_enumTypes[0]
+}
+
+func (x ImportJobState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportJobState.Descriptor instead.
+func (ImportJobState) EnumDescriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{0}
+}
+
 // Sort order enum.
 type SortOrder int32
 
@@ -59,11 +121,11 @@ func (x SortOrder) String() string {
 }
 
 func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_proto_enumTypes[0].Descriptor()
+	return file_v1_proto_enumTypes[1].Descriptor()
 }
 
 func (SortOrder) Type() protoreflect.EnumType {
-	return &file_v1_proto_enumTypes[0]
+	return &file_v1_proto_enumTypes[1]
 }
 
 func (x SortOrder) Number() protoreflect.EnumNumber {
@@ -72,7 +134,219 @@ func (x SortOrder) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SortOrder.Descriptor instead.
 func (SortOrder) EnumDescriptor() ([]byte, []int) {
-	return file_v1_proto_rawDescGZIP(), []int{0}
+	return file_v1_proto_rawDescGZIP(), []int{1}
+}
+
+// Price history downsampling function (single price per bucket).
+type Downsampling int32
+
+const (
+	Downsampling_NoDownsampling Downsampling = 0
+	Downsampling_First          Downsampling = 1 // the first bucket price
+	Downsampling_Last           Downsampling = 2 // the last bucket price
+	Downsampling_Min            Downsampling = 3 // min bucket price
+	Downsampling_Max            Downsampling = 4 // max bucket price
+)
+
+// Enum value maps for Downsampling.
+var (
+	Downsampling_name = map[int32]string{
+		0: "NoDownsampling",
+		1: "First",
+		2: "Last",
+		3: "Min",
+		4: "Max",
+	}
+	Downsampling_value = map[string]int32{
+		"NoDownsampling": 0,
+		"First":          1,
+		"Last":           2,
+		"Min":            3,
+		"Max":            4,
+	}
+)
+
+func (x Downsampling) Enum() *Downsampling {
+	p := new(Downsampling)
+	*p = x
+	return p
+}
+
+func (x Downsampling) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Downsampling) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_proto_enumTypes[2].Descriptor()
+}
+
+func (Downsampling) Type() protoreflect.EnumType {
+	return &file_v1_proto_enumTypes[2]
+}
+
+func (x Downsampling) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Downsampling.Descriptor instead.
+func (Downsampling) EnumDescriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{2}
+}
+
+// Candle interval enum (buckets are UTC aligned, weeks start on Monday).
+type CandleInterval int32
+
+const (
+	CandleInterval_NoInterval CandleInterval = 0
+	CandleInterval_Hour       CandleInterval = 1
+	CandleInterval_Day        CandleInterval = 2
+	CandleInterval_Week       CandleInterval = 3
+)
+
+// Enum value maps for CandleInterval.
+var (
+	CandleInterval_name = map[int32]string{
+		0: "NoInterval",
+		1: "Hour",
+		2: "Day",
+		3: "Week",
+	}
+	CandleInterval_value = map[string]int32{
+		"NoInterval": 0,
+		"Hour":       1,
+		"Day":        2,
+		"Week":       3,
+	}
+)
+
+func (x CandleInterval) Enum() *CandleInterval {
+	p := new(CandleInterval)
+	*p = x
+	return p
+}
+
+func (x CandleInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CandleInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_proto_enumTypes[3].Descriptor()
+}
+
+func (CandleInterval) Type() protoreflect.EnumType {
+	return &file_v1_proto_enumTypes[3]
+}
+
+func (x CandleInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CandleInterval.Descriptor instead.
+func (CandleInterval) EnumDescriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{3}
+}
+
+// Top movers price change direction enum.
+type TopMoversDirection int32
+
+const (
+	TopMoversDirection_NoDirection TopMoversDirection = 0
+	TopMoversDirection_Gainers     TopMoversDirection = 1
+	TopMoversDirection_Losers      TopMoversDirection = 2
+)
+
+// Enum value maps for TopMoversDirection.
+var (
+	TopMoversDirection_name = map[int32]string{
+		0: "NoDirection",
+		1: "Gainers",
+		2: "Losers",
+	}
+	TopMoversDirection_value = map[string]int32{
+		"NoDirection": 0,
+		"Gainers":     1,
+		"Losers":      2,
+	}
+)
+
+func (x TopMoversDirection) Enum() *TopMoversDirection {
+	p := new(TopMoversDirection)
+	*p = x
+	return p
+}
+
+func (x TopMoversDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TopMoversDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_proto_enumTypes[4].Descriptor()
+}
+
+func (TopMoversDirection) Type() protoreflect.EnumType {
+	return &file_v1_proto_enumTypes[4]
+}
+
+func (x TopMoversDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TopMoversDirection.Descriptor instead.
+func (TopMoversDirection) EnumDescriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{4}
+}
+
+// Price alert rule condition enum.
+type PriceAlertCondition int32
+
+const (
+	PriceAlertCondition_NoCondition PriceAlertCondition = 0
+	PriceAlertCondition_Above       PriceAlertCondition = 1 // price crosses the threshold upwards
+	PriceAlertCondition_Below       PriceAlertCondition = 2 // price crosses the threshold downwards
+	PriceAlertCondition_Change      PriceAlertCondition = 3 // price moves more than the change percentage between imports (in any direction)
+)
+
+// Enum value maps for PriceAlertCondition.
+var (
+	PriceAlertCondition_name = map[int32]string{
+		0: "NoCondition",
+		1: "Above",
+		2: "Below",
+		3: "Change",
+	}
+	PriceAlertCondition_value = map[string]int32{
+		"NoCondition": 0,
+		"Above":       1,
+		"Below":       2,
+		"Change":      3,
+	}
+)
+
+func (x PriceAlertCondition) Enum() *PriceAlertCondition {
+	p := new(PriceAlertCondition)
+	*p = x
+	return p
+}
+
+func (x PriceAlertCondition) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PriceAlertCondition) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_proto_enumTypes[5].Descriptor()
+}
+
+func (PriceAlertCondition) Type() protoreflect.EnumType {
+	return &file_v1_proto_enumTypes[5]
+}
+
+func (x PriceAlertCondition) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PriceAlertCondition.Descriptor instead.
+func (PriceAlertCondition) EnumDescriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{5}
 }
 
 // CSVFetcher.Fetch request message.
@@ -81,7 +355,9 @@ type CSVFetchRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"` // CSV-file URL
+	Url     string      `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`         // CSV-file URL
+	Force   bool        `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`    // (optional) import the file even if the same content has already been imported
+	Dialect *CSVDialect `protobuf:"bytes,3,opt,name=dialect,proto3" json:"dialect,omitempty"` // (optional) CSV-file format (server default if not set)
 }
 
 func (x *CSVFetchRequest) Reset() {
@@ -123,11 +399,27 @@ func (x *CSVFetchRequest) GetUrl() string {
 	return ""
 }
 
+func (x *CSVFetchRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *CSVFetchRequest) GetDialect() *CSVDialect {
+	if x != nil {
+		return x.Dialect
+	}
+	return nil
+}
+
 // CSVFetcher.Fetch response message.
 type CSVFetchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"` // enqueued import job ID
 }
 
 func (x *CSVFetchResponse) Reset() {
@@ -162,18 +454,27 @@ func (*CSVFetchResponse) Descriptor() ([]byte, []int) {
 	return file_v1_proto_rawDescGZIP(), []int{1}
 }
 
-// Params for pagination supported requests.
-type PaginationParams struct {
+func (x *CSVFetchResponse) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+// CSVFetcher.Upload request message (client stream).
+type CSVUploadRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Skip  uint32 `protobuf:"varint,1,opt,name=skip,proto3" json:"skip,omitempty"`   // (optional) number of skipped response entries
-	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // (optional) number of response entries per request (default: 50)
+	FileName string      `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"` // (optional) uploaded file name (taken from the first message only)
+	Data     []byte      `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`                         // CSV-file content part
+	Dialect  *CSVDialect `protobuf:"bytes,3,opt,name=dialect,proto3" json:"dialect,omitempty"`                   // (optional) CSV-file format (taken from the first message only, server default if not set)
+	Force    bool        `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`                      // (optional) import the file even if the same content has already been imported (taken from the first message only)
 }
 
-func (x *PaginationParams) Reset() {
-	*x = PaginationParams{}
+func (x *CSVUploadRequest) Reset() {
+	*x = CSVUploadRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -181,13 +482,13 @@ func (x *PaginationParams) Reset() {
 	}
 }
 
-func (x *PaginationParams) String() string {
+func (x *CSVUploadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaginationParams) ProtoMessage() {}
+func (*CSVUploadRequest) ProtoMessage() {}
 
-func (x *PaginationParams) ProtoReflect() protoreflect.Message {
+func (x *CSVUploadRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -199,38 +500,60 @@ func (x *PaginationParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaginationParams.ProtoReflect.Descriptor instead.
-func (*PaginationParams) Descriptor() ([]byte, []int) {
+// Deprecated: Use CSVUploadRequest.ProtoReflect.Descriptor instead.
+func (*CSVUploadRequest) Descriptor() ([]byte, []int) {
 	return file_v1_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *PaginationParams) GetSkip() uint32 {
+func (x *CSVUploadRequest) GetFileName() string {
 	if x != nil {
-		return x.Skip
+		return x.FileName
 	}
-	return 0
+	return ""
 }
 
-func (x *PaginationParams) GetLimit() uint32 {
+func (x *CSVUploadRequest) GetData() []byte {
 	if x != nil {
-		return x.Limit
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
-// Message for price entries request.
-type PriceEntry struct {
+func (x *CSVUploadRequest) GetDialect() *CSVDialect {
+	if x != nil {
+		return x.Dialect
+	}
+	return nil
+}
+
+func (x *CSVUploadRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+// CSV-file format.
+// Empty fields are set to the server defaults, has_header is used as is.
+type CSVDialect struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ProductName string `protobuf:"bytes,1,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"` // product name
-	Timestamp   int64  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                       // price change timestamp (UNIX-time) [s]
-	Price       int32  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`                               // price value
+	Delimiter        string   `protobuf:"bytes,1,opt,name=delimiter,proto3" json:"delimiter,omitempty"`                                       // fields delimiter char
+	Quote            string   `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`                                               // quote char (ASCII only)
+	Comment          string   `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`                                           // comment line prefix char (comments are disabled if empty)
+	HasHeader        bool     `protobuf:"varint,4,opt,name=has_header,json=hasHeader,proto3" json:"has_header,omitempty"`                     // first row contains column names
+	ProductColumn    string   `protobuf:"bytes,5,opt,name=product_column,json=productColumn,proto3" json:"product_column,omitempty"`          // product name column: header name or 0-based index
+	PriceColumn      string   `protobuf:"bytes,6,opt,name=price_column,json=priceColumn,proto3" json:"price_column,omitempty"`                // price column: header name or 0-based index
+	IgnoreColumns    []string `protobuf:"bytes,7,rep,name=ignore_columns,json=ignoreColumns,proto3" json:"ignore_columns,omitempty"`          // columns (header names or 0-based indexes) skipped, "*" to skip all unmapped ones
+	DecimalSeparator string   `protobuf:"bytes,8,opt,name=decimal_separator,json=decimalSeparator,proto3" json:"decimal_separator,omitempty"` // price decimal separator char ("." or ",")
+	Currency         string   `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"`                                         // price ISO-4217 currency code (default for empty currency column values)
+	CurrencyColumn   string   `protobuf:"bytes,10,opt,name=currency_column,json=currencyColumn,proto3" json:"currency_column,omitempty"`      // currency column: header name or 0-based index
 }
 
-func (x *PriceEntry) Reset() {
-	*x = PriceEntry{}
+func (x *CSVDialect) Reset() {
+	*x = CSVDialect{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -238,13 +561,13 @@ func (x *PriceEntry) Reset() {
 	}
 }
 
-func (x *PriceEntry) String() string {
+func (x *CSVDialect) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PriceEntry) ProtoMessage() {}
+func (*CSVDialect) ProtoMessage() {}
 
-func (x *PriceEntry) ProtoReflect() protoreflect.Message {
+func (x *CSVDialect) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -256,46 +579,92 @@ func (x *PriceEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PriceEntry.ProtoReflect.Descriptor instead.
-func (*PriceEntry) Descriptor() ([]byte, []int) {
+// Deprecated: Use CSVDialect.ProtoReflect.Descriptor instead.
+func (*CSVDialect) Descriptor() ([]byte, []int) {
 	return file_v1_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *PriceEntry) GetProductName() string {
+func (x *CSVDialect) GetDelimiter() string {
 	if x != nil {
-		return x.ProductName
+		return x.Delimiter
 	}
 	return ""
 }
 
-func (x *PriceEntry) GetTimestamp() int64 {
+func (x *CSVDialect) GetQuote() string {
 	if x != nil {
-		return x.Timestamp
+		return x.Quote
 	}
-	return 0
+	return ""
 }
 
-func (x *PriceEntry) GetPrice() int32 {
+func (x *CSVDialect) GetComment() string {
 	if x != nil {
-		return x.Price
+		return x.Comment
 	}
-	return 0
+	return ""
 }
 
-// PriceEntryReader.List request message.
-type ListRequest struct {
+func (x *CSVDialect) GetHasHeader() bool {
+	if x != nil {
+		return x.HasHeader
+	}
+	return false
+}
+
+func (x *CSVDialect) GetProductColumn() string {
+	if x != nil {
+		return x.ProductColumn
+	}
+	return ""
+}
+
+func (x *CSVDialect) GetPriceColumn() string {
+	if x != nil {
+		return x.PriceColumn
+	}
+	return ""
+}
+
+func (x *CSVDialect) GetIgnoreColumns() []string {
+	if x != nil {
+		return x.IgnoreColumns
+	}
+	return nil
+}
+
+func (x *CSVDialect) GetDecimalSeparator() string {
+	if x != nil {
+		return x.DecimalSeparator
+	}
+	return ""
+}
+
+func (x *CSVDialect) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CSVDialect) GetCurrencyColumn() string {
+	if x != nil {
+		return x.CurrencyColumn
+	}
+	return ""
+}
+
+// CSVFetcher.Upload response message.
+type CSVUploadResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pagination      *PaginationParams `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`                                                       // pagination params
-	SortByName      SortOrder         `protobuf:"varint,2,opt,name=sort_by_name,json=sortByName,proto3,enum=v1.SortOrder" json:"sort_by_name,omitempty"`                // (optional) sort by product names option
-	SortByPrice     SortOrder         `protobuf:"varint,3,opt,name=sort_by_price,json=sortByPrice,proto3,enum=v1.SortOrder" json:"sort_by_price,omitempty"`             // (optional) sort by prices option
-	SortByTimestamp SortOrder         `protobuf:"varint,4,opt,name=sort_by_timestamp,json=sortByTimestamp,proto3,enum=v1.SortOrder" json:"sort_by_timestamp,omitempty"` // (optional) sort by timestamp option
+	Job *ImportJob `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"` // finished import job
 }
 
-func (x *ListRequest) Reset() {
-	*x = ListRequest{}
+func (x *CSVUploadResponse) Reset() {
+	*x = CSVUploadResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -303,13 +672,13 @@ func (x *ListRequest) Reset() {
 	}
 }
 
-func (x *ListRequest) String() string {
+func (x *CSVUploadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRequest) ProtoMessage() {}
+func (*CSVUploadResponse) ProtoMessage() {}
 
-func (x *ListRequest) ProtoReflect() protoreflect.Message {
+func (x *CSVUploadResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -321,65 +690,135 @@ func (x *ListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRequest.ProtoReflect.Descriptor instead.
-func (*ListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CSVUploadResponse.ProtoReflect.Descriptor instead.
+func (*CSVUploadResponse) Descriptor() ([]byte, []int) {
 	return file_v1_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ListRequest) GetPagination() *PaginationParams {
+func (x *CSVUploadResponse) GetJob() *ImportJob {
 	if x != nil {
-		return x.Pagination
+		return x.Job
 	}
 	return nil
 }
 
-func (x *ListRequest) GetSortByName() SortOrder {
-	if x != nil {
-		return x.SortByName
-	}
-	return SortOrder_Undefined
+// Import job failed chunk errors.
+type ImportChunkError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChunkId        int32    `protobuf:"varint,1,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`                     // chunk sequence number
+	ArchiveEntry   string   `protobuf:"bytes,4,opt,name=archive_entry,json=archiveEntry,proto3" json:"archive_entry,omitempty"`       // archive CSV-file name (for archived sources only)
+	ParsingErrors  []string `protobuf:"bytes,2,rep,name=parsing_errors,json=parsingErrors,proto3" json:"parsing_errors,omitempty"`    // CSV-rows parsing errors
+	ExecutionError string   `protobuf:"bytes,3,opt,name=execution_error,json=executionError,proto3" json:"execution_error,omitempty"` // chunk import error
 }
 
-func (x *ListRequest) GetSortByPrice() SortOrder {
-	if x != nil {
-		return x.SortByPrice
+func (x *ImportChunkError) Reset() {
+	*x = ImportChunkError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return SortOrder_Undefined
 }
 
-func (x *ListRequest) GetSortByTimestamp() SortOrder {
+func (x *ImportChunkError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportChunkError) ProtoMessage() {}
+
+func (x *ImportChunkError) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportChunkError.ProtoReflect.Descriptor instead.
+func (*ImportChunkError) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ImportChunkError) GetChunkId() int32 {
 	if x != nil {
-		return x.SortByTimestamp
+		return x.ChunkId
 	}
-	return SortOrder_Undefined
+	return 0
 }
 
-// PriceEntryReader.List response message.
-type ListResponse struct {
+func (x *ImportChunkError) GetArchiveEntry() string {
+	if x != nil {
+		return x.ArchiveEntry
+	}
+	return ""
+}
+
+func (x *ImportChunkError) GetParsingErrors() []string {
+	if x != nil {
+		return x.ParsingErrors
+	}
+	return nil
+}
+
+func (x *ImportChunkError) GetExecutionError() string {
+	if x != nil {
+		return x.ExecutionError
+	}
+	return ""
+}
+
+// Import job state and progress.
+type ImportJob struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entries []*PriceEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Id                string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                            // job ID
+	Url               string                `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`                                                          // CSV-file URL
+	State             ImportJobState        `protobuf:"varint,3,opt,name=state,proto3,enum=v1.ImportJobState" json:"state,omitempty"`                              // current job state
+	ImportTimestamp   int64                 `protobuf:"varint,4,opt,name=import_timestamp,json=importTimestamp,proto3" json:"import_timestamp,omitempty"`          // prices import timestamp (UNIX-time) [s], set once file is downloaded
+	ChunksProcessed   int32                 `protobuf:"varint,5,opt,name=chunks_processed,json=chunksProcessed,proto3" json:"chunks_processed,omitempty"`          // number of processed chunks (including failed ones)
+	ChunksFailed      int32                 `protobuf:"varint,6,opt,name=chunks_failed,json=chunksFailed,proto3" json:"chunks_failed,omitempty"`                   // number of failed chunks
+	EntriesProcessed  int32                 `protobuf:"varint,7,opt,name=entries_processed,json=entriesProcessed,proto3" json:"entries_processed,omitempty"`       // number of imported CSV entries
+	ChunkErrors       []*ImportChunkError   `protobuf:"bytes,8,rep,name=chunk_errors,json=chunkErrors,proto3" json:"chunk_errors,omitempty"`                       // failed chunks errors
+	Error             string                `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`                                                      // job error (for the Failed state)
+	CreatedAt         int64                 `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                           // job create timestamp (UNIX-time) [s]
+	UpdatedAt         int64                 `protobuf:"varint,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                           // job last update timestamp (UNIX-time) [s]
+	Force             bool                  `protobuf:"varint,12,opt,name=force,proto3" json:"force,omitempty"`                                                    // forced re-import requested
+	ContentHash       string                `protobuf:"bytes,13,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`                      // downloaded file content SHA-256 hash (HEX)
+	Etag              string                `protobuf:"bytes,14,opt,name=etag,proto3" json:"etag,omitempty"`                                                       // downloaded file HTTP ETag (if provided)
+	Size              int64                 `protobuf:"varint,15,opt,name=size,proto3" json:"size,omitempty"`                                                      // downloaded file size [bytes]
+	Dialect           *CSVDialect           `protobuf:"bytes,16,opt,name=dialect,proto3" json:"dialect,omitempty"`                                                 // CSV-file format
+	Compression       string                `protobuf:"bytes,17,opt,name=compression,proto3" json:"compression,omitempty"`                                         // downloaded file compression / archive format (gzip, zstd, zip; empty for plain CSV-files)
+	ArchiveEntries    []*ImportArchiveEntry `protobuf:"bytes,18,rep,name=archive_entries,json=archiveEntries,proto3" json:"archive_entries,omitempty"`             // processed archive CSV-files (for archived sources only)
+	Atomicity         string                `protobuf:"bytes,19,opt,name=atomicity,proto3" json:"atomicity,omitempty"`                                             // import data commit mode (none, chunk, file)
+	ImportTimestampMs int64                 `protobuf:"varint,20,opt,name=import_timestamp_ms,json=importTimestampMs,proto3" json:"import_timestamp_ms,omitempty"` // prices import timestamp (UNIX-time) [ms], set once file is downloaded
 }
 
-func (x *ListResponse) Reset() {
-	*x = ListResponse{}
+func (x *ImportJob) Reset() {
+	*x = ImportJob{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_proto_msgTypes[5]
+		mi := &file_v1_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListResponse) String() string {
+func (x *ImportJob) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListResponse) ProtoMessage() {}
+func (*ImportJob) ProtoMessage() {}
 
-func (x *ListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_proto_msgTypes[5]
+func (x *ImportJob) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -390,152 +829,4227 @@ func (x *ListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListResponse.ProtoReflect.Descriptor instead.
-func (*ListResponse) Descriptor() ([]byte, []int) {
-	return file_v1_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ImportJob.ProtoReflect.Descriptor instead.
+func (*ImportJob) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ListResponse) GetEntries() []*PriceEntry {
+func (x *ImportJob) GetId() string {
 	if x != nil {
-		return x.Entries
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImportJob) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ImportJob) GetState() ImportJobState {
+	if x != nil {
+		return x.State
+	}
+	return ImportJobState_Queued
+}
+
+func (x *ImportJob) GetImportTimestamp() int64 {
+	if x != nil {
+		return x.ImportTimestamp
+	}
+	return 0
+}
+
+func (x *ImportJob) GetChunksProcessed() int32 {
+	if x != nil {
+		return x.ChunksProcessed
+	}
+	return 0
+}
+
+func (x *ImportJob) GetChunksFailed() int32 {
+	if x != nil {
+		return x.ChunksFailed
+	}
+	return 0
+}
+
+func (x *ImportJob) GetEntriesProcessed() int32 {
+	if x != nil {
+		return x.EntriesProcessed
+	}
+	return 0
+}
+
+func (x *ImportJob) GetChunkErrors() []*ImportChunkError {
+	if x != nil {
+		return x.ChunkErrors
 	}
 	return nil
 }
 
-var File_v1_proto protoreflect.FileDescriptor
+func (x *ImportJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
 
-var file_v1_proto_rawDesc = []byte{
-	0x0a, 0x08, 0x76, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x76, 0x31, 0x22, 0x23,
-	0x0a, 0x0f, 0x43, 0x53, 0x56, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x75, 0x72, 0x6c, 0x22, 0x12, 0x0a, 0x10, 0x43, 0x53, 0x56, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x0a, 0x10, 0x50, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73,
-	0x6b, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x12,
-	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x63, 0x0a, 0x0a, 0x50, 0x72, 0x69, 0x63, 0x65, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0xe2, 0x01, 0x0a, 0x0b, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x2f, 0x0a, 0x0c, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x74,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x31, 0x0a, 0x0d, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x5f, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f,
-	0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0b, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x11, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0f,
-	0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22,
-	0x38, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x28, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x2a, 0x2d, 0x0a, 0x09, 0x53, 0x6f, 0x72,
-	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x6e, 0x64, 0x65, 0x66, 0x69,
-	0x6e, 0x65, 0x64, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x73, 0x63, 0x10, 0x01, 0x12, 0x08,
-	0x0a, 0x04, 0x44, 0x65, 0x73, 0x63, 0x10, 0x02, 0x32, 0x42, 0x0a, 0x0a, 0x43, 0x53, 0x56, 0x46,
-	0x65, 0x74, 0x63, 0x68, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x05, 0x46, 0x65, 0x74, 0x63, 0x68, 0x12,
-	0x13, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x53, 0x56, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x53, 0x56, 0x46, 0x65, 0x74,
-	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x3f, 0x0a, 0x10,
-	0x50, 0x72, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x12, 0x2b, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *ImportJob) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
 }
 
-var (
-	file_v1_proto_rawDescOnce sync.Once
-	file_v1_proto_rawDescData = file_v1_proto_rawDesc
-)
+func (x *ImportJob) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
 
-func file_v1_proto_rawDescGZIP() []byte {
-	file_v1_proto_rawDescOnce.Do(func() {
-		file_v1_proto_rawDescData = protoimpl.X.CompressGZIP(file_v1_proto_rawDescData)
-	})
-	return file_v1_proto_rawDescData
+func (x *ImportJob) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
 }
 
-var file_v1_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_v1_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
-var file_v1_proto_goTypes = []interface{}{
-	(SortOrder)(0),           // 0: v1.SortOrder
-	(*CSVFetchRequest)(nil),  // 1: v1.CSVFetchRequest
-	(*CSVFetchResponse)(nil), // 2: v1.CSVFetchResponse
-	(*PaginationParams)(nil), // 3: v1.PaginationParams
-	(*PriceEntry)(nil),       // 4: v1.PriceEntry
-	(*ListRequest)(nil),      // 5: v1.ListRequest
-	(*ListResponse)(nil),     // 6: v1.ListResponse
+func (x *ImportJob) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
 }
-var file_v1_proto_depIdxs = []int32{
-	3, // 0: v1.ListRequest.pagination:type_name -> v1.PaginationParams
-	0, // 1: v1.ListRequest.sort_by_name:type_name -> v1.SortOrder
-	0, // 2: v1.ListRequest.sort_by_price:type_name -> v1.SortOrder
-	0, // 3: v1.ListRequest.sort_by_timestamp:type_name -> v1.SortOrder
-	4, // 4: v1.ListResponse.entries:type_name -> v1.PriceEntry
-	1, // 5: v1.CSVFetcher.Fetch:input_type -> v1.CSVFetchRequest
-	5, // 6: v1.PriceEntryReader.List:input_type -> v1.ListRequest
-	2, // 7: v1.CSVFetcher.Fetch:output_type -> v1.CSVFetchResponse
-	6, // 8: v1.PriceEntryReader.List:output_type -> v1.ListResponse
-	7, // [7:9] is the sub-list for method output_type
-	5, // [5:7] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+
+func (x *ImportJob) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
 }
 
-func init() { file_v1_proto_init() }
-func file_v1_proto_init() {
-	if File_v1_proto != nil {
-		return
+func (x *ImportJob) GetSize() int64 {
+	if x != nil {
+		return x.Size
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_v1_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CSVFetchRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return 0
+}
+
+func (x *ImportJob) GetDialect() *CSVDialect {
+	if x != nil {
+		return x.Dialect
+	}
+	return nil
+}
+
+func (x *ImportJob) GetCompression() string {
+	if x != nil {
+		return x.Compression
+	}
+	return ""
+}
+
+func (x *ImportJob) GetArchiveEntries() []*ImportArchiveEntry {
+	if x != nil {
+		return x.ArchiveEntries
+	}
+	return nil
+}
+
+func (x *ImportJob) GetAtomicity() string {
+	if x != nil {
+		return x.Atomicity
+	}
+	return ""
+}
+
+func (x *ImportJob) GetImportTimestampMs() int64 {
+	if x != nil {
+		return x.ImportTimestampMs
+	}
+	return 0
+}
+
+// Import job processed archive CSV-file (every one is imported separately).
+type ImportArchiveEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name              string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                       // archive CSV-file name
+	ImportTimestamp   int64  `protobuf:"varint,2,opt,name=import_timestamp,json=importTimestamp,proto3" json:"import_timestamp,omitempty"`         // prices import timestamp (UNIX-time) [s]
+	ImportTimestampMs int64  `protobuf:"varint,3,opt,name=import_timestamp_ms,json=importTimestampMs,proto3" json:"import_timestamp_ms,omitempty"` // prices import timestamp (UNIX-time) [ms]
+}
+
+func (x *ImportArchiveEntry) Reset() {
+	*x = ImportArchiveEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportArchiveEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportArchiveEntry) ProtoMessage() {}
+
+func (x *ImportArchiveEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_v1_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CSVFetchResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportArchiveEntry.ProtoReflect.Descriptor instead.
+func (*ImportArchiveEntry) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ImportArchiveEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportArchiveEntry) GetImportTimestamp() int64 {
+	if x != nil {
+		return x.ImportTimestamp
+	}
+	return 0
+}
+
+func (x *ImportArchiveEntry) GetImportTimestampMs() int64 {
+	if x != nil {
+		return x.ImportTimestampMs
+	}
+	return 0
+}
+
+// CSVFetcher.GetImportJob request message.
+type GetImportJobRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"` // import job ID
+}
+
+func (x *GetImportJobRequest) Reset() {
+	*x = GetImportJobRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetImportJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetImportJobRequest) ProtoMessage() {}
+
+func (x *GetImportJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_v1_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PaginationParams); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetImportJobRequest.ProtoReflect.Descriptor instead.
+func (*GetImportJobRequest) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetImportJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+// CSVFetcher.ListImportJobs request message.
+type ListImportJobsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *PaginationParams `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"` // pagination params
+}
+
+func (x *ListImportJobsRequest) Reset() {
+	*x = ListImportJobsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListImportJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListImportJobsRequest) ProtoMessage() {}
+
+func (x *ListImportJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListImportJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListImportJobsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListImportJobsRequest) GetPagination() *PaginationParams {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// CSVFetcher.ListImportJobs response message.
+type ListImportJobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jobs []*ImportJob `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+}
+
+func (x *ListImportJobsResponse) Reset() {
+	*x = ListImportJobsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListImportJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListImportJobsResponse) ProtoMessage() {}
+
+func (x *ListImportJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListImportJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListImportJobsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListImportJobsResponse) GetJobs() []*ImportJob {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+// Prices import deletion params.
+type ImportRollbackParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Actor                string `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`                                                              // operation initiator (recorded to the audit trail)
+	Reason               string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`                                                            // (optional) operation reason
+	DeleteOrphanProducts bool   `protobuf:"varint,3,opt,name=delete_orphan_products,json=deleteOrphanProducts,proto3" json:"delete_orphan_products,omitempty"` // (optional) delete products that have no prices left
+}
+
+func (x *ImportRollbackParams) Reset() {
+	*x = ImportRollbackParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportRollbackParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRollbackParams) ProtoMessage() {}
+
+func (x *ImportRollbackParams) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRollbackParams.ProtoReflect.Descriptor instead.
+func (*ImportRollbackParams) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ImportRollbackParams) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ImportRollbackParams) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ImportRollbackParams) GetDeleteOrphanProducts() bool {
+	if x != nil {
+		return x.DeleteOrphanProducts
+	}
+	return false
+}
+
+// CSVFetcher.DeleteImport request message.
+type DeleteImportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp   int64                 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                        // prices import timestamp (UNIX-time) [s], matches the only import within the second
+	Params      *ImportRollbackParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`                               // deletion params
+	TimestampMs int64                 `protobuf:"varint,3,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"` // (optional) exact prices import timestamp (UNIX-time) [ms], takes precedence over the timestamp
+}
+
+func (x *DeleteImportRequest) Reset() {
+	*x = DeleteImportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteImportRequest) ProtoMessage() {}
+
+func (x *DeleteImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteImportRequest.ProtoReflect.Descriptor instead.
+func (*DeleteImportRequest) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteImportRequest) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *DeleteImportRequest) GetParams() *ImportRollbackParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *DeleteImportRequest) GetTimestampMs() int64 {
+	if x != nil {
+		return x.TimestampMs
+	}
+	return 0
+}
+
+// CSVFetcher.DeleteImportByJobID request message.
+type DeleteImportByJobIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId  string                `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"` // finished import job ID
+	Params *ImportRollbackParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`            // deletion params
+}
+
+func (x *DeleteImportByJobIDRequest) Reset() {
+	*x = DeleteImportByJobIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteImportByJobIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteImportByJobIDRequest) ProtoMessage() {}
+
+func (x *DeleteImportByJobIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteImportByJobIDRequest.ProtoReflect.Descriptor instead.
+func (*DeleteImportByJobIDRequest) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteImportByJobIDRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *DeleteImportByJobIDRequest) GetParams() *ImportRollbackParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// Prices import management operation audit record.
+type ImportAuditEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                  string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                                 // record ID
+	Action              string  `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`                                                         // operation type (delete)
+	Actor               string  `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`                                                           // operation initiator
+	ClientAddr          string  `protobuf:"bytes,4,opt,name=client_addr,json=clientAddr,proto3" json:"client_addr,omitempty"`                               // operation initiator network address
+	Reason              string  `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`                                                         // operation reason
+	JobId               string  `protobuf:"bytes,6,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`                                              // import job ID (if the operation targets a job)
+	Timestamps          []int64 `protobuf:"varint,7,rep,packed,name=timestamps,proto3" json:"timestamps,omitempty"`                                         // affected prices import timestamps (UNIX-time) [s]
+	DeletedPriceImports int64   `protobuf:"varint,8,opt,name=deleted_price_imports,json=deletedPriceImports,proto3" json:"deleted_price_imports,omitempty"` // number of deleted product prices imports
+	DeletedProducts     int64   `protobuf:"varint,9,opt,name=deleted_products,json=deletedProducts,proto3" json:"deleted_products,omitempty"`               // number of deleted orphan products
+	CreatedAt           int64   `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                // record create timestamp (UNIX-time) [s]
+}
+
+func (x *ImportAuditEntry) Reset() {
+	*x = ImportAuditEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportAuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportAuditEntry) ProtoMessage() {}
+
+func (x *ImportAuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportAuditEntry.ProtoReflect.Descriptor instead.
+func (*ImportAuditEntry) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ImportAuditEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImportAuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ImportAuditEntry) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ImportAuditEntry) GetClientAddr() string {
+	if x != nil {
+		return x.ClientAddr
+	}
+	return ""
+}
+
+func (x *ImportAuditEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ImportAuditEntry) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *ImportAuditEntry) GetTimestamps() []int64 {
+	if x != nil {
+		return x.Timestamps
+	}
+	return nil
+}
+
+func (x *ImportAuditEntry) GetDeletedPriceImports() int64 {
+	if x != nil {
+		return x.DeletedPriceImports
+	}
+	return 0
+}
+
+func (x *ImportAuditEntry) GetDeletedProducts() int64 {
+	if x != nil {
+		return x.DeletedProducts
+	}
+	return 0
+}
+
+func (x *ImportAuditEntry) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// CSVFetcher.DeleteImport / DeleteImportByJobID response message.
+type DeleteImportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Audit *ImportAuditEntry `protobuf:"bytes,1,opt,name=audit,proto3" json:"audit,omitempty"` // recorded audit entry
+}
+
+func (x *DeleteImportResponse) Reset() {
+	*x = DeleteImportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteImportResponse) ProtoMessage() {}
+
+func (x *DeleteImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteImportResponse.ProtoReflect.Descriptor instead.
+func (*DeleteImportResponse) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteImportResponse) GetAudit() *ImportAuditEntry {
+	if x != nil {
+		return x.Audit
+	}
+	return nil
+}
+
+// CSVFetcher.ListImportAudit request message.
+type ListImportAuditRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *PaginationParams `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"` // pagination params
+}
+
+func (x *ListImportAuditRequest) Reset() {
+	*x = ListImportAuditRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListImportAuditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListImportAuditRequest) ProtoMessage() {}
+
+func (x *ListImportAuditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListImportAuditRequest.ProtoReflect.Descriptor instead.
+func (*ListImportAuditRequest) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListImportAuditRequest) GetPagination() *PaginationParams {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// CSVFetcher.ListImportAudit response message.
+type ListImportAuditResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*ImportAuditEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *ListImportAuditResponse) Reset() {
+	*x = ListImportAuditResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListImportAuditResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListImportAuditResponse) ProtoMessage() {}
+
+func (x *ListImportAuditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListImportAuditResponse.ProtoReflect.Descriptor instead.
+func (*ListImportAuditResponse) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListImportAuditResponse) GetEntries() []*ImportAuditEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// Params for pagination supported requests.
+type PaginationParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Skip  uint32 `protobuf:"varint,1,opt,name=skip,proto3" json:"skip,omitempty"`   // (optional) number of skipped response entries
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // (optional) number of response entries per request (default: 50)
+}
+
+func (x *PaginationParams) Reset() {
+	*x = PaginationParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaginationParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaginationParams) ProtoMessage() {}
+
+func (x *PaginationParams) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaginationParams.ProtoReflect.Descriptor instead.
+func (*PaginationParams) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PaginationParams) GetSkip() uint32 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+func (x *PaginationParams) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Message for price entries request.
+type PriceEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductName string `protobuf:"bytes,1,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"` // product name
+	Timestamp   int64  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                       // price change timestamp (UNIX-time) [s]
+	Price       string `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`                                // exact decimal price value (like "12.99")
+	Currency    string `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`                          // price ISO-4217 currency code (empty for legacy integer prices)
+}
+
+func (x *PriceEntry) Reset() {
+	*x = PriceEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceEntry) ProtoMessage() {}
+
+func (x *PriceEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceEntry.ProtoReflect.Descriptor instead.
+func (*PriceEntry) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PriceEntry) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *PriceEntry) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *PriceEntry) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *PriceEntry) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// PriceEntryReader.List request message.
+type ListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination      *PaginationParams   `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`                                                       // pagination params
+	SortByName      SortOrder           `protobuf:"varint,2,opt,name=sort_by_name,json=sortByName,proto3,enum=v1.SortOrder" json:"sort_by_name,omitempty"`                // (optional) sort by product names option
+	SortByPrice     SortOrder           `protobuf:"varint,3,opt,name=sort_by_price,json=sortByPrice,proto3,enum=v1.SortOrder" json:"sort_by_price,omitempty"`             // (optional) sort by prices option
+	SortByTimestamp SortOrder           `protobuf:"varint,4,opt,name=sort_by_timestamp,json=sortByTimestamp,proto3,enum=v1.SortOrder" json:"sort_by_timestamp,omitempty"` // (optional) sort by timestamp option
+	Filter          *PriceEntriesFilter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`                                                               // (optional) filter params
+	PageToken       string              `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`                                        // (optional) continuation token from the previous page response (keyset pagination: pagination.skip should be 0, the same sort and filter params should be used)
+	SkipTotalCount  bool                `protobuf:"varint,7,opt,name=skip_total_count,json=skipTotalCount,proto3" json:"skip_total_count,omitempty"`                      // (optional) do not calculate the total number of filtered entries (faster for large collections)
+}
+
+func (x *ListRequest) Reset() {
+	*x = ListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRequest) ProtoMessage() {}
+
+func (x *ListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRequest.ProtoReflect.Descriptor instead.
+func (*ListRequest) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListRequest) GetPagination() *PaginationParams {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListRequest) GetSortByName() SortOrder {
+	if x != nil {
+		return x.SortByName
+	}
+	return SortOrder_Undefined
+}
+
+func (x *ListRequest) GetSortByPrice() SortOrder {
+	if x != nil {
+		return x.SortByPrice
+	}
+	return SortOrder_Undefined
+}
+
+func (x *ListRequest) GetSortByTimestamp() SortOrder {
+	if x != nil {
+		return x.SortByTimestamp
+	}
+	return SortOrder_Undefined
+}
+
+func (x *ListRequest) GetFilter() *PriceEntriesFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListRequest) GetSkipTotalCount() bool {
+	if x != nil {
+		return x.SkipTotalCount
+	}
+	return false
+}
+
+// Applied sort field.
+type SortField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field string    `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`                    // sort field name (product_name, price, timestamp)
+	Order SortOrder `protobuf:"varint,2,opt,name=order,proto3,enum=v1.SortOrder" json:"order,omitempty"` // sort order
+}
+
+func (x *SortField) Reset() {
+	*x = SortField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SortField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortField) ProtoMessage() {}
+
+func (x *SortField) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortField.ProtoReflect.Descriptor instead.
+func (*SortField) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SortField) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *SortField) GetOrder() SortOrder {
+	if x != nil {
+		return x.Order
+	}
+	return SortOrder_Undefined
+}
+
+// Price entries filter params (set ones are combined with AND).
+type PriceEntriesFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductName       string `protobuf:"bytes,1,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`                     // (optional) exact product name
+	ProductNamePrefix string `protobuf:"bytes,2,opt,name=product_name_prefix,json=productNamePrefix,proto3" json:"product_name_prefix,omitempty"` // (optional) product name prefix
+	ProductNameRegex  string `protobuf:"bytes,3,opt,name=product_name_regex,json=productNameRegex,proto3" json:"product_name_regex,omitempty"`    // (optional) product name regular expression (PCRE)
+	PriceMin          string `protobuf:"bytes,4,opt,name=price_min,json=priceMin,proto3" json:"price_min,omitempty"`                              // (optional) min price decimal value of the currency (inclusive)
+	PriceMax          string `protobuf:"bytes,5,opt,name=price_max,json=priceMax,proto3" json:"price_max,omitempty"`                              // (optional) max price decimal value of the currency (inclusive)
+	TimestampFrom     int64  `protobuf:"varint,6,opt,name=timestamp_from,json=timestampFrom,proto3" json:"timestamp_from,omitempty"`              // (optional) prices import timestamp range start (UNIX-time) [s], inclusive
+	TimestampTo       int64  `protobuf:"varint,7,opt,name=timestamp_to,json=timestampTo,proto3" json:"timestamp_to,omitempty"`                    // (optional) prices import timestamp range end (UNIX-time) [s], exclusive
+	Currency          string `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`                                              // (optional) ISO-4217 price currency code, required for price_min / price_max
+}
+
+func (x *PriceEntriesFilter) Reset() {
+	*x = PriceEntriesFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceEntriesFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceEntriesFilter) ProtoMessage() {}
+
+func (x *PriceEntriesFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceEntriesFilter.ProtoReflect.Descriptor instead.
+func (*PriceEntriesFilter) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PriceEntriesFilter) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *PriceEntriesFilter) GetProductNamePrefix() string {
+	if x != nil {
+		return x.ProductNamePrefix
+	}
+	return ""
+}
+
+func (x *PriceEntriesFilter) GetProductNameRegex() string {
+	if x != nil {
+		return x.ProductNameRegex
+	}
+	return ""
+}
+
+func (x *PriceEntriesFilter) GetPriceMin() string {
+	if x != nil {
+		return x.PriceMin
+	}
+	return ""
+}
+
+func (x *PriceEntriesFilter) GetPriceMax() string {
+	if x != nil {
+		return x.PriceMax
+	}
+	return ""
+}
+
+func (x *PriceEntriesFilter) GetTimestampFrom() int64 {
+	if x != nil {
+		return x.TimestampFrom
+	}
+	return 0
+}
+
+func (x *PriceEntriesFilter) GetTimestampTo() int64 {
+	if x != nil {
+		return x.TimestampTo
+	}
+	return 0
+}
+
+func (x *PriceEntriesFilter) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// PriceEntryReader.List response message.
+type ListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries       []*PriceEntry       `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string              `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // continuation token for the next page request (empty if there are no more entries)
+	TotalCount    int64               `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`           // total number of filtered entries (-1 if skip_total_count is set)
+	HasMore       bool                `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`                    // there are more entries after the page
+	Filter        *PriceEntriesFilter `protobuf:"bytes,5,opt,name=filter,proto3" json:"filter,omitempty"`                                      // applied (normalized) filter params
+	Sort          []*SortField        `protobuf:"bytes,6,rep,name=sort,proto3" json:"sort,omitempty"`                                          // applied sort fields (in the priority order)
+}
+
+func (x *ListResponse) Reset() {
+	*x = ListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResponse) ProtoMessage() {}
+
+func (x *ListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResponse.ProtoReflect.Descriptor instead.
+func (*ListResponse) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListResponse) GetEntries() []*PriceEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+func (x *ListResponse) GetFilter() *PriceEntriesFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListResponse) GetSort() []*SortField {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+// PriceEntryReader.Stream request message.
+type StreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SortByName      SortOrder           `protobuf:"varint,1,opt,name=sort_by_name,json=sortByName,proto3,enum=v1.SortOrder" json:"sort_by_name,omitempty"`                // (optional) sort by product names option
+	SortByPrice     SortOrder           `protobuf:"varint,2,opt,name=sort_by_price,json=sortByPrice,proto3,enum=v1.SortOrder" json:"sort_by_price,omitempty"`             // (optional) sort by prices option
+	SortByTimestamp SortOrder           `protobuf:"varint,3,opt,name=sort_by_timestamp,json=sortByTimestamp,proto3,enum=v1.SortOrder" json:"sort_by_timestamp,omitempty"` // (optional) sort by timestamp option
+	Filter          *PriceEntriesFilter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`                                                               // (optional) filter params
+	Limit           int64               `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`                                                                // (optional) max number of entries to send (0 - no limit)
+}
+
+func (x *StreamRequest) Reset() {
+	*x = StreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamRequest) ProtoMessage() {}
+
+func (x *StreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamRequest.ProtoReflect.Descriptor instead.
+func (*StreamRequest) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *StreamRequest) GetSortByName() SortOrder {
+	if x != nil {
+		return x.SortByName
+	}
+	return SortOrder_Undefined
+}
+
+func (x *StreamRequest) GetSortByPrice() SortOrder {
+	if x != nil {
+		return x.SortByPrice
+	}
+	return SortOrder_Undefined
+}
+
+func (x *StreamRequest) GetSortByTimestamp() SortOrder {
+	if x != nil {
+		return x.SortByTimestamp
+	}
+	return SortOrder_Undefined
+}
+
+func (x *StreamRequest) GetFilter() *PriceEntriesFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *StreamRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Price value.
+type PriceValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Price    string `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`       // exact decimal price value (like "12.99")
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"` // price ISO-4217 currency code (empty for legacy integer prices)
+}
+
+func (x *PriceValue) Reset() {
+	*x = PriceValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceValue) ProtoMessage() {}
+
+func (x *PriceValue) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceValue.ProtoReflect.Descriptor instead.
+func (*PriceValue) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PriceValue) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *PriceValue) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// Product latest prices.
+type LatestPrices struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductName string        `protobuf:"bytes,1,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"` // product name
+	Timestamp   int64         `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                       // the most recent prices import timestamp (UNIX-time) [s]
+	Prices      []*PriceValue `protobuf:"bytes,3,rep,name=prices,proto3" json:"prices,omitempty"`                              // the most recent prices import prices
+}
+
+func (x *LatestPrices) Reset() {
+	*x = LatestPrices{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LatestPrices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatestPrices) ProtoMessage() {}
+
+func (x *LatestPrices) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatestPrices.ProtoReflect.Descriptor instead.
+func (*LatestPrices) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *LatestPrices) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *LatestPrices) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *LatestPrices) GetPrices() []*PriceValue {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+// PriceEntryReader.GetLatestPrices request message.
+type GetLatestPricesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination   *PaginationParams `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`                         // pagination params
+	ProductNames []string          `protobuf:"bytes,2,rep,name=product_names,json=productNames,proto3" json:"product_names,omitempty"` // (optional) exact product names filter
+}
+
+func (x *GetLatestPricesRequest) Reset() {
+	*x = GetLatestPricesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLatestPricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLatestPricesRequest) ProtoMessage() {}
+
+func (x *GetLatestPricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLatestPricesRequest.ProtoReflect.Descriptor instead.
+func (*GetLatestPricesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetLatestPricesRequest) GetPagination() *PaginationParams {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetLatestPricesRequest) GetProductNames() []string {
+	if x != nil {
+		return x.ProductNames
+	}
+	return nil
+}
+
+// PriceEntryReader.GetLatestPrices response message.
+type GetLatestPricesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*LatestPrices `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"` // products latest prices (sorted by product name)
+}
+
+func (x *GetLatestPricesResponse) Reset() {
+	*x = GetLatestPricesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLatestPricesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLatestPricesResponse) ProtoMessage() {}
+
+func (x *GetLatestPricesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLatestPricesResponse.ProtoReflect.Descriptor instead.
+func (*GetLatestPricesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetLatestPricesResponse) GetEntries() []*LatestPrices {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// PriceEntryReader.GetProductHistory request message.
+type GetProductHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductName   string       `protobuf:"bytes,1,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`        // exact product name
+	TimestampFrom int64        `protobuf:"varint,2,opt,name=timestamp_from,json=timestampFrom,proto3" json:"timestamp_from,omitempty"` // (optional) prices import timestamp range start (UNIX-time) [s], inclusive
+	TimestampTo   int64        `protobuf:"varint,3,opt,name=timestamp_to,json=timestampTo,proto3" json:"timestamp_to,omitempty"`       // (optional) prices import timestamp range end (UNIX-time) [s], exclusive
+	BucketSize    int64        `protobuf:"varint,4,opt,name=bucket_size,json=bucketSize,proto3" json:"bucket_size,omitempty"`          // (optional) downsampling bucket size [s] (buckets are aligned to UNIX epoch)
+	Downsampling  Downsampling `protobuf:"varint,5,opt,name=downsampling,proto3,enum=v1.Downsampling" json:"downsampling,omitempty"`   // (optional) downsampling function (required if bucket_size is set)
+}
+
+func (x *GetProductHistoryRequest) Reset() {
+	*x = GetProductHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProductHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductHistoryRequest) ProtoMessage() {}
+
+func (x *GetProductHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetProductHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetProductHistoryRequest) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *GetProductHistoryRequest) GetTimestampFrom() int64 {
+	if x != nil {
+		return x.TimestampFrom
+	}
+	return 0
+}
+
+func (x *GetProductHistoryRequest) GetTimestampTo() int64 {
+	if x != nil {
+		return x.TimestampTo
+	}
+	return 0
+}
+
+func (x *GetProductHistoryRequest) GetBucketSize() int64 {
+	if x != nil {
+		return x.BucketSize
+	}
+	return 0
+}
+
+func (x *GetProductHistoryRequest) GetDownsampling() Downsampling {
+	if x != nil {
+		return x.Downsampling
+	}
+	return Downsampling_NoDownsampling
+}
+
+// Product price history point.
+type PriceHistoryPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp int64       `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // prices import timestamp (bucket start if downsampled) (UNIX-time) [s]
+	Price     *PriceValue `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`          // price
+}
+
+func (x *PriceHistoryPoint) Reset() {
+	*x = PriceHistoryPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceHistoryPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceHistoryPoint) ProtoMessage() {}
+
+func (x *PriceHistoryPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceHistoryPoint.ProtoReflect.Descriptor instead.
+func (*PriceHistoryPoint) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PriceHistoryPoint) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *PriceHistoryPoint) GetPrice() *PriceValue {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+// PriceEntryReader.GetProductHistory response message.
+type GetProductHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Points []*PriceHistoryPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"` // chronological prices series (a single price per bucket and currency if downsampled)
+}
+
+func (x *GetProductHistoryResponse) Reset() {
+	*x = GetProductHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProductHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductHistoryResponse) ProtoMessage() {}
+
+func (x *GetProductHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetProductHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetProductHistoryResponse) GetPoints() []*PriceHistoryPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+// PriceEntryReader.Stats request message.
+type StatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *PaginationParams   `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"` // pagination params
+	Filter     *PriceEntriesFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`         // (optional) filter params (applied to price points)
+}
+
+func (x *StatsRequest) Reset() {
+	*x = StatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsRequest) ProtoMessage() {}
+
+func (x *StatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsRequest.ProtoReflect.Descriptor instead.
+func (*StatsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *StatsRequest) GetPagination() *PaginationParams {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *StatsRequest) GetFilter() *PriceEntriesFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// Product prices statistics.
+type PriceStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductName  string  `protobuf:"bytes,1,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`     // product name
+	Currency     string  `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`                              // prices ISO-4217 currency code (prices of different currencies are aggregated separately)
+	Min          string  `protobuf:"bytes,3,opt,name=min,proto3" json:"min,omitempty"`                                        // min price decimal value
+	Max          string  `protobuf:"bytes,4,opt,name=max,proto3" json:"max,omitempty"`                                        // max price decimal value
+	Mean         string  `protobuf:"bytes,5,opt,name=mean,proto3" json:"mean,omitempty"`                                      // mean price decimal value
+	Median       string  `protobuf:"bytes,6,opt,name=median,proto3" json:"median,omitempty"`                                  // median price decimal value
+	StdDev       float64 `protobuf:"fixed64,7,opt,name=std_dev,json=stdDev,proto3" json:"std_dev,omitempty"`                  // population standard deviation
+	PricesCount  int64   `protobuf:"varint,8,opt,name=prices_count,json=pricesCount,proto3" json:"prices_count,omitempty"`    // number of price points
+	ImportsCount int64   `protobuf:"varint,9,opt,name=imports_count,json=importsCount,proto3" json:"imports_count,omitempty"` // number of price imports
+}
+
+func (x *PriceStats) Reset() {
+	*x = PriceStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceStats) ProtoMessage() {}
+
+func (x *PriceStats) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceStats.ProtoReflect.Descriptor instead.
+func (*PriceStats) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PriceStats) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *PriceStats) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PriceStats) GetMin() string {
+	if x != nil {
+		return x.Min
+	}
+	return ""
+}
+
+func (x *PriceStats) GetMax() string {
+	if x != nil {
+		return x.Max
+	}
+	return ""
+}
+
+func (x *PriceStats) GetMean() string {
+	if x != nil {
+		return x.Mean
+	}
+	return ""
+}
+
+func (x *PriceStats) GetMedian() string {
+	if x != nil {
+		return x.Median
+	}
+	return ""
+}
+
+func (x *PriceStats) GetStdDev() float64 {
+	if x != nil {
+		return x.StdDev
+	}
+	return 0
+}
+
+func (x *PriceStats) GetPricesCount() int64 {
+	if x != nil {
+		return x.PricesCount
+	}
+	return 0
+}
+
+func (x *PriceStats) GetImportsCount() int64 {
+	if x != nil {
+		return x.ImportsCount
+	}
+	return 0
+}
+
+// PriceEntryReader.Stats response message.
+type StatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stats []*PriceStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"` // per product statistics (sorted by product name and currency)
+}
+
+func (x *StatsResponse) Reset() {
+	*x = StatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse) ProtoMessage() {}
+
+func (x *StatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse.ProtoReflect.Descriptor instead.
+func (*StatsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *StatsResponse) GetStats() []*PriceStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// PriceEntryReader.DiffImports request message.
+type DiffImportsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromTimestamp   int64 `protobuf:"varint,1,opt,name=from_timestamp,json=fromTimestamp,proto3" json:"from_timestamp,omitempty"`         // the previous import timestamp (UNIX-time) [s], matches the only import within the second
+	ToTimestamp     int64 `protobuf:"varint,2,opt,name=to_timestamp,json=toTimestamp,proto3" json:"to_timestamp,omitempty"`               // the new import timestamp (UNIX-time) [s], matches the only import within the second
+	FromTimestampMs int64 `protobuf:"varint,3,opt,name=from_timestamp_ms,json=fromTimestampMs,proto3" json:"from_timestamp_ms,omitempty"` // (optional) exact previous import timestamp (UNIX-time) [ms], takes precedence over the from_timestamp
+	ToTimestampMs   int64 `protobuf:"varint,4,opt,name=to_timestamp_ms,json=toTimestampMs,proto3" json:"to_timestamp_ms,omitempty"`       // (optional) exact new import timestamp (UNIX-time) [ms], takes precedence over the to_timestamp
+}
+
+func (x *DiffImportsRequest) Reset() {
+	*x = DiffImportsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffImportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffImportsRequest) ProtoMessage() {}
+
+func (x *DiffImportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffImportsRequest.ProtoReflect.Descriptor instead.
+func (*DiffImportsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DiffImportsRequest) GetFromTimestamp() int64 {
+	if x != nil {
+		return x.FromTimestamp
+	}
+	return 0
+}
+
+func (x *DiffImportsRequest) GetToTimestamp() int64 {
+	if x != nil {
+		return x.ToTimestamp
+	}
+	return 0
+}
+
+func (x *DiffImportsRequest) GetFromTimestampMs() int64 {
+	if x != nil {
+		return x.FromTimestampMs
+	}
+	return 0
+}
+
+func (x *DiffImportsRequest) GetToTimestampMs() int64 {
+	if x != nil {
+		return x.ToTimestampMs
+	}
+	return 0
+}
+
+// Product prices within an import (one per currency).
+type ProductPrices struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductName string        `protobuf:"bytes,1,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"` // product name
+	Prices      []*PriceValue `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices,omitempty"`                              // prices (the last one per currency)
+}
+
+func (x *ProductPrices) Reset() {
+	*x = ProductPrices{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProductPrices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductPrices) ProtoMessage() {}
+
+func (x *ProductPrices) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductPrices.ProtoReflect.Descriptor instead.
+func (*ProductPrices) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ProductPrices) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *ProductPrices) GetPrices() []*PriceValue {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+// Product price change between two imports.
+type PriceChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductName  string      `protobuf:"bytes,1,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`      // product name
+	From         *PriceValue `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`                                       // the previous import price
+	To           *PriceValue `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`                                           // the new import price
+	Delta        string      `protobuf:"bytes,4,opt,name=delta,proto3" json:"delta,omitempty"`                                     // exact decimal absolute delta (to - from)
+	DeltaPercent float64     `protobuf:"fixed64,5,opt,name=delta_percent,json=deltaPercent,proto3" json:"delta_percent,omitempty"` // delta percentage of the previous price (0 if the previous price is zero)
+}
+
+func (x *PriceChange) Reset() {
+	*x = PriceChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceChange) ProtoMessage() {}
+
+func (x *PriceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceChange.ProtoReflect.Descriptor instead.
+func (*PriceChange) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PriceChange) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *PriceChange) GetFrom() *PriceValue {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *PriceChange) GetTo() *PriceValue {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *PriceChange) GetDelta() string {
+	if x != nil {
+		return x.Delta
+	}
+	return ""
+}
+
+func (x *PriceChange) GetDeltaPercent() float64 {
+	if x != nil {
+		return x.DeltaPercent
+	}
+	return 0
+}
+
+// PriceEntryReader.DiffImports response message.
+type DiffImportsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromTimestamp   int64            `protobuf:"varint,1,opt,name=from_timestamp,json=fromTimestamp,proto3" json:"from_timestamp,omitempty"`         // the previous import timestamp (UNIX-time) [s]
+	ToTimestamp     int64            `protobuf:"varint,2,opt,name=to_timestamp,json=toTimestamp,proto3" json:"to_timestamp,omitempty"`               // the new import timestamp (UNIX-time) [s]
+	NewProducts     []*ProductPrices `protobuf:"bytes,3,rep,name=new_products,json=newProducts,proto3" json:"new_products,omitempty"`                // products of the new import only
+	RemovedProducts []*ProductPrices `protobuf:"bytes,4,rep,name=removed_products,json=removedProducts,proto3" json:"removed_products,omitempty"`    // products of the previous import only
+	Increased       []*PriceChange   `protobuf:"bytes,5,rep,name=increased,proto3" json:"increased,omitempty"`                                       // price increases
+	Decreased       []*PriceChange   `protobuf:"bytes,6,rep,name=decreased,proto3" json:"decreased,omitempty"`                                       // price decreases
+	UnchangedCount  int64            `protobuf:"varint,7,opt,name=unchanged_count,json=unchangedCount,proto3" json:"unchanged_count,omitempty"`      // number of not changed prices
+	FromTimestampMs int64            `protobuf:"varint,8,opt,name=from_timestamp_ms,json=fromTimestampMs,proto3" json:"from_timestamp_ms,omitempty"` // compared previous import timestamp (UNIX-time) [ms]
+	ToTimestampMs   int64            `protobuf:"varint,9,opt,name=to_timestamp_ms,json=toTimestampMs,proto3" json:"to_timestamp_ms,omitempty"`       // compared new import timestamp (UNIX-time) [ms]
+}
+
+func (x *DiffImportsResponse) Reset() {
+	*x = DiffImportsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffImportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffImportsResponse) ProtoMessage() {}
+
+func (x *DiffImportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffImportsResponse.ProtoReflect.Descriptor instead.
+func (*DiffImportsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DiffImportsResponse) GetFromTimestamp() int64 {
+	if x != nil {
+		return x.FromTimestamp
+	}
+	return 0
+}
+
+func (x *DiffImportsResponse) GetToTimestamp() int64 {
+	if x != nil {
+		return x.ToTimestamp
+	}
+	return 0
+}
+
+func (x *DiffImportsResponse) GetNewProducts() []*ProductPrices {
+	if x != nil {
+		return x.NewProducts
+	}
+	return nil
+}
+
+func (x *DiffImportsResponse) GetRemovedProducts() []*ProductPrices {
+	if x != nil {
+		return x.RemovedProducts
+	}
+	return nil
+}
+
+func (x *DiffImportsResponse) GetIncreased() []*PriceChange {
+	if x != nil {
+		return x.Increased
+	}
+	return nil
+}
+
+func (x *DiffImportsResponse) GetDecreased() []*PriceChange {
+	if x != nil {
+		return x.Decreased
+	}
+	return nil
+}
+
+func (x *DiffImportsResponse) GetUnchangedCount() int64 {
+	if x != nil {
+		return x.UnchangedCount
+	}
+	return 0
+}
+
+func (x *DiffImportsResponse) GetFromTimestampMs() int64 {
+	if x != nil {
+		return x.FromTimestampMs
+	}
+	return 0
+}
+
+func (x *DiffImportsResponse) GetToTimestampMs() int64 {
+	if x != nil {
+		return x.ToTimestampMs
+	}
+	return 0
+}
+
+// PriceEntryReader.GetCandles request message.
+type GetCandlesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductName   string         `protobuf:"bytes,1,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`        // exact product name
+	Interval      CandleInterval `protobuf:"varint,2,opt,name=interval,proto3,enum=v1.CandleInterval" json:"interval,omitempty"`         // candle interval
+	TimestampFrom int64          `protobuf:"varint,3,opt,name=timestamp_from,json=timestampFrom,proto3" json:"timestamp_from,omitempty"` // (optional) prices import timestamp range start (UNIX-time) [s], inclusive
+	TimestampTo   int64          `protobuf:"varint,4,opt,name=timestamp_to,json=timestampTo,proto3" json:"timestamp_to,omitempty"`       // (optional) prices import timestamp range end (UNIX-time) [s], exclusive
+}
+
+func (x *GetCandlesRequest) Reset() {
+	*x = GetCandlesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCandlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCandlesRequest) ProtoMessage() {}
+
+func (x *GetCandlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCandlesRequest.ProtoReflect.Descriptor instead.
+func (*GetCandlesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetCandlesRequest) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *GetCandlesRequest) GetInterval() CandleInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return CandleInterval_NoInterval
+}
+
+func (x *GetCandlesRequest) GetTimestampFrom() int64 {
+	if x != nil {
+		return x.TimestampFrom
+	}
+	return 0
+}
+
+func (x *GetCandlesRequest) GetTimestampTo() int64 {
+	if x != nil {
+		return x.TimestampTo
+	}
+	return 0
+}
+
+// OHLC candle.
+type Candle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start    int64  `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`      // bucket start timestamp (UNIX-time) [s]
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"` // prices ISO-4217 currency code (prices of different currencies are aggregated separately)
+	Open     string `protobuf:"bytes,3,opt,name=open,proto3" json:"open,omitempty"`         // the first bucket price decimal value
+	High     string `protobuf:"bytes,4,opt,name=high,proto3" json:"high,omitempty"`         // max bucket price decimal value
+	Low      string `protobuf:"bytes,5,opt,name=low,proto3" json:"low,omitempty"`           // min bucket price decimal value
+	Close    string `protobuf:"bytes,6,opt,name=close,proto3" json:"close,omitempty"`       // the last bucket price decimal value
+	Count    int64  `protobuf:"varint,7,opt,name=count,proto3" json:"count,omitempty"`      // number of bucket price points
+}
+
+func (x *Candle) Reset() {
+	*x = Candle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Candle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Candle) ProtoMessage() {}
+
+func (x *Candle) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Candle.ProtoReflect.Descriptor instead.
+func (*Candle) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Candle) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Candle) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Candle) GetOpen() string {
+	if x != nil {
+		return x.Open
+	}
+	return ""
+}
+
+func (x *Candle) GetHigh() string {
+	if x != nil {
+		return x.High
+	}
+	return ""
+}
+
+func (x *Candle) GetLow() string {
+	if x != nil {
+		return x.Low
+	}
+	return ""
+}
+
+func (x *Candle) GetClose() string {
+	if x != nil {
+		return x.Close
+	}
+	return ""
+}
+
+func (x *Candle) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// PriceEntryReader.GetCandles response message.
+type GetCandlesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Candles []*Candle `protobuf:"bytes,1,rep,name=candles,proto3" json:"candles,omitempty"` // candles ordered by bucket start
+}
+
+func (x *GetCandlesResponse) Reset() {
+	*x = GetCandlesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCandlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCandlesResponse) ProtoMessage() {}
+
+func (x *GetCandlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCandlesResponse.ProtoReflect.Descriptor instead.
+func (*GetCandlesResponse) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetCandlesResponse) GetCandles() []*Candle {
+	if x != nil {
+		return x.Candles
+	}
+	return nil
+}
+
+// PriceEntryReader.TopMovers request message.
+type TopMoversRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TimestampFrom int64              `protobuf:"varint,1,opt,name=timestamp_from,json=timestampFrom,proto3" json:"timestamp_from,omitempty"` // (optional) prices as of the timestamp (UNIX-time) [s], the last two imports are compared if both timestamps are not set (only products present in both)
+	TimestampTo   int64              `protobuf:"varint,2,opt,name=timestamp_to,json=timestampTo,proto3" json:"timestamp_to,omitempty"`       // (optional) prices as of the timestamp (UNIX-time) [s]
+	Direction     TopMoversDirection `protobuf:"varint,3,opt,name=direction,proto3,enum=v1.TopMoversDirection" json:"direction,omitempty"`   // price change direction
+	Relative      bool               `protobuf:"varint,4,opt,name=relative,proto3" json:"relative,omitempty"`                                // (optional) rank by the delta percentage instead of the absolute delta
+	Currency      string             `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`                                 // ISO-4217 currency code filter (required unless relative is set)
+	Limit         int64              `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`                                      // max number of products
+}
+
+func (x *TopMoversRequest) Reset() {
+	*x = TopMoversRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopMoversRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopMoversRequest) ProtoMessage() {}
+
+func (x *TopMoversRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopMoversRequest.ProtoReflect.Descriptor instead.
+func (*TopMoversRequest) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *TopMoversRequest) GetTimestampFrom() int64 {
+	if x != nil {
+		return x.TimestampFrom
+	}
+	return 0
+}
+
+func (x *TopMoversRequest) GetTimestampTo() int64 {
+	if x != nil {
+		return x.TimestampTo
+	}
+	return 0
+}
+
+func (x *TopMoversRequest) GetDirection() TopMoversDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return TopMoversDirection_NoDirection
+}
+
+func (x *TopMoversRequest) GetRelative() bool {
+	if x != nil {
+		return x.Relative
+	}
+	return false
+}
+
+func (x *TopMoversRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TopMoversRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// PriceEntryReader.TopMovers response message.
+type TopMoversResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromTimestamp   int64          `protobuf:"varint,1,opt,name=from_timestamp,json=fromTimestamp,proto3" json:"from_timestamp,omitempty"`         // compared "from" timestamp (UNIX-time) [s]
+	ToTimestamp     int64          `protobuf:"varint,2,opt,name=to_timestamp,json=toTimestamp,proto3" json:"to_timestamp,omitempty"`               // compared "to" timestamp (UNIX-time) [s]
+	Movers          []*PriceChange `protobuf:"bytes,3,rep,name=movers,proto3" json:"movers,omitempty"`                                             // price changes (the largest change first)
+	FromTimestampMs int64          `protobuf:"varint,4,opt,name=from_timestamp_ms,json=fromTimestampMs,proto3" json:"from_timestamp_ms,omitempty"` // compared "from" timestamp (UNIX-time) [ms]
+	ToTimestampMs   int64          `protobuf:"varint,5,opt,name=to_timestamp_ms,json=toTimestampMs,proto3" json:"to_timestamp_ms,omitempty"`       // compared "to" timestamp (UNIX-time) [ms]
+}
+
+func (x *TopMoversResponse) Reset() {
+	*x = TopMoversResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TopMoversResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopMoversResponse) ProtoMessage() {}
+
+func (x *TopMoversResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopMoversResponse.ProtoReflect.Descriptor instead.
+func (*TopMoversResponse) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *TopMoversResponse) GetFromTimestamp() int64 {
+	if x != nil {
+		return x.FromTimestamp
+	}
+	return 0
+}
+
+func (x *TopMoversResponse) GetToTimestamp() int64 {
+	if x != nil {
+		return x.ToTimestamp
+	}
+	return 0
+}
+
+func (x *TopMoversResponse) GetMovers() []*PriceChange {
+	if x != nil {
+		return x.Movers
+	}
+	return nil
+}
+
+func (x *TopMoversResponse) GetFromTimestampMs() int64 {
+	if x != nil {
+		return x.FromTimestampMs
+	}
+	return 0
+}
+
+func (x *TopMoversResponse) GetToTimestampMs() int64 {
+	if x != nil {
+		return x.ToTimestampMs
+	}
+	return 0
+}
+
+// Price alert rule.
+type PriceAlertRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string              `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                     // rule ID (set by server)
+	Name            string              `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                 // (optional) rule name
+	ProductName     string              `protobuf:"bytes,3,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`                // exact product name
+	Currency        string              `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`                                         // compared prices ISO-4217 currency code
+	Condition       PriceAlertCondition `protobuf:"varint,5,opt,name=condition,proto3,enum=v1.PriceAlertCondition" json:"condition,omitempty"`          // trigger condition
+	Threshold       string              `protobuf:"bytes,6,opt,name=threshold,proto3" json:"threshold,omitempty"`                                       // exact decimal price threshold (above / below conditions)
+	ChangePercent   float64             `protobuf:"fixed64,7,opt,name=change_percent,json=changePercent,proto3" json:"change_percent,omitempty"`        // price change percentage threshold (change condition)
+	Enabled         bool                `protobuf:"varint,8,opt,name=enabled,proto3" json:"enabled,omitempty"`                                          // disabled rules are not evaluated
+	LastTriggeredAt int64               `protobuf:"varint,9,opt,name=last_triggered_at,json=lastTriggeredAt,proto3" json:"last_triggered_at,omitempty"` // the last notification timestamp (UNIX-time) [s], 0 if never triggered (set by server)
+	CreatedAt       int64               `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                    // rule create timestamp (UNIX-time) [s] (set by server)
+	UpdatedAt       int64               `protobuf:"varint,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                    // rule last update timestamp (UNIX-time) [s] (set by server)
+}
+
+func (x *PriceAlertRule) Reset() {
+	*x = PriceAlertRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceAlertRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceAlertRule) ProtoMessage() {}
+
+func (x *PriceAlertRule) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceAlertRule.ProtoReflect.Descriptor instead.
+func (*PriceAlertRule) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PriceAlertRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PriceAlertRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PriceAlertRule) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *PriceAlertRule) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PriceAlertRule) GetCondition() PriceAlertCondition {
+	if x != nil {
+		return x.Condition
+	}
+	return PriceAlertCondition_NoCondition
+}
+
+func (x *PriceAlertRule) GetThreshold() string {
+	if x != nil {
+		return x.Threshold
+	}
+	return ""
+}
+
+func (x *PriceAlertRule) GetChangePercent() float64 {
+	if x != nil {
+		return x.ChangePercent
+	}
+	return 0
+}
+
+func (x *PriceAlertRule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *PriceAlertRule) GetLastTriggeredAt() int64 {
+	if x != nil {
+		return x.LastTriggeredAt
+	}
+	return 0
+}
+
+func (x *PriceAlertRule) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *PriceAlertRule) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// PriceAlerts.CreatePriceAlert request message.
+type CreatePriceAlertRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule *PriceAlertRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"` // new rule (server set fields are ignored)
+}
+
+func (x *CreatePriceAlertRequest) Reset() {
+	*x = CreatePriceAlertRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePriceAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePriceAlertRequest) ProtoMessage() {}
+
+func (x *CreatePriceAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePriceAlertRequest.ProtoReflect.Descriptor instead.
+func (*CreatePriceAlertRequest) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CreatePriceAlertRequest) GetRule() *PriceAlertRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// PriceAlerts.GetPriceAlert request message.
+type GetPriceAlertRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // rule ID
+}
+
+func (x *GetPriceAlertRequest) Reset() {
+	*x = GetPriceAlertRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPriceAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceAlertRequest) ProtoMessage() {}
+
+func (x *GetPriceAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceAlertRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceAlertRequest) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetPriceAlertRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// PriceAlerts.ListPriceAlerts request message.
+type ListPriceAlertsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *PaginationParams `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"` // pagination params
+}
+
+func (x *ListPriceAlertsRequest) Reset() {
+	*x = ListPriceAlertsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPriceAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceAlertsRequest) ProtoMessage() {}
+
+func (x *ListPriceAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceAlertsRequest.ProtoReflect.Descriptor instead.
+func (*ListPriceAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListPriceAlertsRequest) GetPagination() *PaginationParams {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// PriceAlerts.ListPriceAlerts response message.
+type ListPriceAlertsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rules []*PriceAlertRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *ListPriceAlertsResponse) Reset() {
+	*x = ListPriceAlertsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPriceAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceAlertsResponse) ProtoMessage() {}
+
+func (x *ListPriceAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceAlertsResponse.ProtoReflect.Descriptor instead.
+func (*ListPriceAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListPriceAlertsResponse) GetRules() []*PriceAlertRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// PriceAlerts.UpdatePriceAlert request message.
+type UpdatePriceAlertRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rule *PriceAlertRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"` // updated rule (replaces the existing one by ID, server set fields are ignored)
+}
+
+func (x *UpdatePriceAlertRequest) Reset() {
+	*x = UpdatePriceAlertRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePriceAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePriceAlertRequest) ProtoMessage() {}
+
+func (x *UpdatePriceAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePriceAlertRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePriceAlertRequest) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UpdatePriceAlertRequest) GetRule() *PriceAlertRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// PriceAlerts.DeletePriceAlert request message.
+type DeletePriceAlertRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // rule ID
+}
+
+func (x *DeletePriceAlertRequest) Reset() {
+	*x = DeletePriceAlertRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePriceAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePriceAlertRequest) ProtoMessage() {}
+
+func (x *DeletePriceAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePriceAlertRequest.ProtoReflect.Descriptor instead.
+func (*DeletePriceAlertRequest) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DeletePriceAlertRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// PriceAlerts.DeletePriceAlert response message.
+type DeletePriceAlertResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeletePriceAlertResponse) Reset() {
+	*x = DeletePriceAlertResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePriceAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePriceAlertResponse) ProtoMessage() {}
+
+func (x *DeletePriceAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePriceAlertResponse.ProtoReflect.Descriptor instead.
+func (*DeletePriceAlertResponse) Descriptor() ([]byte, []int) {
+	return file_v1_proto_rawDescGZIP(), []int{51}
+}
+
+var File_v1_proto protoreflect.FileDescriptor
+
+var file_v1_proto_rawDesc = []byte{
+	0x0a, 0x08, 0x76, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x76, 0x31, 0x22, 0x63,
+	0x0a, 0x0f, 0x43, 0x53, 0x56, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x64, 0x69, 0x61,
+	0x6c, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x53, 0x56, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x07, 0x64, 0x69, 0x61, 0x6c,
+	0x65, 0x63, 0x74, 0x22, 0x29, 0x0a, 0x10, 0x43, 0x53, 0x56, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x83,
+	0x01, 0x0a, 0x10, 0x43, 0x53, 0x56, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x28, 0x0a, 0x07, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x53, 0x56, 0x44, 0x69,
+	0x61, 0x6c, 0x65, 0x63, 0x74, 0x52, 0x07, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x22, 0xdc, 0x02, 0x0a, 0x0a, 0x43, 0x53, 0x56, 0x44, 0x69, 0x61, 0x6c,
+	0x65, 0x63, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x61, 0x73, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x68, 0x61, 0x73, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x67,
+	0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0d, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e,
+	0x73, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x70,
+	0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x65,
+	0x63, 0x69, 0x6d, 0x61, 0x6c, 0x53, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x22, 0x34, 0x0a, 0x11, 0x43, 0x53, 0x56, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x6a, 0x6f, 0x62, 0x22, 0xa2, 0x01, 0x0a, 0x10, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x19,
+	0x0a, 0x08, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x25,
+	0x0a, 0x0e, 0x70, 0x61, 0x72, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x73, 0x69, 0x6e, 0x67, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xc8,
+	0x05, 0x0a, 0x09, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x28,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x5f, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x73, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x46, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64,
+	0x12, 0x37, 0x0a, 0x0c, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x0b, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f,
+	0x72, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x28,
+	0x0a, 0x07, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x53, 0x56, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x52,
+	0x07, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0f, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x12, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x61, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x74, 0x6f, 0x6d, 0x69, 0x63, 0x69, 0x74, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x73,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x12, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x2e, 0x0a, 0x13, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x69, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x73, 0x22,
+	0x2c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x4d, 0x0a,
+	0x15, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3b, 0x0a, 0x16,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x4a, 0x6f, 0x62, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22, 0x7a, 0x0a, 0x14, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x34, 0x0a, 0x16, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x6f, 0x72, 0x70, 0x68, 0x61, 0x6e,
+	0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x14, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x30, 0x0a, 0x06, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x73,
+	0x22, 0x65, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x42, 0x79, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15,
+	0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52,
+	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xbe, 0x02, 0x0a, 0x10, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x29,
+	0x0a, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x42, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2a, 0x0a, 0x05, 0x61, 0x75, 0x64, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x61, 0x75, 0x64, 0x69, 0x74, 0x22, 0x4e, 0x0a, 0x16,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x49, 0x0a, 0x17,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x10, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x6b, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x0a, 0x50, 0x72, 0x69, 0x63, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0xdb, 0x02,
+	0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x0c, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x0d, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0b, 0x73, 0x6f, 0x72, 0x74,
+	0x42, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x11, 0x73, 0x6f, 0x72, 0x74, 0x5f,
+	0x62, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x0f, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x2e, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x6b, 0x69,
+	0x70, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x46, 0x0a, 0x09, 0x53,
+	0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x23,
+	0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x22, 0xb5, 0x02, 0x0a, 0x12, 0x50, 0x72, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a,
+	0x13, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x2c, 0x0a,
+	0x12, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x65,
+	0x67, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x67, 0x65, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x4d, 0x69, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x4d, 0x61, 0x78, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x21, 0x0a, 0x0c,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x74, 0x6f, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x54, 0x6f, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xef, 0x01, 0x0a, 0x0c,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x04, 0x73, 0x6f,
+	0x72, 0x74, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f,
+	0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x22, 0xf4, 0x01,
+	0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2f, 0x0a, 0x0c, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x31, 0x0a, 0x0d, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0b, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x11, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0f, 0x73,
+	0x6f, 0x72, 0x74, 0x42, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2e,
+	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x22, 0x3e, 0x0a, 0x0a, 0x50, 0x72, 0x69, 0x63, 0x65, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x22, 0x77, 0x0a, 0x0c, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x26, 0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x22, 0x73, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a,
+	0x0d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x22, 0x45, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a,
+	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73,
+	0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xde, 0x01, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x46, 0x72, 0x6f, 0x6d,
+	0x12, 0x21, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x74, 0x6f,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x54, 0x6f, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x34, 0x0a, 0x0c, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x61, 0x6d, 0x70,
+	0x6c, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x6f, 0x77, 0x6e, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x64, 0x6f,
+	0x77, 0x6e, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x22, 0x57, 0x0a, 0x11, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x24, 0x0a,
+	0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x22, 0x4a, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2d, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22,
+	0x74, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x34, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xfc, 0x01, 0x0a, 0x0a, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x74, 0x64, 0x5f, 0x64, 0x65, 0x76, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x73, 0x74, 0x64, 0x44, 0x65, 0x76, 0x12, 0x21, 0x0a, 0x0c,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x35, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0xb2, 0x01, 0x0a, 0x12,
+	0x44, 0x69, 0x66, 0x66, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2a, 0x0a, 0x11,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x6f, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x73,
+	0x22, 0x5a, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x22, 0xaf, 0x01, 0x0a,
+	0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x22, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x02, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c,
+	0x74, 0x61, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0c, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0xae,
+	0x03, 0x0a, 0x13, 0x44, 0x69, 0x66, 0x66, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x21, 0x0a,
+	0x0c, 0x74, 0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x34, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x64, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x09, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x61,
+	0x73, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x09, 0x64, 0x65, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x64, 0x65, 0x63, 0x72, 0x65, 0x61, 0x73,
+	0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x75, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x75, 0x6e, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x66,
+	0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x6f, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x73, 0x22,
+	0xb0, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x52, 0x08,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x46, 0x72, 0x6f, 0x6d, 0x12,
+	0x21, 0x0a, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x74, 0x6f, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x54, 0x6f, 0x22, 0xa0, 0x01, 0x0a, 0x06, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6f,
+	0x70, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x67, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x68, 0x69, 0x67, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x77, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x64,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x07, 0x63,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x07, 0x63, 0x61, 0x6e, 0x64, 0x6c, 0x65,
+	0x73, 0x22, 0xe0, 0x01, 0x0a, 0x10, 0x54, 0x6f, 0x70, 0x4d, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x21, 0x0a,
+	0x0c, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x54, 0x6f,
+	0x12, 0x34, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x4d, 0x6f, 0x76, 0x65,
+	0x72, 0x73, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x22, 0xda, 0x01, 0x0a, 0x11, 0x54, 0x6f, 0x70, 0x4d, 0x6f, 0x76, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x72,
+	0x6f, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x27, 0x0a, 0x06, 0x6d, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x06, 0x6d, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x0a,
+	0x11, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f,
+	0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x6f, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x4d,
+	0x73, 0x22, 0xf3, 0x02, 0x0a, 0x0e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x35, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x25, 0x0a, 0x0e,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2a, 0x0a,
+	0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x41, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x22, 0x26, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x4e, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x43, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a,
+	0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65,
+	0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x41, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x22, 0x29, 0x0a, 0x17, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2a, 0x70, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x10, 0x00, 0x12,
+	0x0f, 0x0a, 0x0b, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x01,
+	0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x10, 0x02,
+	0x12, 0x08, 0x0a, 0x04, 0x44, 0x6f, 0x6e, 0x65, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65,
+	0x64, 0x10, 0x05, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x42, 0x61, 0x63,
+	0x6b, 0x10, 0x06, 0x2a, 0x2d, 0x0a, 0x09, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x12, 0x0d, 0x0a, 0x09, 0x55, 0x6e, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x10, 0x00, 0x12,
+	0x07, 0x0a, 0x03, 0x41, 0x73, 0x63, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x65, 0x73, 0x63,
+	0x10, 0x02, 0x2a, 0x49, 0x0a, 0x0c, 0x44, 0x6f, 0x77, 0x6e, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69,
+	0x6e, 0x67, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x6f, 0x44, 0x6f, 0x77, 0x6e, 0x73, 0x61, 0x6d, 0x70,
+	0x6c, 0x69, 0x6e, 0x67, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x69, 0x72, 0x73, 0x74, 0x10,
+	0x01, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x61, 0x73, 0x74, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x4d,
+	0x69, 0x6e, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x61, 0x78, 0x10, 0x04, 0x2a, 0x3d, 0x0a,
+	0x0e, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12,
+	0x0e, 0x0a, 0x0a, 0x4e, 0x6f, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x10, 0x00, 0x12,
+	0x08, 0x0a, 0x04, 0x48, 0x6f, 0x75, 0x72, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x44, 0x61, 0x79,
+	0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x65, 0x65, 0x6b, 0x10, 0x03, 0x2a, 0x3e, 0x0a, 0x12,
+	0x54, 0x6f, 0x70, 0x4d, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x6f, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x47, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x10, 0x01,
+	0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x6f, 0x73, 0x65, 0x72, 0x73, 0x10, 0x02, 0x2a, 0x48, 0x0a, 0x13,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x6f, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x62, 0x6f, 0x76, 0x65, 0x10, 0x01, 0x12,
+	0x09, 0x0a, 0x05, 0x42, 0x65, 0x6c, 0x6f, 0x77, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x10, 0x03, 0x32, 0xe8, 0x03, 0x0a, 0x0a, 0x43, 0x53, 0x56, 0x46, 0x65,
+	0x74, 0x63, 0x68, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x05, 0x46, 0x65, 0x74, 0x63, 0x68, 0x12, 0x13,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x53, 0x56, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x53, 0x56, 0x46, 0x65, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x06, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x53, 0x56, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x53, 0x56, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x38, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62, 0x22, 0x00,
+	0x12, 0x49, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f,
+	0x62, 0x73, 0x12, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f, 0x62,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x17, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x51, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x42, 0x79, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x12, 0x1e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x4a, 0x6f, 0x62, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x12, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x32, 0xff, 0x03, 0x0a, 0x10, 0x50, 0x72, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0f,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x10, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x2f, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x11, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x4c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73,
+	0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65,
+	0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x52, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x10, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x44, 0x69, 0x66, 0x66, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x09, 0x54, 0x6f, 0x70, 0x4d, 0x6f,
+	0x76, 0x65, 0x72, 0x73, 0x12, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x4d, 0x6f, 0x76,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x6f, 0x70, 0x4d, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x32, 0xfb, 0x02, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x73, 0x12, 0x45, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x12, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x12, 0x18, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x12, 0x1a,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x10, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x12, 0x1b, 0x2e,
+	0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x22, 0x00,
+	0x12, 0x4f, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x12, 0x1b, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_v1_proto_rawDescOnce sync.Once
+	file_v1_proto_rawDescData = file_v1_proto_rawDesc
+)
+
+func file_v1_proto_rawDescGZIP() []byte {
+	file_v1_proto_rawDescOnce.Do(func() {
+		file_v1_proto_rawDescData = protoimpl.X.CompressGZIP(file_v1_proto_rawDescData)
+	})
+	return file_v1_proto_rawDescData
+}
+
+var file_v1_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_v1_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
+var file_v1_proto_goTypes = []interface{}{
+	(ImportJobState)(0),                // 0: v1.ImportJobState
+	(SortOrder)(0),                     // 1: v1.SortOrder
+	(Downsampling)(0),                  // 2: v1.Downsampling
+	(CandleInterval)(0),                // 3: v1.CandleInterval
+	(TopMoversDirection)(0),            // 4: v1.TopMoversDirection
+	(PriceAlertCondition)(0),           // 5: v1.PriceAlertCondition
+	(*CSVFetchRequest)(nil),            // 6: v1.CSVFetchRequest
+	(*CSVFetchResponse)(nil),           // 7: v1.CSVFetchResponse
+	(*CSVUploadRequest)(nil),           // 8: v1.CSVUploadRequest
+	(*CSVDialect)(nil),                 // 9: v1.CSVDialect
+	(*CSVUploadResponse)(nil),          // 10: v1.CSVUploadResponse
+	(*ImportChunkError)(nil),           // 11: v1.ImportChunkError
+	(*ImportJob)(nil),                  // 12: v1.ImportJob
+	(*ImportArchiveEntry)(nil),         // 13: v1.ImportArchiveEntry
+	(*GetImportJobRequest)(nil),        // 14: v1.GetImportJobRequest
+	(*ListImportJobsRequest)(nil),      // 15: v1.ListImportJobsRequest
+	(*ListImportJobsResponse)(nil),     // 16: v1.ListImportJobsResponse
+	(*ImportRollbackParams)(nil),       // 17: v1.ImportRollbackParams
+	(*DeleteImportRequest)(nil),        // 18: v1.DeleteImportRequest
+	(*DeleteImportByJobIDRequest)(nil), // 19: v1.DeleteImportByJobIDRequest
+	(*ImportAuditEntry)(nil),           // 20: v1.ImportAuditEntry
+	(*DeleteImportResponse)(nil),       // 21: v1.DeleteImportResponse
+	(*ListImportAuditRequest)(nil),     // 22: v1.ListImportAuditRequest
+	(*ListImportAuditResponse)(nil),    // 23: v1.ListImportAuditResponse
+	(*PaginationParams)(nil),           // 24: v1.PaginationParams
+	(*PriceEntry)(nil),                 // 25: v1.PriceEntry
+	(*ListRequest)(nil),                // 26: v1.ListRequest
+	(*SortField)(nil),                  // 27: v1.SortField
+	(*PriceEntriesFilter)(nil),         // 28: v1.PriceEntriesFilter
+	(*ListResponse)(nil),               // 29: v1.ListResponse
+	(*StreamRequest)(nil),              // 30: v1.StreamRequest
+	(*PriceValue)(nil),                 // 31: v1.PriceValue
+	(*LatestPrices)(nil),               // 32: v1.LatestPrices
+	(*GetLatestPricesRequest)(nil),     // 33: v1.GetLatestPricesRequest
+	(*GetLatestPricesResponse)(nil),    // 34: v1.GetLatestPricesResponse
+	(*GetProductHistoryRequest)(nil),   // 35: v1.GetProductHistoryRequest
+	(*PriceHistoryPoint)(nil),          // 36: v1.PriceHistoryPoint
+	(*GetProductHistoryResponse)(nil),  // 37: v1.GetProductHistoryResponse
+	(*StatsRequest)(nil),               // 38: v1.StatsRequest
+	(*PriceStats)(nil),                 // 39: v1.PriceStats
+	(*StatsResponse)(nil),              // 40: v1.StatsResponse
+	(*DiffImportsRequest)(nil),         // 41: v1.DiffImportsRequest
+	(*ProductPrices)(nil),              // 42: v1.ProductPrices
+	(*PriceChange)(nil),                // 43: v1.PriceChange
+	(*DiffImportsResponse)(nil),        // 44: v1.DiffImportsResponse
+	(*GetCandlesRequest)(nil),          // 45: v1.GetCandlesRequest
+	(*Candle)(nil),                     // 46: v1.Candle
+	(*GetCandlesResponse)(nil),         // 47: v1.GetCandlesResponse
+	(*TopMoversRequest)(nil),           // 48: v1.TopMoversRequest
+	(*TopMoversResponse)(nil),          // 49: v1.TopMoversResponse
+	(*PriceAlertRule)(nil),             // 50: v1.PriceAlertRule
+	(*CreatePriceAlertRequest)(nil),    // 51: v1.CreatePriceAlertRequest
+	(*GetPriceAlertRequest)(nil),       // 52: v1.GetPriceAlertRequest
+	(*ListPriceAlertsRequest)(nil),     // 53: v1.ListPriceAlertsRequest
+	(*ListPriceAlertsResponse)(nil),    // 54: v1.ListPriceAlertsResponse
+	(*UpdatePriceAlertRequest)(nil),    // 55: v1.UpdatePriceAlertRequest
+	(*DeletePriceAlertRequest)(nil),    // 56: v1.DeletePriceAlertRequest
+	(*DeletePriceAlertResponse)(nil),   // 57: v1.DeletePriceAlertResponse
+}
+var file_v1_proto_depIdxs = []int32{
+	9,  // 0: v1.CSVFetchRequest.dialect:type_name -> v1.CSVDialect
+	9,  // 1: v1.CSVUploadRequest.dialect:type_name -> v1.CSVDialect
+	12, // 2: v1.CSVUploadResponse.job:type_name -> v1.ImportJob
+	0,  // 3: v1.ImportJob.state:type_name -> v1.ImportJobState
+	11, // 4: v1.ImportJob.chunk_errors:type_name -> v1.ImportChunkError
+	9,  // 5: v1.ImportJob.dialect:type_name -> v1.CSVDialect
+	13, // 6: v1.ImportJob.archive_entries:type_name -> v1.ImportArchiveEntry
+	24, // 7: v1.ListImportJobsRequest.pagination:type_name -> v1.PaginationParams
+	12, // 8: v1.ListImportJobsResponse.jobs:type_name -> v1.ImportJob
+	17, // 9: v1.DeleteImportRequest.params:type_name -> v1.ImportRollbackParams
+	17, // 10: v1.DeleteImportByJobIDRequest.params:type_name -> v1.ImportRollbackParams
+	20, // 11: v1.DeleteImportResponse.audit:type_name -> v1.ImportAuditEntry
+	24, // 12: v1.ListImportAuditRequest.pagination:type_name -> v1.PaginationParams
+	20, // 13: v1.ListImportAuditResponse.entries:type_name -> v1.ImportAuditEntry
+	24, // 14: v1.ListRequest.pagination:type_name -> v1.PaginationParams
+	1,  // 15: v1.ListRequest.sort_by_name:type_name -> v1.SortOrder
+	1,  // 16: v1.ListRequest.sort_by_price:type_name -> v1.SortOrder
+	1,  // 17: v1.ListRequest.sort_by_timestamp:type_name -> v1.SortOrder
+	28, // 18: v1.ListRequest.filter:type_name -> v1.PriceEntriesFilter
+	1,  // 19: v1.SortField.order:type_name -> v1.SortOrder
+	25, // 20: v1.ListResponse.entries:type_name -> v1.PriceEntry
+	28, // 21: v1.ListResponse.filter:type_name -> v1.PriceEntriesFilter
+	27, // 22: v1.ListResponse.sort:type_name -> v1.SortField
+	1,  // 23: v1.StreamRequest.sort_by_name:type_name -> v1.SortOrder
+	1,  // 24: v1.StreamRequest.sort_by_price:type_name -> v1.SortOrder
+	1,  // 25: v1.StreamRequest.sort_by_timestamp:type_name -> v1.SortOrder
+	28, // 26: v1.StreamRequest.filter:type_name -> v1.PriceEntriesFilter
+	31, // 27: v1.LatestPrices.prices:type_name -> v1.PriceValue
+	24, // 28: v1.GetLatestPricesRequest.pagination:type_name -> v1.PaginationParams
+	32, // 29: v1.GetLatestPricesResponse.entries:type_name -> v1.LatestPrices
+	2,  // 30: v1.GetProductHistoryRequest.downsampling:type_name -> v1.Downsampling
+	31, // 31: v1.PriceHistoryPoint.price:type_name -> v1.PriceValue
+	36, // 32: v1.GetProductHistoryResponse.points:type_name -> v1.PriceHistoryPoint
+	24, // 33: v1.StatsRequest.pagination:type_name -> v1.PaginationParams
+	28, // 34: v1.StatsRequest.filter:type_name -> v1.PriceEntriesFilter
+	39, // 35: v1.StatsResponse.stats:type_name -> v1.PriceStats
+	31, // 36: v1.ProductPrices.prices:type_name -> v1.PriceValue
+	31, // 37: v1.PriceChange.from:type_name -> v1.PriceValue
+	31, // 38: v1.PriceChange.to:type_name -> v1.PriceValue
+	42, // 39: v1.DiffImportsResponse.new_products:type_name -> v1.ProductPrices
+	42, // 40: v1.DiffImportsResponse.removed_products:type_name -> v1.ProductPrices
+	43, // 41: v1.DiffImportsResponse.increased:type_name -> v1.PriceChange
+	43, // 42: v1.DiffImportsResponse.decreased:type_name -> v1.PriceChange
+	3,  // 43: v1.GetCandlesRequest.interval:type_name -> v1.CandleInterval
+	46, // 44: v1.GetCandlesResponse.candles:type_name -> v1.Candle
+	4,  // 45: v1.TopMoversRequest.direction:type_name -> v1.TopMoversDirection
+	43, // 46: v1.TopMoversResponse.movers:type_name -> v1.PriceChange
+	5,  // 47: v1.PriceAlertRule.condition:type_name -> v1.PriceAlertCondition
+	50, // 48: v1.CreatePriceAlertRequest.rule:type_name -> v1.PriceAlertRule
+	24, // 49: v1.ListPriceAlertsRequest.pagination:type_name -> v1.PaginationParams
+	50, // 50: v1.ListPriceAlertsResponse.rules:type_name -> v1.PriceAlertRule
+	50, // 51: v1.UpdatePriceAlertRequest.rule:type_name -> v1.PriceAlertRule
+	6,  // 52: v1.CSVFetcher.Fetch:input_type -> v1.CSVFetchRequest
+	8,  // 53: v1.CSVFetcher.Upload:input_type -> v1.CSVUploadRequest
+	14, // 54: v1.CSVFetcher.GetImportJob:input_type -> v1.GetImportJobRequest
+	15, // 55: v1.CSVFetcher.ListImportJobs:input_type -> v1.ListImportJobsRequest
+	18, // 56: v1.CSVFetcher.DeleteImport:input_type -> v1.DeleteImportRequest
+	19, // 57: v1.CSVFetcher.DeleteImportByJobID:input_type -> v1.DeleteImportByJobIDRequest
+	22, // 58: v1.CSVFetcher.ListImportAudit:input_type -> v1.ListImportAuditRequest
+	26, // 59: v1.PriceEntryReader.List:input_type -> v1.ListRequest
+	30, // 60: v1.PriceEntryReader.Stream:input_type -> v1.StreamRequest
+	33, // 61: v1.PriceEntryReader.GetLatestPrices:input_type -> v1.GetLatestPricesRequest
+	35, // 62: v1.PriceEntryReader.GetProductHistory:input_type -> v1.GetProductHistoryRequest
+	38, // 63: v1.PriceEntryReader.Stats:input_type -> v1.StatsRequest
+	41, // 64: v1.PriceEntryReader.DiffImports:input_type -> v1.DiffImportsRequest
+	45, // 65: v1.PriceEntryReader.GetCandles:input_type -> v1.GetCandlesRequest
+	48, // 66: v1.PriceEntryReader.TopMovers:input_type -> v1.TopMoversRequest
+	51, // 67: v1.PriceAlerts.CreatePriceAlert:input_type -> v1.CreatePriceAlertRequest
+	52, // 68: v1.PriceAlerts.GetPriceAlert:input_type -> v1.GetPriceAlertRequest
+	53, // 69: v1.PriceAlerts.ListPriceAlerts:input_type -> v1.ListPriceAlertsRequest
+	55, // 70: v1.PriceAlerts.UpdatePriceAlert:input_type -> v1.UpdatePriceAlertRequest
+	56, // 71: v1.PriceAlerts.DeletePriceAlert:input_type -> v1.DeletePriceAlertRequest
+	7,  // 72: v1.CSVFetcher.Fetch:output_type -> v1.CSVFetchResponse
+	10, // 73: v1.CSVFetcher.Upload:output_type -> v1.CSVUploadResponse
+	12, // 74: v1.CSVFetcher.GetImportJob:output_type -> v1.ImportJob
+	16, // 75: v1.CSVFetcher.ListImportJobs:output_type -> v1.ListImportJobsResponse
+	21, // 76: v1.CSVFetcher.DeleteImport:output_type -> v1.DeleteImportResponse
+	21, // 77: v1.CSVFetcher.DeleteImportByJobID:output_type -> v1.DeleteImportResponse
+	23, // 78: v1.CSVFetcher.ListImportAudit:output_type -> v1.ListImportAuditResponse
+	29, // 79: v1.PriceEntryReader.List:output_type -> v1.ListResponse
+	25, // 80: v1.PriceEntryReader.Stream:output_type -> v1.PriceEntry
+	34, // 81: v1.PriceEntryReader.GetLatestPrices:output_type -> v1.GetLatestPricesResponse
+	37, // 82: v1.PriceEntryReader.GetProductHistory:output_type -> v1.GetProductHistoryResponse
+	40, // 83: v1.PriceEntryReader.Stats:output_type -> v1.StatsResponse
+	44, // 84: v1.PriceEntryReader.DiffImports:output_type -> v1.DiffImportsResponse
+	47, // 85: v1.PriceEntryReader.GetCandles:output_type -> v1.GetCandlesResponse
+	49, // 86: v1.PriceEntryReader.TopMovers:output_type -> v1.TopMoversResponse
+	50, // 87: v1.PriceAlerts.CreatePriceAlert:output_type -> v1.PriceAlertRule
+	50, // 88: v1.PriceAlerts.GetPriceAlert:output_type -> v1.PriceAlertRule
+	54, // 89: v1.PriceAlerts.ListPriceAlerts:output_type -> v1.ListPriceAlertsResponse
+	50, // 90: v1.PriceAlerts.UpdatePriceAlert:output_type -> v1.PriceAlertRule
+	57, // 91: v1.PriceAlerts.DeletePriceAlert:output_type -> v1.DeletePriceAlertResponse
+	72, // [72:92] is the sub-list for method output_type
+	52, // [52:72] is the sub-list for method input_type
+	52, // [52:52] is the sub-list for extension type_name
+	52, // [52:52] is the sub-list for extension extendee
+	0,  // [0:52] is the sub-list for field type_name
+}
+
+func init() { file_v1_proto_init() }
+func file_v1_proto_init() {
+	if File_v1_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_v1_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CSVFetchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CSVFetchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CSVUploadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CSVDialect); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CSVUploadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportChunkError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportJob); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportArchiveEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetImportJobRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListImportJobsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListImportJobsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportRollbackParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteImportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteImportByJobIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportAuditEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteImportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListImportAuditRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListImportAuditResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaginationParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SortField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceEntriesFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
 				return nil
 			}
 		}
-		file_v1_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PriceEntry); i {
+		file_v1_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -546,8 +5060,8 @@ func file_v1_proto_init() {
 				return nil
 			}
 		}
-		file_v1_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRequest); i {
+		file_v1_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -558,8 +5072,308 @@ func file_v1_proto_init() {
 				return nil
 			}
 		}
-		file_v1_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListResponse); i {
+		file_v1_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LatestPrices); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLatestPricesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLatestPricesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProductHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceHistoryPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProductHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffImportsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProductPrices); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffImportsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCandlesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Candle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCandlesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopMoversRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TopMoversResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceAlertRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePriceAlertRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPriceAlertRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPriceAlertsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPriceAlertsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePriceAlertRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePriceAlertRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePriceAlertResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -576,10 +5390,10 @@ func file_v1_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_v1_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   6,
+			NumEnums:      6,
+			NumMessages:   52,
 			NumExtensions: 0,
-			NumServices:   2,
+			NumServices:   3,
 		},
 		GoTypes:           file_v1_proto_goTypes,
 		DependencyIndexes: file_v1_proto_depIdxs,
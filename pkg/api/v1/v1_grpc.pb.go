@@ -18,6 +18,12 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type CSVFetcherClient interface {
 	Fetch(ctx context.Context, in *CSVFetchRequest, opts ...grpc.CallOption) (*CSVFetchResponse, error)
+	Upload(ctx context.Context, opts ...grpc.CallOption) (CSVFetcher_UploadClient, error)
+	GetImportJob(ctx context.Context, in *GetImportJobRequest, opts ...grpc.CallOption) (*ImportJob, error)
+	ListImportJobs(ctx context.Context, in *ListImportJobsRequest, opts ...grpc.CallOption) (*ListImportJobsResponse, error)
+	DeleteImport(ctx context.Context, in *DeleteImportRequest, opts ...grpc.CallOption) (*DeleteImportResponse, error)
+	DeleteImportByJobID(ctx context.Context, in *DeleteImportByJobIDRequest, opts ...grpc.CallOption) (*DeleteImportResponse, error)
+	ListImportAudit(ctx context.Context, in *ListImportAuditRequest, opts ...grpc.CallOption) (*ListImportAuditResponse, error)
 }
 
 type cSVFetcherClient struct {
@@ -37,11 +43,96 @@ func (c *cSVFetcherClient) Fetch(ctx context.Context, in *CSVFetchRequest, opts
 	return out, nil
 }
 
+func (c *cSVFetcherClient) Upload(ctx context.Context, opts ...grpc.CallOption) (CSVFetcher_UploadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CSVFetcher_serviceDesc.Streams[0], "/v1.CSVFetcher/Upload", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cSVFetcherUploadClient{stream}
+	return x, nil
+}
+
+type CSVFetcher_UploadClient interface {
+	Send(*CSVUploadRequest) error
+	CloseAndRecv() (*CSVUploadResponse, error)
+	grpc.ClientStream
+}
+
+type cSVFetcherUploadClient struct {
+	grpc.ClientStream
+}
+
+func (x *cSVFetcherUploadClient) Send(m *CSVUploadRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *cSVFetcherUploadClient) CloseAndRecv() (*CSVUploadResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(CSVUploadResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cSVFetcherClient) GetImportJob(ctx context.Context, in *GetImportJobRequest, opts ...grpc.CallOption) (*ImportJob, error) {
+	out := new(ImportJob)
+	err := c.cc.Invoke(ctx, "/v1.CSVFetcher/GetImportJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cSVFetcherClient) ListImportJobs(ctx context.Context, in *ListImportJobsRequest, opts ...grpc.CallOption) (*ListImportJobsResponse, error) {
+	out := new(ListImportJobsResponse)
+	err := c.cc.Invoke(ctx, "/v1.CSVFetcher/ListImportJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cSVFetcherClient) DeleteImport(ctx context.Context, in *DeleteImportRequest, opts ...grpc.CallOption) (*DeleteImportResponse, error) {
+	out := new(DeleteImportResponse)
+	err := c.cc.Invoke(ctx, "/v1.CSVFetcher/DeleteImport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cSVFetcherClient) DeleteImportByJobID(ctx context.Context, in *DeleteImportByJobIDRequest, opts ...grpc.CallOption) (*DeleteImportResponse, error) {
+	out := new(DeleteImportResponse)
+	err := c.cc.Invoke(ctx, "/v1.CSVFetcher/DeleteImportByJobID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cSVFetcherClient) ListImportAudit(ctx context.Context, in *ListImportAuditRequest, opts ...grpc.CallOption) (*ListImportAuditResponse, error) {
+	out := new(ListImportAuditResponse)
+	err := c.cc.Invoke(ctx, "/v1.CSVFetcher/ListImportAudit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CSVFetcherServer is the server API for CSVFetcher service.
 // All implementations must embed UnimplementedCSVFetcherServer
 // for forward compatibility
 type CSVFetcherServer interface {
 	Fetch(context.Context, *CSVFetchRequest) (*CSVFetchResponse, error)
+	Upload(CSVFetcher_UploadServer) error
+	GetImportJob(context.Context, *GetImportJobRequest) (*ImportJob, error)
+	ListImportJobs(context.Context, *ListImportJobsRequest) (*ListImportJobsResponse, error)
+	DeleteImport(context.Context, *DeleteImportRequest) (*DeleteImportResponse, error)
+	DeleteImportByJobID(context.Context, *DeleteImportByJobIDRequest) (*DeleteImportResponse, error)
+	ListImportAudit(context.Context, *ListImportAuditRequest) (*ListImportAuditResponse, error)
 	mustEmbedUnimplementedCSVFetcherServer()
 }
 
@@ -52,6 +143,24 @@ type UnimplementedCSVFetcherServer struct {
 func (UnimplementedCSVFetcherServer) Fetch(context.Context, *CSVFetchRequest) (*CSVFetchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Fetch not implemented")
 }
+func (UnimplementedCSVFetcherServer) Upload(CSVFetcher_UploadServer) error {
+	return status.Errorf(codes.Unimplemented, "method Upload not implemented")
+}
+func (UnimplementedCSVFetcherServer) GetImportJob(context.Context, *GetImportJobRequest) (*ImportJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImportJob not implemented")
+}
+func (UnimplementedCSVFetcherServer) ListImportJobs(context.Context, *ListImportJobsRequest) (*ListImportJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListImportJobs not implemented")
+}
+func (UnimplementedCSVFetcherServer) DeleteImport(context.Context, *DeleteImportRequest) (*DeleteImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteImport not implemented")
+}
+func (UnimplementedCSVFetcherServer) DeleteImportByJobID(context.Context, *DeleteImportByJobIDRequest) (*DeleteImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteImportByJobID not implemented")
+}
+func (UnimplementedCSVFetcherServer) ListImportAudit(context.Context, *ListImportAuditRequest) (*ListImportAuditResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListImportAudit not implemented")
+}
 func (UnimplementedCSVFetcherServer) mustEmbedUnimplementedCSVFetcherServer() {}
 
 // UnsafeCSVFetcherServer may be embedded to opt out of forward compatibility for this service.
@@ -83,6 +192,122 @@ func _CSVFetcher_Fetch_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CSVFetcher_Upload_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CSVFetcherServer).Upload(&cSVFetcherUploadServer{stream})
+}
+
+type CSVFetcher_UploadServer interface {
+	SendAndClose(*CSVUploadResponse) error
+	Recv() (*CSVUploadRequest, error)
+	grpc.ServerStream
+}
+
+type cSVFetcherUploadServer struct {
+	grpc.ServerStream
+}
+
+func (x *cSVFetcherUploadServer) SendAndClose(m *CSVUploadResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *cSVFetcherUploadServer) Recv() (*CSVUploadRequest, error) {
+	m := new(CSVUploadRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _CSVFetcher_GetImportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CSVFetcherServer).GetImportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CSVFetcher/GetImportJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CSVFetcherServer).GetImportJob(ctx, req.(*GetImportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CSVFetcher_ListImportJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListImportJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CSVFetcherServer).ListImportJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CSVFetcher/ListImportJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CSVFetcherServer).ListImportJobs(ctx, req.(*ListImportJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CSVFetcher_DeleteImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CSVFetcherServer).DeleteImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CSVFetcher/DeleteImport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CSVFetcherServer).DeleteImport(ctx, req.(*DeleteImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CSVFetcher_DeleteImportByJobID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteImportByJobIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CSVFetcherServer).DeleteImportByJobID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CSVFetcher/DeleteImportByJobID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CSVFetcherServer).DeleteImportByJobID(ctx, req.(*DeleteImportByJobIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CSVFetcher_ListImportAudit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListImportAuditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CSVFetcherServer).ListImportAudit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CSVFetcher/ListImportAudit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CSVFetcherServer).ListImportAudit(ctx, req.(*ListImportAuditRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _CSVFetcher_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "v1.CSVFetcher",
 	HandlerType: (*CSVFetcherServer)(nil),
@@ -91,8 +316,34 @@ var _CSVFetcher_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Fetch",
 			Handler:    _CSVFetcher_Fetch_Handler,
 		},
+		{
+			MethodName: "GetImportJob",
+			Handler:    _CSVFetcher_GetImportJob_Handler,
+		},
+		{
+			MethodName: "ListImportJobs",
+			Handler:    _CSVFetcher_ListImportJobs_Handler,
+		},
+		{
+			MethodName: "DeleteImport",
+			Handler:    _CSVFetcher_DeleteImport_Handler,
+		},
+		{
+			MethodName: "DeleteImportByJobID",
+			Handler:    _CSVFetcher_DeleteImportByJobID_Handler,
+		},
+		{
+			MethodName: "ListImportAudit",
+			Handler:    _CSVFetcher_ListImportAudit_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Upload",
+			Handler:       _CSVFetcher_Upload_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1.proto",
 }
 
@@ -101,6 +352,13 @@ var _CSVFetcher_serviceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type PriceEntryReaderClient interface {
 	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	Stream(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (PriceEntryReader_StreamClient, error)
+	GetLatestPrices(ctx context.Context, in *GetLatestPricesRequest, opts ...grpc.CallOption) (*GetLatestPricesResponse, error)
+	GetProductHistory(ctx context.Context, in *GetProductHistoryRequest, opts ...grpc.CallOption) (*GetProductHistoryResponse, error)
+	Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error)
+	DiffImports(ctx context.Context, in *DiffImportsRequest, opts ...grpc.CallOption) (*DiffImportsResponse, error)
+	GetCandles(ctx context.Context, in *GetCandlesRequest, opts ...grpc.CallOption) (*GetCandlesResponse, error)
+	TopMovers(ctx context.Context, in *TopMoversRequest, opts ...grpc.CallOption) (*TopMoversResponse, error)
 }
 
 type priceEntryReaderClient struct {
@@ -120,11 +378,104 @@ func (c *priceEntryReaderClient) List(ctx context.Context, in *ListRequest, opts
 	return out, nil
 }
 
+func (c *priceEntryReaderClient) Stream(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (PriceEntryReader_StreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PriceEntryReader_serviceDesc.Streams[0], "/v1.PriceEntryReader/Stream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &priceEntryReaderStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PriceEntryReader_StreamClient interface {
+	Recv() (*PriceEntry, error)
+	grpc.ClientStream
+}
+
+type priceEntryReaderStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *priceEntryReaderStreamClient) Recv() (*PriceEntry, error) {
+	m := new(PriceEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *priceEntryReaderClient) GetLatestPrices(ctx context.Context, in *GetLatestPricesRequest, opts ...grpc.CallOption) (*GetLatestPricesResponse, error) {
+	out := new(GetLatestPricesResponse)
+	err := c.cc.Invoke(ctx, "/v1.PriceEntryReader/GetLatestPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceEntryReaderClient) GetProductHistory(ctx context.Context, in *GetProductHistoryRequest, opts ...grpc.CallOption) (*GetProductHistoryResponse, error) {
+	out := new(GetProductHistoryResponse)
+	err := c.cc.Invoke(ctx, "/v1.PriceEntryReader/GetProductHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceEntryReaderClient) Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error) {
+	out := new(StatsResponse)
+	err := c.cc.Invoke(ctx, "/v1.PriceEntryReader/Stats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceEntryReaderClient) DiffImports(ctx context.Context, in *DiffImportsRequest, opts ...grpc.CallOption) (*DiffImportsResponse, error) {
+	out := new(DiffImportsResponse)
+	err := c.cc.Invoke(ctx, "/v1.PriceEntryReader/DiffImports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceEntryReaderClient) GetCandles(ctx context.Context, in *GetCandlesRequest, opts ...grpc.CallOption) (*GetCandlesResponse, error) {
+	out := new(GetCandlesResponse)
+	err := c.cc.Invoke(ctx, "/v1.PriceEntryReader/GetCandles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceEntryReaderClient) TopMovers(ctx context.Context, in *TopMoversRequest, opts ...grpc.CallOption) (*TopMoversResponse, error) {
+	out := new(TopMoversResponse)
+	err := c.cc.Invoke(ctx, "/v1.PriceEntryReader/TopMovers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PriceEntryReaderServer is the server API for PriceEntryReader service.
 // All implementations must embed UnimplementedPriceEntryReaderServer
 // for forward compatibility
 type PriceEntryReaderServer interface {
 	List(context.Context, *ListRequest) (*ListResponse, error)
+	Stream(*StreamRequest, PriceEntryReader_StreamServer) error
+	GetLatestPrices(context.Context, *GetLatestPricesRequest) (*GetLatestPricesResponse, error)
+	GetProductHistory(context.Context, *GetProductHistoryRequest) (*GetProductHistoryResponse, error)
+	Stats(context.Context, *StatsRequest) (*StatsResponse, error)
+	DiffImports(context.Context, *DiffImportsRequest) (*DiffImportsResponse, error)
+	GetCandles(context.Context, *GetCandlesRequest) (*GetCandlesResponse, error)
+	TopMovers(context.Context, *TopMoversRequest) (*TopMoversResponse, error)
 	mustEmbedUnimplementedPriceEntryReaderServer()
 }
 
@@ -135,6 +486,27 @@ type UnimplementedPriceEntryReaderServer struct {
 func (UnimplementedPriceEntryReaderServer) List(context.Context, *ListRequest) (*ListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
+func (UnimplementedPriceEntryReaderServer) Stream(*StreamRequest, PriceEntryReader_StreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method Stream not implemented")
+}
+func (UnimplementedPriceEntryReaderServer) GetLatestPrices(context.Context, *GetLatestPricesRequest) (*GetLatestPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLatestPrices not implemented")
+}
+func (UnimplementedPriceEntryReaderServer) GetProductHistory(context.Context, *GetProductHistoryRequest) (*GetProductHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductHistory not implemented")
+}
+func (UnimplementedPriceEntryReaderServer) Stats(context.Context, *StatsRequest) (*StatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
+func (UnimplementedPriceEntryReaderServer) DiffImports(context.Context, *DiffImportsRequest) (*DiffImportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffImports not implemented")
+}
+func (UnimplementedPriceEntryReaderServer) GetCandles(context.Context, *GetCandlesRequest) (*GetCandlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCandles not implemented")
+}
+func (UnimplementedPriceEntryReaderServer) TopMovers(context.Context, *TopMoversRequest) (*TopMoversResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopMovers not implemented")
+}
 func (UnimplementedPriceEntryReaderServer) mustEmbedUnimplementedPriceEntryReaderServer() {}
 
 // UnsafePriceEntryReaderServer may be embedded to opt out of forward compatibility for this service.
@@ -166,6 +538,135 @@ func _PriceEntryReader_List_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PriceEntryReader_Stream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PriceEntryReaderServer).Stream(m, &priceEntryReaderStreamServer{stream})
+}
+
+type PriceEntryReader_StreamServer interface {
+	Send(*PriceEntry) error
+	grpc.ServerStream
+}
+
+type priceEntryReaderStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *priceEntryReaderStreamServer) Send(m *PriceEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PriceEntryReader_GetLatestPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLatestPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceEntryReaderServer).GetLatestPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.PriceEntryReader/GetLatestPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceEntryReaderServer).GetLatestPrices(ctx, req.(*GetLatestPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceEntryReader_GetProductHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceEntryReaderServer).GetProductHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.PriceEntryReader/GetProductHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceEntryReaderServer).GetProductHistory(ctx, req.(*GetProductHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceEntryReader_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceEntryReaderServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.PriceEntryReader/Stats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceEntryReaderServer).Stats(ctx, req.(*StatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceEntryReader_DiffImports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffImportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceEntryReaderServer).DiffImports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.PriceEntryReader/DiffImports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceEntryReaderServer).DiffImports(ctx, req.(*DiffImportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceEntryReader_GetCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCandlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceEntryReaderServer).GetCandles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.PriceEntryReader/GetCandles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceEntryReaderServer).GetCandles(ctx, req.(*GetCandlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceEntryReader_TopMovers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopMoversRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceEntryReaderServer).TopMovers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.PriceEntryReader/TopMovers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceEntryReaderServer).TopMovers(ctx, req.(*TopMoversRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _PriceEntryReader_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "v1.PriceEntryReader",
 	HandlerType: (*PriceEntryReaderServer)(nil),
@@ -174,6 +675,263 @@ var _PriceEntryReader_serviceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _PriceEntryReader_List_Handler,
 		},
+		{
+			MethodName: "GetLatestPrices",
+			Handler:    _PriceEntryReader_GetLatestPrices_Handler,
+		},
+		{
+			MethodName: "GetProductHistory",
+			Handler:    _PriceEntryReader_GetProductHistory_Handler,
+		},
+		{
+			MethodName: "Stats",
+			Handler:    _PriceEntryReader_Stats_Handler,
+		},
+		{
+			MethodName: "DiffImports",
+			Handler:    _PriceEntryReader_DiffImports_Handler,
+		},
+		{
+			MethodName: "GetCandles",
+			Handler:    _PriceEntryReader_GetCandles_Handler,
+		},
+		{
+			MethodName: "TopMovers",
+			Handler:    _PriceEntryReader_TopMovers_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Stream",
+			Handler:       _PriceEntryReader_Stream_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "v1.proto",
+}
+
+// PriceAlertsClient is the client API for PriceAlerts service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PriceAlertsClient interface {
+	CreatePriceAlert(ctx context.Context, in *CreatePriceAlertRequest, opts ...grpc.CallOption) (*PriceAlertRule, error)
+	GetPriceAlert(ctx context.Context, in *GetPriceAlertRequest, opts ...grpc.CallOption) (*PriceAlertRule, error)
+	ListPriceAlerts(ctx context.Context, in *ListPriceAlertsRequest, opts ...grpc.CallOption) (*ListPriceAlertsResponse, error)
+	UpdatePriceAlert(ctx context.Context, in *UpdatePriceAlertRequest, opts ...grpc.CallOption) (*PriceAlertRule, error)
+	DeletePriceAlert(ctx context.Context, in *DeletePriceAlertRequest, opts ...grpc.CallOption) (*DeletePriceAlertResponse, error)
+}
+
+type priceAlertsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPriceAlertsClient(cc grpc.ClientConnInterface) PriceAlertsClient {
+	return &priceAlertsClient{cc}
+}
+
+func (c *priceAlertsClient) CreatePriceAlert(ctx context.Context, in *CreatePriceAlertRequest, opts ...grpc.CallOption) (*PriceAlertRule, error) {
+	out := new(PriceAlertRule)
+	err := c.cc.Invoke(ctx, "/v1.PriceAlerts/CreatePriceAlert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceAlertsClient) GetPriceAlert(ctx context.Context, in *GetPriceAlertRequest, opts ...grpc.CallOption) (*PriceAlertRule, error) {
+	out := new(PriceAlertRule)
+	err := c.cc.Invoke(ctx, "/v1.PriceAlerts/GetPriceAlert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceAlertsClient) ListPriceAlerts(ctx context.Context, in *ListPriceAlertsRequest, opts ...grpc.CallOption) (*ListPriceAlertsResponse, error) {
+	out := new(ListPriceAlertsResponse)
+	err := c.cc.Invoke(ctx, "/v1.PriceAlerts/ListPriceAlerts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceAlertsClient) UpdatePriceAlert(ctx context.Context, in *UpdatePriceAlertRequest, opts ...grpc.CallOption) (*PriceAlertRule, error) {
+	out := new(PriceAlertRule)
+	err := c.cc.Invoke(ctx, "/v1.PriceAlerts/UpdatePriceAlert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceAlertsClient) DeletePriceAlert(ctx context.Context, in *DeletePriceAlertRequest, opts ...grpc.CallOption) (*DeletePriceAlertResponse, error) {
+	out := new(DeletePriceAlertResponse)
+	err := c.cc.Invoke(ctx, "/v1.PriceAlerts/DeletePriceAlert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PriceAlertsServer is the server API for PriceAlerts service.
+// All implementations must embed UnimplementedPriceAlertsServer
+// for forward compatibility
+type PriceAlertsServer interface {
+	CreatePriceAlert(context.Context, *CreatePriceAlertRequest) (*PriceAlertRule, error)
+	GetPriceAlert(context.Context, *GetPriceAlertRequest) (*PriceAlertRule, error)
+	ListPriceAlerts(context.Context, *ListPriceAlertsRequest) (*ListPriceAlertsResponse, error)
+	UpdatePriceAlert(context.Context, *UpdatePriceAlertRequest) (*PriceAlertRule, error)
+	DeletePriceAlert(context.Context, *DeletePriceAlertRequest) (*DeletePriceAlertResponse, error)
+	mustEmbedUnimplementedPriceAlertsServer()
+}
+
+// UnimplementedPriceAlertsServer must be embedded to have forward compatible implementations.
+type UnimplementedPriceAlertsServer struct {
+}
+
+func (UnimplementedPriceAlertsServer) CreatePriceAlert(context.Context, *CreatePriceAlertRequest) (*PriceAlertRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePriceAlert not implemented")
+}
+func (UnimplementedPriceAlertsServer) GetPriceAlert(context.Context, *GetPriceAlertRequest) (*PriceAlertRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceAlert not implemented")
+}
+func (UnimplementedPriceAlertsServer) ListPriceAlerts(context.Context, *ListPriceAlertsRequest) (*ListPriceAlertsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPriceAlerts not implemented")
+}
+func (UnimplementedPriceAlertsServer) UpdatePriceAlert(context.Context, *UpdatePriceAlertRequest) (*PriceAlertRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePriceAlert not implemented")
+}
+func (UnimplementedPriceAlertsServer) DeletePriceAlert(context.Context, *DeletePriceAlertRequest) (*DeletePriceAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePriceAlert not implemented")
+}
+func (UnimplementedPriceAlertsServer) mustEmbedUnimplementedPriceAlertsServer() {}
+
+// UnsafePriceAlertsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PriceAlertsServer will
+// result in compilation errors.
+type UnsafePriceAlertsServer interface {
+	mustEmbedUnimplementedPriceAlertsServer()
+}
+
+func RegisterPriceAlertsServer(s *grpc.Server, srv PriceAlertsServer) {
+	s.RegisterService(&_PriceAlerts_serviceDesc, srv)
+}
+
+func _PriceAlerts_CreatePriceAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePriceAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceAlertsServer).CreatePriceAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.PriceAlerts/CreatePriceAlert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceAlertsServer).CreatePriceAlert(ctx, req.(*CreatePriceAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceAlerts_GetPriceAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceAlertsServer).GetPriceAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.PriceAlerts/GetPriceAlert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceAlertsServer).GetPriceAlert(ctx, req.(*GetPriceAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceAlerts_ListPriceAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPriceAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceAlertsServer).ListPriceAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.PriceAlerts/ListPriceAlerts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceAlertsServer).ListPriceAlerts(ctx, req.(*ListPriceAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceAlerts_UpdatePriceAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePriceAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceAlertsServer).UpdatePriceAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.PriceAlerts/UpdatePriceAlert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceAlertsServer).UpdatePriceAlert(ctx, req.(*UpdatePriceAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceAlerts_DeletePriceAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePriceAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceAlertsServer).DeletePriceAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.PriceAlerts/DeletePriceAlert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceAlertsServer).DeletePriceAlert(ctx, req.(*DeletePriceAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _PriceAlerts_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.PriceAlerts",
+	HandlerType: (*PriceAlertsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePriceAlert",
+			Handler:    _PriceAlerts_CreatePriceAlert_Handler,
+		},
+		{
+			MethodName: "GetPriceAlert",
+			Handler:    _PriceAlerts_GetPriceAlert_Handler,
+		},
+		{
+			MethodName: "ListPriceAlerts",
+			Handler:    _PriceAlerts_ListPriceAlerts_Handler,
+		},
+		{
+			MethodName: "UpdatePriceAlert",
+			Handler:    _PriceAlerts_UpdatePriceAlert_Handler,
+		},
+		{
+			MethodName: "DeletePriceAlert",
+			Handler:    _PriceAlerts_DeletePriceAlert_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1.proto",
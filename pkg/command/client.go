@@ -4,10 +4,13 @@ import (
 	"context"
 	"crypto/x509"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"os"
 	"os/signal"
+	"os/user"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"syscall"
@@ -27,9 +30,42 @@ import (
 const (
 	flagPageSkip        = "skip"
 	flagPageLimit       = "limit"
+	flagPageToken       = "page-token"
+	flagSkipTotalCount  = "skip-total-count"
 	flagSortByName      = "sort-by-name"
 	flagSortByPrice     = "sort-by-price"
 	flagSortByTimestamp = "sort-by-timestamp"
+	flagFilterName      = "name"
+	flagFilterPrefix    = "name-prefix"
+	flagFilterRegex     = "name-regex"
+	flagFilterPriceMin  = "price-min"
+	flagFilterPriceMax  = "price-max"
+	flagFilterCurrency  = "price-currency"
+	flagFilterFrom      = "from"
+	flagFilterTo        = "to"
+	flagForce           = "force"
+	flagTimeout         = "timeout"
+	flagCSVDelimiter    = "delimiter"
+	flagCSVQuote        = "quote"
+	flagCSVComment      = "comment"
+	flagCSVHeader       = "header"
+	flagCSVProduct      = "product-column"
+	flagCSVPrice        = "price-column"
+	flagCSVIgnore       = "ignore-columns"
+	flagCSVDecimalSep   = "decimal-separator"
+	flagCSVCurrency     = "currency"
+	flagCSVCurrencyCol  = "currency-column"
+	flagActor           = "actor"
+	flagReason          = "reason"
+	flagDeleteOrphans   = "delete-orphans"
+	flagBucket          = "bucket"
+	flagDownsampling    = "downsampling"
+	flagCandleInterval  = "interval"
+	flagMoversDirection = "direction"
+	flagMoversRelative  = "relative"
+	flagTimestampMs     = "ms"
+	//
+	uploadPartSize = 64 * 1024
 )
 
 // clientCmd is a gRPC-client debug root command.
@@ -49,6 +85,664 @@ func GetClientListCmd() *cobra.Command {
 			// parse inputs
 			pageSkip, pageLimit := parseIntFlag(logger, flagPageSkip, cmd.Flags()), parseIntFlag(logger, flagPageLimit, cmd.Flags())
 			sortByName, sortByPrice, sortByTimestamp := parseSortFlag(flagSortByName, cmd.Flags()), parseSortFlag(flagSortByPrice, cmd.Flags()), parseSortFlag(flagSortByTimestamp, cmd.Flags())
+			filter := parsePriceEntriesFilterFlags(logger, cmd.Flags())
+			pageToken, err := cmd.Flags().GetString(flagPageToken)
+			if err != nil {
+				logger.Fatalf("parsing %s flag: %v", flagPageToken, err)
+			}
+
+			// create gRPC client
+			conn, err := createGRPCClientConnection(logger)
+			if err != nil {
+				logger.Fatalf(err.Error())
+			}
+			defer conn.Close()
+
+			client := v1.NewPriceEntryReaderClient(conn)
+
+			// request
+			requestCtx, requestCancel := context.WithTimeout(context.Background(), 1*time.Second)
+			defer requestCancel()
+			resp, err := client.List(requestCtx, &v1.ListRequest{
+				Pagination: &v1.PaginationParams{
+					Skip:  uint32(pageSkip),
+					Limit: uint32(pageLimit),
+				},
+				SortByName:      sortByName,
+				SortByPrice:     sortByPrice,
+				SortByTimestamp: sortByTimestamp,
+				Filter:          filter,
+				PageToken:       pageToken,
+				SkipTotalCount:  parseBoolFlag(logger, flagSkipTotalCount, cmd.Flags()),
+			})
+			if err != nil {
+				logger.Fatalf("request failed: %v", err)
+			}
+
+			// print result
+			if len(resp.Entries) == 0 {
+				logger.Infof("no entries found")
+				return
+			}
+
+			for _, entry := range resp.Entries {
+				logger.Infof("%s\t->\t%s %s\t->\t%s",
+					entry.ProductName,
+					entry.Price,
+					entry.Currency,
+					time.Unix(entry.Timestamp, 0).Format(time.RFC3339),
+				)
+			}
+			if resp.TotalCount >= 0 {
+				logger.Infof("total: %d, has more: %v", resp.TotalCount, resp.HasMore)
+			} else {
+				logger.Infof("has more: %v", resp.HasMore)
+			}
+			if resp.NextPageToken != "" {
+				logger.Infof("next page token: %s", resp.NextPageToken)
+			}
+		},
+	}
+	cmd.Flags().Int(flagPageSkip, 0, "(optional) pagination param: skip")
+	cmd.Flags().Int(flagPageLimit, 50, "(optional) pagination param: limit")
+	cmd.Flags().String(flagSortByName, "", "(optional) sort param: by product name (ASC/DESC)")
+	cmd.Flags().String(flagSortByPrice, "", "(optional) sort param: by price (ASC/DESC)")
+	cmd.Flags().String(flagSortByTimestamp, "", "(optional) sort param: by timestamp (ASC/DESC)")
+	cmd.Flags().String(flagPageToken, "", "(optional) pagination param: next page token from the previous response (used instead of skip)")
+	cmd.Flags().Bool(flagSkipTotalCount, false, "(optional) do not calculate the total number of filtered entries")
+	addPriceEntriesFilterFlags(cmd)
+
+	return cmd
+}
+
+// GetClientLatestPricesCmd returns a gRPC-client command for GetLatestPrices() request.
+func GetClientLatestPricesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "latest",
+		Short: "List the latest prices per product",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := initLogger()
+
+			// parse inputs
+			pageSkip, pageLimit := parseIntFlag(logger, flagPageSkip, cmd.Flags()), parseIntFlag(logger, flagPageLimit, cmd.Flags())
+			productNames, err := cmd.Flags().GetStringSlice(flagFilterName)
+			if err != nil {
+				logger.Fatalf("parsing %s flag: %v", flagFilterName, err)
+			}
+
+			// create gRPC client
+			conn, err := createGRPCClientConnection(logger)
+			if err != nil {
+				logger.Fatalf(err.Error())
+			}
+			defer conn.Close()
+
+			client := v1.NewPriceEntryReaderClient(conn)
+
+			// request
+			requestCtx, requestCancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer requestCancel()
+			resp, err := client.GetLatestPrices(requestCtx, &v1.GetLatestPricesRequest{
+				Pagination: &v1.PaginationParams{
+					Skip:  uint32(pageSkip),
+					Limit: uint32(pageLimit),
+				},
+				ProductNames: productNames,
+			})
+			if err != nil {
+				logger.Fatalf("request failed: %v", err)
+			}
+
+			// print result
+			if len(resp.Entries) == 0 {
+				logger.Infof("no entries found")
+				return
+			}
+
+			for _, entry := range resp.Entries {
+				prices := make([]string, 0, len(entry.Prices))
+				for _, price := range entry.Prices {
+					prices = append(prices, strings.TrimSpace(price.Price+" "+price.Currency))
+				}
+
+				logger.Infof("%s\t->\t%s\t->\t%s",
+					entry.ProductName,
+					strings.Join(prices, ", "),
+					time.Unix(entry.Timestamp, 0).Format(time.RFC3339),
+				)
+			}
+		},
+	}
+	cmd.Flags().Int(flagPageSkip, 0, "(optional) pagination param: skip")
+	cmd.Flags().Int(flagPageLimit, 50, "(optional) pagination param: limit")
+	cmd.Flags().StringSlice(flagFilterName, nil, "(optional) filter param: exact product names (comma separated or repeated)")
+
+	return cmd
+}
+
+// GetClientProductHistoryCmd returns a gRPC-client command for GetProductHistory() request.
+func GetClientProductHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "history",
+		Short:   "List product price history for specified product name arg",
+		Example: "history \"Product A\" --bucket 24h --downsampling last",
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := initLogger()
+
+			// parse inputs
+			bucketSize := parseDurationFlag(logger, flagBucket, cmd.Flags())
+			if bucketSize%time.Second != 0 {
+				logger.Fatalf("%s flag: should have seconds precision", flagBucket)
+			}
+
+			downsamplingStr, err := cmd.Flags().GetString(flagDownsampling)
+			if err != nil {
+				logger.Fatalf("parsing %s flag: %v", flagDownsampling, err)
+			}
+			downsampling := v1.Downsampling_NoDownsampling
+			if downsamplingStr != "" {
+				v, ok := map[string]v1.Downsampling{
+					"first": v1.Downsampling_First,
+					"last":  v1.Downsampling_Last,
+					"min":   v1.Downsampling_Min,
+					"max":   v1.Downsampling_Max,
+				}[strings.ToLower(downsamplingStr)]
+				if !ok {
+					logger.Fatalf("%s flag: unknown function %q (first, last, min, max)", flagDownsampling, downsamplingStr)
+				}
+				downsampling = v
+			}
+
+			// create gRPC client
+			conn, err := createGRPCClientConnection(logger)
+			if err != nil {
+				logger.Fatalf(err.Error())
+			}
+			defer conn.Close()
+
+			client := v1.NewPriceEntryReaderClient(conn)
+
+			// request
+			requestCtx, requestCancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer requestCancel()
+			resp, err := client.GetProductHistory(requestCtx, &v1.GetProductHistoryRequest{
+				ProductName:   args[0],
+				TimestampFrom: parseTimestampFlag(logger, flagFilterFrom, cmd.Flags()),
+				TimestampTo:   parseTimestampFlag(logger, flagFilterTo, cmd.Flags()),
+				BucketSize:    int64(bucketSize / time.Second),
+				Downsampling:  downsampling,
+			})
+			if err != nil {
+				logger.Fatalf("request failed: %v", err)
+			}
+
+			// print result
+			if len(resp.Points) == 0 {
+				logger.Infof("no prices found")
+				return
+			}
+
+			for _, point := range resp.Points {
+				logger.Infof("%s\t->\t%s %s",
+					time.Unix(point.Timestamp, 0).Format(time.RFC3339),
+					point.Price.Price,
+					point.Price.Currency,
+				)
+			}
+		},
+	}
+	cmd.Flags().String(flagFilterFrom, "", "(optional) import timestamp range start (RFC3339 or UNIX-time, inclusive)")
+	cmd.Flags().String(flagFilterTo, "", "(optional) import timestamp range end (RFC3339 or UNIX-time, exclusive)")
+	cmd.Flags().Duration(flagBucket, 0, "(optional) downsampling bucket size (seconds precision)")
+	cmd.Flags().String(flagDownsampling, "", "(optional) downsampling function: first, last, min, max (required if bucket is set)")
+
+	return cmd
+}
+
+// GetClientCandlesCmd returns a gRPC-client command for GetCandles() request.
+func GetClientCandlesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "candles",
+		Short:   "List product OHLC price candles for specified product name arg",
+		Example: "candles \"Product A\" --interval day --from 2020-11-01T00:00:00Z",
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := initLogger()
+
+			// parse inputs
+			intervalStr, err := cmd.Flags().GetString(flagCandleInterval)
+			if err != nil {
+				logger.Fatalf("parsing %s flag: %v", flagCandleInterval, err)
+			}
+			interval, ok := map[string]v1.CandleInterval{
+				"hour": v1.CandleInterval_Hour,
+				"day":  v1.CandleInterval_Day,
+				"week": v1.CandleInterval_Week,
+			}[strings.ToLower(intervalStr)]
+			if !ok {
+				logger.Fatalf("%s flag: unknown interval %q (hour, day, week)", flagCandleInterval, intervalStr)
+			}
+
+			// create gRPC client
+			conn, err := createGRPCClientConnection(logger)
+			if err != nil {
+				logger.Fatalf(err.Error())
+			}
+			defer conn.Close()
+
+			client := v1.NewPriceEntryReaderClient(conn)
+
+			// request
+			requestCtx, requestCancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer requestCancel()
+			resp, err := client.GetCandles(requestCtx, &v1.GetCandlesRequest{
+				ProductName:   args[0],
+				Interval:      interval,
+				TimestampFrom: parseTimestampFlag(logger, flagFilterFrom, cmd.Flags()),
+				TimestampTo:   parseTimestampFlag(logger, flagFilterTo, cmd.Flags()),
+			})
+			if err != nil {
+				logger.Fatalf("request failed: %v", err)
+			}
+
+			// print result
+			if len(resp.Candles) == 0 {
+				logger.Infof("no prices found")
+				return
+			}
+
+			for _, candle := range resp.Candles {
+				logger.Infof("%s\t%s\tO: %s\tH: %s\tL: %s\tC: %s\t(%d points)",
+					time.Unix(candle.Start, 0).UTC().Format(time.RFC3339),
+					candle.Currency,
+					candle.Open,
+					candle.High,
+					candle.Low,
+					candle.Close,
+					candle.Count,
+				)
+			}
+		},
+	}
+	cmd.Flags().String(flagCandleInterval, "day", "(optional) candle interval: hour, day, week")
+	cmd.Flags().String(flagFilterFrom, "", "(optional) import timestamp range start (RFC3339 or UNIX-time, inclusive)")
+	cmd.Flags().String(flagFilterTo, "", "(optional) import timestamp range end (RFC3339 or UNIX-time, exclusive)")
+
+	return cmd
+}
+
+// GetClientTopMoversCmd returns a gRPC-client command for TopMovers() request.
+func GetClientTopMoversCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "movers",
+		Short:   "List products with the largest price changes between two points in time (or the last two imports)",
+		Example: "movers --direction losers --relative --limit 20",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := initLogger()
+
+			// parse inputs
+			directionStr, err := cmd.Flags().GetString(flagMoversDirection)
+			if err != nil {
+				logger.Fatalf("parsing %s flag: %v", flagMoversDirection, err)
+			}
+			direction, ok := map[string]v1.TopMoversDirection{
+				"gainers": v1.TopMoversDirection_Gainers,
+				"losers":  v1.TopMoversDirection_Losers,
+			}[strings.ToLower(directionStr)]
+			if !ok {
+				logger.Fatalf("%s flag: unknown direction %q (gainers, losers)", flagMoversDirection, directionStr)
+			}
+
+			currency, err := cmd.Flags().GetString(flagCSVCurrency)
+			if err != nil {
+				logger.Fatalf("parsing %s flag: %v", flagCSVCurrency, err)
+			}
+
+			// create gRPC client
+			conn, err := createGRPCClientConnection(logger)
+			if err != nil {
+				logger.Fatalf(err.Error())
+			}
+			defer conn.Close()
+
+			client := v1.NewPriceEntryReaderClient(conn)
+
+			// request
+			requestCtx, requestCancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer requestCancel()
+			resp, err := client.TopMovers(requestCtx, &v1.TopMoversRequest{
+				TimestampFrom: parseTimestampFlag(logger, flagFilterFrom, cmd.Flags()),
+				TimestampTo:   parseTimestampFlag(logger, flagFilterTo, cmd.Flags()),
+				Direction:     direction,
+				Relative:      parseBoolFlag(logger, flagMoversRelative, cmd.Flags()),
+				Currency:      currency,
+				Limit:         int64(parseIntFlag(logger, flagPageLimit, cmd.Flags())),
+			})
+			if err != nil {
+				logger.Fatalf("request failed: %v", err)
+			}
+
+			// print result
+			logger.Infof("%s (%d) -> %s (%d): %d %s",
+				time.Unix(resp.FromTimestamp, 0).Format(time.RFC3339), resp.FromTimestampMs,
+				time.Unix(resp.ToTimestamp, 0).Format(time.RFC3339), resp.ToTimestampMs,
+				len(resp.Movers),
+				strings.ToLower(directionStr),
+			)
+			for i, change := range resp.Movers {
+				logger.Infof("[%d]\t%s\t->\t%s -> %s %s (%s, %+.2f%%)",
+					i+1,
+					change.ProductName,
+					change.From.Price,
+					change.To.Price,
+					change.To.Currency,
+					change.Delta,
+					change.DeltaPercent,
+				)
+			}
+		},
+	}
+	cmd.Flags().String(flagMoversDirection, "gainers", "(optional) price change direction: gainers, losers")
+	cmd.Flags().Bool(flagMoversRelative, false, "(optional) rank by the delta percentage instead of the absolute delta")
+	cmd.Flags().String(flagCSVCurrency, "", "ISO-4217 currency code filter (required unless relative is set)")
+	cmd.Flags().Int(flagPageLimit, 10, "(optional) max number of products")
+	cmd.Flags().String(flagFilterFrom, "", "(optional) compared prices as of timestamp (RFC3339 or UNIX-time), the last two imports are compared if from / to are not set")
+	cmd.Flags().String(flagFilterTo, "", "(optional) compared prices as of timestamp (RFC3339 or UNIX-time)")
+
+	return cmd
+}
+
+// GetClientStatsCmd returns a gRPC-client command for Stats() request.
+func GetClientStatsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "List per product prices statistics",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := initLogger()
+
+			// parse inputs
+			pageSkip, pageLimit := parseIntFlag(logger, flagPageSkip, cmd.Flags()), parseIntFlag(logger, flagPageLimit, cmd.Flags())
+			filter := parsePriceEntriesFilterFlags(logger, cmd.Flags())
+
+			// create gRPC client
+			conn, err := createGRPCClientConnection(logger)
+			if err != nil {
+				logger.Fatalf(err.Error())
+			}
+			defer conn.Close()
+
+			client := v1.NewPriceEntryReaderClient(conn)
+
+			// request
+			requestCtx, requestCancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer requestCancel()
+			resp, err := client.Stats(requestCtx, &v1.StatsRequest{
+				Pagination: &v1.PaginationParams{
+					Skip:  uint32(pageSkip),
+					Limit: uint32(pageLimit),
+				},
+				Filter: filter,
+			})
+			if err != nil {
+				logger.Fatalf("request failed: %v", err)
+			}
+
+			// print result
+			if len(resp.Stats) == 0 {
+				logger.Infof("no entries found")
+				return
+			}
+
+			for _, stats := range resp.Stats {
+				logger.Infof("%s (%s):", stats.ProductName, stats.Currency)
+				logger.Infof("\tmin / max: %s / %s", stats.Min, stats.Max)
+				logger.Infof("\tmean / median: %s / %s", stats.Mean, stats.Median)
+				logger.Infof("\tstd dev: %f", stats.StdDev)
+				logger.Infof("\tprices / imports: %d / %d", stats.PricesCount, stats.ImportsCount)
+			}
+		},
+	}
+	cmd.Flags().Int(flagPageSkip, 0, "(optional) pagination param: skip")
+	cmd.Flags().Int(flagPageLimit, 50, "(optional) pagination param: limit")
+	addPriceEntriesFilterFlags(cmd)
+
+	return cmd
+}
+
+// GetClientDiffImportsCmd returns a gRPC-client command for DiffImports() request.
+func GetClientDiffImportsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "diff",
+		Short:   "Compare two imports for specified previous and new import timestamp args (RFC3339 or UNIX-time)",
+		Example: "diff 2020-10-01T00:00:00Z 2020-10-02T00:00:00Z",
+		Args:    cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := initLogger()
+
+			// parse inputs
+			exactTimestamps := parseBoolFlag(logger, flagTimestampMs, cmd.Flags())
+			timestamps := make([]int64, 0, len(args))
+			for i, arg := range args {
+				timestamp, err := parseTimestamp(arg)
+				if exactTimestamps {
+					timestamp, err = strconv.ParseInt(arg, 10, 64)
+				}
+				if err != nil {
+					logger.Fatalf("parsing timestamp arg [%d]: %v", i, err)
+				}
+				timestamps = append(timestamps, timestamp)
+			}
+			req := &v1.DiffImportsRequest{
+				FromTimestamp: timestamps[0],
+				ToTimestamp:   timestamps[1],
+			}
+			if exactTimestamps {
+				req.FromTimestamp, req.FromTimestampMs = 0, timestamps[0]
+				req.ToTimestamp, req.ToTimestampMs = 0, timestamps[1]
+			}
+
+			// create gRPC client
+			conn, err := createGRPCClientConnection(logger)
+			if err != nil {
+				logger.Fatalf(err.Error())
+			}
+			defer conn.Close()
+
+			client := v1.NewPriceEntryReaderClient(conn)
+
+			// request
+			requestCtx, requestCancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer requestCancel()
+			resp, err := client.DiffImports(requestCtx, req)
+			if err != nil {
+				logger.Fatalf("request failed: %v", err)
+			}
+
+			// print result
+			logger.Infof("%s (%d) -> %s (%d):",
+				time.Unix(resp.FromTimestamp, 0).Format(time.RFC3339), resp.FromTimestampMs,
+				time.Unix(resp.ToTimestamp, 0).Format(time.RFC3339), resp.ToTimestampMs,
+			)
+			for _, products := range []struct {
+				title    string
+				products []*v1.ProductPrices
+			}{
+				{title: "new products", products: resp.NewProducts},
+				{title: "removed products", products: resp.RemovedProducts},
+			} {
+				logger.Infof("%s: %d", products.title, len(products.products))
+				for _, product := range products.products {
+					prices := make([]string, 0, len(product.Prices))
+					for _, price := range product.Prices {
+						prices = append(prices, strings.TrimSpace(price.Price+" "+price.Currency))
+					}
+					logger.Infof("\t%s\t->\t%s", product.ProductName, strings.Join(prices, ", "))
+				}
+			}
+			for _, changes := range []struct {
+				title   string
+				changes []*v1.PriceChange
+			}{
+				{title: "price increases", changes: resp.Increased},
+				{title: "price decreases", changes: resp.Decreased},
+			} {
+				logger.Infof("%s: %d", changes.title, len(changes.changes))
+				for _, change := range changes.changes {
+					logger.Infof("\t%s\t->\t%s -> %s %s (%s, %+.2f%%)",
+						change.ProductName,
+						change.From.Price,
+						change.To.Price,
+						change.To.Currency,
+						change.Delta,
+						change.DeltaPercent,
+					)
+				}
+			}
+			logger.Infof("unchanged prices: %d", resp.UnchangedCount)
+		},
+	}
+	cmd.Flags().Bool(flagTimestampMs, false, "(optional) timestamp args are exact import timestamps (UNIX-time) [ms]")
+
+	return cmd
+}
+
+// GetClientFetchCmd returns a gRPC-client command for Fetch() request.
+func GetClientFetchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fetch",
+		Short:   "Fetch price entries CSV-file for specified URL arg (http, https, file, s3, sftp)",
+		Example: "fetch {url_to_file}",
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := initLogger()
+
+			// parse inputs
+			force := parseBoolFlag(logger, flagForce, cmd.Flags())
+			dialect := parseCSVDialectFlags(logger, cmd.Flags())
+
+			// create gRPC client
+			conn, err := createGRPCClientConnection(logger)
+			if err != nil {
+				logger.Fatalf(err.Error())
+			}
+			defer conn.Close()
+
+			client := v1.NewCSVFetcherClient(conn)
+
+			// request
+			requestCtx, requestCancel := context.WithTimeout(context.Background(), 1*time.Second)
+			defer requestCancel()
+			resp, err := client.Fetch(requestCtx, &v1.CSVFetchRequest{
+				Url:     args[0],
+				Force:   force,
+				Dialect: dialect,
+			})
+			if err != nil {
+				logger.Fatalf("request failed: %v", err)
+			}
+
+			logger.Infof("request: ok: import job enqueued: %s", resp.JobId)
+		},
+	}
+	cmd.Flags().Bool(flagForce, false, "(optional) import the file even if the same content has already been imported")
+	addCSVDialectFlags(cmd)
+
+	return cmd
+}
+
+// GetClientUploadCmd returns a gRPC-client command for Upload() request.
+func GetClientUploadCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "upload",
+		Short:   "Upload price entries local CSV-file for specified path arg",
+		Example: "upload {path_to_file}",
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := initLogger()
+
+			// parse inputs
+			timeout := parseDurationFlag(logger, flagTimeout, cmd.Flags())
+			dialect := parseCSVDialectFlags(logger, cmd.Flags())
+			force := parseBoolFlag(logger, flagForce, cmd.Flags())
+
+			file, err := os.Open(args[0])
+			if err != nil {
+				logger.Fatalf("opening file: %v", err)
+			}
+			defer file.Close()
+
+			// create gRPC client
+			conn, err := createGRPCClientConnection(logger)
+			if err != nil {
+				logger.Fatalf(err.Error())
+			}
+			defer conn.Close()
+
+			client := v1.NewCSVFetcherClient(conn)
+
+			// request
+			requestCtx, requestCancel := context.WithTimeout(context.Background(), timeout)
+			defer requestCancel()
+			stream, err := client.Upload(requestCtx)
+			if err != nil {
+				logger.Fatalf("request failed: %v", err)
+			}
+
+			// stream file by parts
+			fileName, buf := filepath.Base(args[0]), make([]byte, uploadPartSize)
+			for partIdx := 0; ; partIdx++ {
+				n, err := file.Read(buf)
+				if err != nil && err != io.EOF {
+					logger.Fatalf("reading file: %v", err)
+				}
+				if n == 0 && partIdx > 0 {
+					break
+				}
+
+				req := &v1.CSVUploadRequest{
+					Data: buf[:n],
+				}
+				if partIdx == 0 {
+					req.FileName = fileName
+					req.Dialect = dialect
+					req.Force = force
+				}
+				if err := stream.Send(req); err != nil {
+					if err == io.EOF {
+						// server has closed the stream, the actual error is received below
+						break
+					}
+					logger.Fatalf("sending file part [%d]: %v", partIdx, err)
+				}
+			}
+
+			resp, err := stream.CloseAndRecv()
+			if err != nil {
+				logger.Fatalf("request failed: %v", err)
+			}
+
+			// print result
+			printImportJob(logger, resp.Job)
+			printImportChunkErrors(logger, resp.Job)
+		},
+	}
+	cmd.Flags().Duration(flagTimeout, 10*time.Minute, "(optional) upload and processing timeout")
+	cmd.Flags().Bool(flagForce, false, "(optional) import the file even if the same content has already been imported")
+	addCSVDialectFlags(cmd)
+
+	return cmd
+}
+
+// GetClientImportJobCmd returns a gRPC-client command for GetImportJob() request.
+func GetClientImportJobCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "job",
+		Short:   "Get CSV-file import job state for specified job ID arg",
+		Example: "job {job_id}",
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := initLogger()
 
 			// create gRPC client
 			conn, err := createGRPCClientConnection(logger)
@@ -57,58 +751,181 @@ func GetClientListCmd() *cobra.Command {
 			}
 			defer conn.Close()
 
-			client := v1.NewPriceEntryReaderClient(conn)
+			client := v1.NewCSVFetcherClient(conn)
 
 			// request
 			requestCtx, requestCancel := context.WithTimeout(context.Background(), 1*time.Second)
 			defer requestCancel()
-			resp, err := client.List(requestCtx, &v1.ListRequest{
+			resp, err := client.GetImportJob(requestCtx, &v1.GetImportJobRequest{
+				JobId: args[0],
+			})
+			if err != nil {
+				logger.Fatalf("request failed: %v", err)
+			}
+
+			// print result
+			printImportJob(logger, resp)
+			printImportChunkErrors(logger, resp)
+		},
+	}
+
+	return cmd
+}
+
+// GetClientListImportJobsCmd returns a gRPC-client command for ListImportJobs() request.
+func GetClientListImportJobsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "jobs",
+		Short: "List CSV-file import jobs",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := initLogger()
+
+			// parse inputs
+			pageSkip, pageLimit := parseIntFlag(logger, flagPageSkip, cmd.Flags()), parseIntFlag(logger, flagPageLimit, cmd.Flags())
+
+			// create gRPC client
+			conn, err := createGRPCClientConnection(logger)
+			if err != nil {
+				logger.Fatalf(err.Error())
+			}
+			defer conn.Close()
+
+			client := v1.NewCSVFetcherClient(conn)
+
+			// request
+			requestCtx, requestCancel := context.WithTimeout(context.Background(), 1*time.Second)
+			defer requestCancel()
+			resp, err := client.ListImportJobs(requestCtx, &v1.ListImportJobsRequest{
 				Pagination: &v1.PaginationParams{
 					Skip:  uint32(pageSkip),
 					Limit: uint32(pageLimit),
 				},
-				SortByName:      sortByName,
-				SortByPrice:     sortByPrice,
-				SortByTimestamp: sortByTimestamp,
 			})
 			if err != nil {
 				logger.Fatalf("request failed: %v", err)
 			}
 
 			// print result
-			if len(resp.Entries) == 0 {
-				logger.Infof("no entries found")
+			if len(resp.Jobs) == 0 {
+				logger.Infof("no jobs found")
 				return
 			}
 
-			for _, entry := range resp.Entries {
-				logger.Infof("%s\t->\t%s\t->\t%s",
-					entry.ProductName,
-					strconv.FormatInt(int64(entry.Price), 10),
-					time.Unix(entry.Timestamp, 0).Format(time.RFC3339),
-				)
+			for _, job := range resp.Jobs {
+				printImportJob(logger, job)
 			}
 		},
 	}
 	cmd.Flags().Int(flagPageSkip, 0, "(optional) pagination param: skip")
 	cmd.Flags().Int(flagPageLimit, 50, "(optional) pagination param: limit")
-	cmd.Flags().String(flagSortByName, "", "(optional) sort param: by product name (ASC/DESC)")
-	cmd.Flags().String(flagSortByPrice, "", "(optional) sort param: by price (ASC/DESC)")
-	cmd.Flags().String(flagSortByTimestamp, "", "(optional) sort param: by timestamp (ASC/DESC)")
 
 	return cmd
 }
 
-// GetClientFetchCmd returns a gRPC-client command for Fetch() request.
-func GetClientFetchCmd() *cobra.Command {
+// GetClientDeleteImportCmd returns a gRPC-client command for DeleteImport() request.
+func GetClientDeleteImportCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "fetch",
-		Short:   "Fetch price entries CSV-file for specified URL arg",
-		Example: "fetch {url_to_file}",
+		Use:     "delete-import",
+		Short:   "Delete imported prices for specified import timestamp arg (UNIX-time) [s] or [ms]",
+		Example: "delete-import {timestamp} --reason {reason}",
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := initLogger()
+
+			// parse inputs
+			timestamp, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				logger.Fatalf("parsing timestamp: %v", err)
+			}
+			params := parseImportRollbackFlags(logger, cmd.Flags())
+			req := &v1.DeleteImportRequest{
+				Timestamp: timestamp,
+				Params:    params,
+			}
+			if parseBoolFlag(logger, flagTimestampMs, cmd.Flags()) {
+				req.Timestamp, req.TimestampMs = 0, timestamp
+			}
+
+			// create gRPC client
+			conn, err := createGRPCClientConnection(logger)
+			if err != nil {
+				logger.Fatalf(err.Error())
+			}
+			defer conn.Close()
+
+			client := v1.NewCSVFetcherClient(conn)
+
+			// request
+			requestCtx, requestCancel := context.WithTimeout(context.Background(), parseDurationFlag(logger, flagTimeout, cmd.Flags()))
+			defer requestCancel()
+			resp, err := client.DeleteImport(requestCtx, req)
+			if err != nil {
+				logger.Fatalf("request failed: %v", err)
+			}
+
+			// print result
+			printImportAuditEntry(logger, resp.Audit)
+		},
+	}
+	addImportRollbackFlags(cmd)
+	cmd.Flags().Bool(flagTimestampMs, false, "(optional) timestamp arg is an exact import timestamp (UNIX-time) [ms]")
+
+	return cmd
+}
+
+// GetClientDeleteJobImportCmd returns a gRPC-client command for DeleteImportByJobID() request.
+func GetClientDeleteJobImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete-job-import",
+		Short:   "Delete imported prices of the finished import job for specified job ID arg",
+		Example: "delete-job-import {job_id} --reason {reason}",
 		Args:    cobra.ExactArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
 			logger := initLogger()
 
+			// parse inputs
+			params := parseImportRollbackFlags(logger, cmd.Flags())
+
+			// create gRPC client
+			conn, err := createGRPCClientConnection(logger)
+			if err != nil {
+				logger.Fatalf(err.Error())
+			}
+			defer conn.Close()
+
+			client := v1.NewCSVFetcherClient(conn)
+
+			// request
+			requestCtx, requestCancel := context.WithTimeout(context.Background(), parseDurationFlag(logger, flagTimeout, cmd.Flags()))
+			defer requestCancel()
+			resp, err := client.DeleteImportByJobID(requestCtx, &v1.DeleteImportByJobIDRequest{
+				JobId:  args[0],
+				Params: params,
+			})
+			if err != nil {
+				logger.Fatalf("request failed: %v", err)
+			}
+
+			// print result
+			printImportAuditEntry(logger, resp.Audit)
+		},
+	}
+	addImportRollbackFlags(cmd)
+
+	return cmd
+}
+
+// GetClientListImportAuditCmd returns a gRPC-client command for ListImportAudit() request.
+func GetClientListImportAuditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "List imported prices deletion audit trail",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := initLogger()
+
+			// parse inputs
+			pageSkip, pageLimit := parseIntFlag(logger, flagPageSkip, cmd.Flags()), parseIntFlag(logger, flagPageLimit, cmd.Flags())
+
 			// create gRPC client
 			conn, err := createGRPCClientConnection(logger)
 			if err != nil {
@@ -121,16 +938,29 @@ func GetClientFetchCmd() *cobra.Command {
 			// request
 			requestCtx, requestCancel := context.WithTimeout(context.Background(), 1*time.Second)
 			defer requestCancel()
-			_, err = client.Fetch(requestCtx, &v1.CSVFetchRequest{
-				Url: args[0],
+			resp, err := client.ListImportAudit(requestCtx, &v1.ListImportAuditRequest{
+				Pagination: &v1.PaginationParams{
+					Skip:  uint32(pageSkip),
+					Limit: uint32(pageLimit),
+				},
 			})
 			if err != nil {
 				logger.Fatalf("request failed: %v", err)
 			}
 
-			logger.Infof("request: ok")
+			// print result
+			if len(resp.Entries) == 0 {
+				logger.Infof("no entries found")
+				return
+			}
+
+			for _, entry := range resp.Entries {
+				printImportAuditEntry(logger, entry)
+			}
 		},
 	}
+	cmd.Flags().Int(flagPageSkip, 0, "(optional) pagination param: skip")
+	cmd.Flags().Int(flagPageLimit, 50, "(optional) pagination param: limit")
 
 	return cmd
 }
@@ -175,6 +1005,241 @@ func GetClientFileServerCmd() *cobra.Command {
 	return cmd
 }
 
+// printImportJob prints import job state and progress.
+func printImportJob(logger *logrus.Logger, job *v1.ImportJob) {
+	logger.Infof("%s\t->\t%s\t->\t%s\t->\tchunks: %d (failed: %d), entries: %d\t->\t%s",
+		job.Id,
+		job.Url,
+		job.State.String(),
+		job.ChunksProcessed,
+		job.ChunksFailed,
+		job.EntriesProcessed,
+		time.Unix(job.UpdatedAt, 0).Format(time.RFC3339),
+	)
+	if job.Atomicity != "" {
+		logger.Infof("\tatomicity: %s", job.Atomicity)
+	}
+	if job.ImportTimestampMs != 0 {
+		logger.Infof("\timport timestamp: %d [ms]", job.ImportTimestampMs)
+	}
+	if job.ContentHash != "" {
+		logger.Infof("\tsource: %d bytes, sha256: %s, etag: %s, compression: %s", job.Size, job.ContentHash, job.Etag, job.Compression)
+	}
+	for _, entry := range job.ArchiveEntries {
+		logger.Infof("\tarchive entry: %s\t->\t%s (%d [ms])", entry.Name, time.Unix(entry.ImportTimestamp, 0).Format(time.RFC3339), entry.ImportTimestampMs)
+	}
+	if job.Error != "" {
+		logger.Infof("\terror: %s", job.Error)
+	}
+}
+
+// printImportChunkErrors prints import job failed chunks errors.
+func printImportChunkErrors(logger *logrus.Logger, job *v1.ImportJob) {
+	for _, chunkErr := range job.ChunkErrors {
+		chunkName := fmt.Sprintf("chunk %d", chunkErr.ChunkId)
+		if chunkErr.ArchiveEntry != "" {
+			chunkName = fmt.Sprintf("%s: %s", chunkErr.ArchiveEntry, chunkName)
+		}
+		logger.Infof("\t%s:\tparsing: [%s]\texecution: %s",
+			chunkName,
+			strings.Join(chunkErr.ParsingErrors, ", "),
+			chunkErr.ExecutionError,
+		)
+	}
+}
+
+// printImportAuditEntry prints import audit entry.
+func printImportAuditEntry(logger *logrus.Logger, entry *v1.ImportAuditEntry) {
+	timestamps := make([]string, 0, len(entry.Timestamps))
+	for _, timestamp := range entry.Timestamps {
+		timestamps = append(timestamps, time.Unix(timestamp, 0).Format(time.RFC3339))
+	}
+
+	logger.Infof("%s\t->\t%s by %s (%s)\t->\tprice imports: %d, orphan products: %d\t->\t%s",
+		entry.Id,
+		entry.Action,
+		entry.Actor,
+		entry.ClientAddr,
+		entry.DeletedPriceImports,
+		entry.DeletedProducts,
+		time.Unix(entry.CreatedAt, 0).Format(time.RFC3339),
+	)
+	logger.Infof("\timports: [%s]", strings.Join(timestamps, ", "))
+	if entry.JobId != "" {
+		logger.Infof("\tjob: %s", entry.JobId)
+	}
+	if entry.Reason != "" {
+		logger.Infof("\treason: %s", entry.Reason)
+	}
+}
+
+// addPriceEntriesFilterFlags adds price entries filter cmd flags.
+func addPriceEntriesFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagFilterName, "", "(optional) filter param: exact product name")
+	cmd.Flags().String(flagFilterPrefix, "", "(optional) filter param: product name prefix")
+	cmd.Flags().String(flagFilterRegex, "", "(optional) filter param: product name regular expression")
+	cmd.Flags().String(flagFilterPriceMin, "", "(optional) filter param: min price (inclusive)")
+	cmd.Flags().String(flagFilterPriceMax, "", "(optional) filter param: max price (inclusive)")
+	cmd.Flags().String(flagFilterCurrency, "", "(optional) filter param: ISO-4217 price currency code (required for the price range)")
+	cmd.Flags().String(flagFilterFrom, "", "(optional) filter param: import timestamp range start (RFC3339 or UNIX-time, inclusive)")
+	cmd.Flags().String(flagFilterTo, "", "(optional) filter param: import timestamp range end (RFC3339 or UNIX-time, exclusive)")
+}
+
+// parsePriceEntriesFilterFlags converts price entries filter cmd flags to gRPC PriceEntriesFilter (crashes on failure).
+func parsePriceEntriesFilterFlags(logger *logrus.Logger, flags *pflag.FlagSet) *v1.PriceEntriesFilter {
+	filter := &v1.PriceEntriesFilter{}
+	for flagName, target := range map[string]*string{
+		flagFilterName:     &filter.ProductName,
+		flagFilterPrefix:   &filter.ProductNamePrefix,
+		flagFilterRegex:    &filter.ProductNameRegex,
+		flagFilterPriceMin: &filter.PriceMin,
+		flagFilterPriceMax: &filter.PriceMax,
+		flagFilterCurrency: &filter.Currency,
+	} {
+		v, err := flags.GetString(flagName)
+		if err != nil {
+			logger.Fatalf("parsing %s flag: %v", flagName, err)
+		}
+		*target = v
+	}
+
+	for flagName, target := range map[string]*int64{
+		flagFilterFrom: &filter.TimestampFrom,
+		flagFilterTo:   &filter.TimestampTo,
+	} {
+		*target = parseTimestampFlag(logger, flagName, flags)
+	}
+
+	return filter
+}
+
+// parseTimestampFlag parses RFC3339 or UNIX-time cmd flag to UNIX-time (0 if not set, crashes on failure).
+func parseTimestampFlag(logger *logrus.Logger, flagName string, flags *pflag.FlagSet) int64 {
+	v, err := flags.GetString(flagName)
+	if err != nil {
+		logger.Fatalf("parsing %s flag: %v", flagName, err)
+	}
+	if v == "" {
+		return 0
+	}
+
+	unixTime, err := parseTimestamp(v)
+	if err != nil {
+		logger.Fatalf("parsing %s flag: %v", flagName, err)
+	}
+
+	return unixTime
+}
+
+// parseTimestamp parses RFC3339 or UNIX-time string to UNIX-time.
+func parseTimestamp(v string) (int64, error) {
+	if unixTime, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return unixTime, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return 0, fmt.Errorf("should be RFC3339 or UNIX-time: %w", err)
+	}
+
+	return t.Unix(), nil
+}
+
+// addImportRollbackFlags adds imported prices deletion cmd flags.
+func addImportRollbackFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagActor, "", "(optional) operation initiator recorded to the audit trail (default: OS user name)")
+	cmd.Flags().String(flagReason, "", "(optional) operation reason recorded to the audit trail")
+	cmd.Flags().Bool(flagDeleteOrphans, false, "(optional) delete products that have no prices left")
+	cmd.Flags().Duration(flagTimeout, 1*time.Minute, "(optional) request timeout")
+}
+
+// parseImportRollbackFlags converts imported prices deletion cmd flags to gRPC ImportRollbackParams (crashes on failure).
+func parseImportRollbackFlags(logger *logrus.Logger, flags *pflag.FlagSet) *v1.ImportRollbackParams {
+	params := &v1.ImportRollbackParams{
+		DeleteOrphanProducts: parseBoolFlag(logger, flagDeleteOrphans, flags),
+	}
+
+	for flagName, target := range map[string]*string{
+		flagActor:  &params.Actor,
+		flagReason: &params.Reason,
+	} {
+		v, err := flags.GetString(flagName)
+		if err != nil {
+			logger.Fatalf("parsing %s flag: %v", flagName, err)
+		}
+		*target = v
+	}
+
+	if params.Actor == "" {
+		osUser, err := user.Current()
+		if err != nil {
+			logger.Fatalf("%s flag: not set and OS user lookup failed: %v", flagActor, err)
+		}
+		params.Actor = osUser.Username
+	}
+
+	return params
+}
+
+// addCSVDialectFlags adds CSV-file format cmd flags.
+func addCSVDialectFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagCSVDelimiter, "", "(optional) CSV format: fields delimiter char")
+	cmd.Flags().String(flagCSVQuote, "", "(optional) CSV format: quote char")
+	cmd.Flags().String(flagCSVComment, "", "(optional) CSV format: comment line prefix char")
+	cmd.Flags().Bool(flagCSVHeader, false, "(optional) CSV format: first row contains column names")
+	cmd.Flags().String(flagCSVProduct, "", "(optional) CSV format: product name column (header name or 0-based index)")
+	cmd.Flags().String(flagCSVPrice, "", "(optional) CSV format: price column (header name or 0-based index)")
+	cmd.Flags().String(flagCSVDecimalSep, "", "(optional) CSV format: price decimal separator char (. or ,)")
+	cmd.Flags().String(flagCSVCurrency, "", "(optional) CSV format: price ISO-4217 currency code")
+	cmd.Flags().String(flagCSVCurrencyCol, "", "(optional) CSV format: currency column (header name or 0-based index)")
+	cmd.Flags().StringSlice(flagCSVIgnore, nil, "(optional) CSV format: skipped columns (header names or 0-based indexes, \"*\" for all unmapped)")
+}
+
+// parseCSVDialectFlags converts CSV-file format cmd flags to gRPC CSVDialect (nil if none is set, crashes on failure).
+func parseCSVDialectFlags(logger *logrus.Logger, flags *pflag.FlagSet) *v1.CSVDialect {
+	changed := false
+	for _, flagName := range []string{
+		flagCSVDelimiter, flagCSVQuote, flagCSVComment, flagCSVHeader, flagCSVProduct, flagCSVPrice, flagCSVIgnore,
+		flagCSVDecimalSep, flagCSVCurrency, flagCSVCurrencyCol,
+	} {
+		if flags.Changed(flagName) {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	dialect := &v1.CSVDialect{
+		HasHeader: parseBoolFlag(logger, flagCSVHeader, flags),
+	}
+	for flagName, target := range map[string]*string{
+		flagCSVDelimiter:   &dialect.Delimiter,
+		flagCSVQuote:       &dialect.Quote,
+		flagCSVComment:     &dialect.Comment,
+		flagCSVProduct:     &dialect.ProductColumn,
+		flagCSVPrice:       &dialect.PriceColumn,
+		flagCSVDecimalSep:  &dialect.DecimalSeparator,
+		flagCSVCurrency:    &dialect.Currency,
+		flagCSVCurrencyCol: &dialect.CurrencyColumn,
+	} {
+		v, err := flags.GetString(flagName)
+		if err != nil {
+			logger.Fatalf("parsing %s flag: %v", flagName, err)
+		}
+		*target = v
+	}
+
+	ignoreColumns, err := flags.GetStringSlice(flagCSVIgnore)
+	if err != nil {
+		logger.Fatalf("parsing %s flag: %v", flagCSVIgnore, err)
+	}
+	dialect.IgnoreColumns = ignoreColumns
+
+	return dialect
+}
+
 // parseIntFlag parses int cmd flag (crashes on failure).
 func parseIntFlag(logger *logrus.Logger, flagName string, flags *pflag.FlagSet) int {
 	v, err := flags.GetInt(flagName)
@@ -185,6 +1250,26 @@ func parseIntFlag(logger *logrus.Logger, flagName string, flags *pflag.FlagSet)
 	return v
 }
 
+// parseBoolFlag parses bool cmd flag (crashes on failure).
+func parseBoolFlag(logger *logrus.Logger, flagName string, flags *pflag.FlagSet) bool {
+	v, err := flags.GetBool(flagName)
+	if err != nil {
+		logger.Fatalf("parsing %s flag: %v", flagName, err)
+	}
+
+	return v
+}
+
+// parseDurationFlag parses time.Duration cmd flag (crashes on failure).
+func parseDurationFlag(logger *logrus.Logger, flagName string, flags *pflag.FlagSet) time.Duration {
+	v, err := flags.GetDuration(flagName)
+	if err != nil {
+		logger.Fatalf("parsing %s flag: %v", flagName, err)
+	}
+
+	return v
+}
+
 // parseSortFlag converts cmd flag to gRPC SortOrder.
 func parseSortFlag(flagName string, flags *pflag.FlagSet) v1.SortOrder {
 	value := ""
@@ -246,7 +1331,25 @@ func createGRPCClientConnection(logger *logrus.Logger) (*grpc.ClientConn, error)
 
 func init() {
 	clientCmd.AddCommand(GetClientListCmd())
+	clientCmd.AddCommand(GetClientExportCmd())
+	clientCmd.AddCommand(GetClientLatestPricesCmd())
+	clientCmd.AddCommand(GetClientProductHistoryCmd())
+	clientCmd.AddCommand(GetClientCandlesCmd())
+	clientCmd.AddCommand(GetClientStatsCmd())
+	clientCmd.AddCommand(GetClientDiffImportsCmd())
+	clientCmd.AddCommand(GetClientTopMoversCmd())
 	clientCmd.AddCommand(GetClientFetchCmd())
+	clientCmd.AddCommand(GetClientUploadCmd())
+	clientCmd.AddCommand(GetClientImportJobCmd())
+	clientCmd.AddCommand(GetClientListImportJobsCmd())
+	clientCmd.AddCommand(GetClientDeleteImportCmd())
+	clientCmd.AddCommand(GetClientDeleteJobImportCmd())
+	clientCmd.AddCommand(GetClientListImportAuditCmd())
+	clientCmd.AddCommand(GetClientCreatePriceAlertCmd())
+	clientCmd.AddCommand(GetClientPriceAlertCmd())
+	clientCmd.AddCommand(GetClientListPriceAlertsCmd())
+	clientCmd.AddCommand(GetClientUpdatePriceAlertCmd())
+	clientCmd.AddCommand(GetClientDeletePriceAlertCmd())
 	clientCmd.AddCommand(GetClientFileServerCmd())
 	rootCmd.AddCommand(clientCmd)
 }
@@ -0,0 +1,327 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	v1 "github.com/itiky/mdb-tutorial/pkg/api/v1"
+)
+
+const (
+	flagAlertName          = "name"
+	flagAlertProduct       = "product"
+	flagAlertCondition     = "condition"
+	flagAlertThreshold     = "threshold"
+	flagAlertChangePercent = "change-percent"
+	flagAlertEnabled       = "enabled"
+)
+
+// GetClientCreatePriceAlertCmd returns a gRPC-client command for CreatePriceAlert() request.
+func GetClientCreatePriceAlertCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "alert-create",
+		Short:   "Create a price alert rule evaluated after each import",
+		Example: "alert-create --product {product} --currency USD --condition above --threshold 100.50",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := initLogger()
+
+			// parse inputs
+			rule := &v1.PriceAlertRule{}
+			parsePriceAlertFlags(logger, cmd.Flags(), rule, true)
+
+			// create gRPC client
+			conn, err := createGRPCClientConnection(logger)
+			if err != nil {
+				logger.Fatalf(err.Error())
+			}
+			defer conn.Close()
+
+			client := v1.NewPriceAlertsClient(conn)
+
+			// request
+			requestCtx, requestCancel := context.WithTimeout(context.Background(), 1*time.Second)
+			defer requestCancel()
+			resp, err := client.CreatePriceAlert(requestCtx, &v1.CreatePriceAlertRequest{
+				Rule: rule,
+			})
+			if err != nil {
+				logger.Fatalf("request failed: %v", err)
+			}
+
+			// print result
+			printPriceAlertRule(logger, resp)
+		},
+	}
+	addPriceAlertFlags(cmd, true)
+
+	return cmd
+}
+
+// GetClientPriceAlertCmd returns a gRPC-client command for GetPriceAlert() request.
+func GetClientPriceAlertCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "alert",
+		Short:   "Get price alert rule for specified rule ID arg",
+		Example: "alert {rule_id}",
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := initLogger()
+
+			// create gRPC client
+			conn, err := createGRPCClientConnection(logger)
+			if err != nil {
+				logger.Fatalf(err.Error())
+			}
+			defer conn.Close()
+
+			client := v1.NewPriceAlertsClient(conn)
+
+			// request
+			requestCtx, requestCancel := context.WithTimeout(context.Background(), 1*time.Second)
+			defer requestCancel()
+			resp, err := client.GetPriceAlert(requestCtx, &v1.GetPriceAlertRequest{
+				Id: args[0],
+			})
+			if err != nil {
+				logger.Fatalf("request failed: %v", err)
+			}
+
+			// print result
+			printPriceAlertRule(logger, resp)
+		},
+	}
+
+	return cmd
+}
+
+// GetClientListPriceAlertsCmd returns a gRPC-client command for ListPriceAlerts() request.
+func GetClientListPriceAlertsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "alerts",
+		Short: "List price alert rules",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := initLogger()
+
+			// parse inputs
+			pageSkip, pageLimit := parseIntFlag(logger, flagPageSkip, cmd.Flags()), parseIntFlag(logger, flagPageLimit, cmd.Flags())
+
+			// create gRPC client
+			conn, err := createGRPCClientConnection(logger)
+			if err != nil {
+				logger.Fatalf(err.Error())
+			}
+			defer conn.Close()
+
+			client := v1.NewPriceAlertsClient(conn)
+
+			// request
+			requestCtx, requestCancel := context.WithTimeout(context.Background(), 1*time.Second)
+			defer requestCancel()
+			resp, err := client.ListPriceAlerts(requestCtx, &v1.ListPriceAlertsRequest{
+				Pagination: &v1.PaginationParams{
+					Skip:  uint32(pageSkip),
+					Limit: uint32(pageLimit),
+				},
+			})
+			if err != nil {
+				logger.Fatalf("request failed: %v", err)
+			}
+
+			// print result
+			if len(resp.Rules) == 0 {
+				logger.Infof("no rules found")
+				return
+			}
+
+			for _, rule := range resp.Rules {
+				printPriceAlertRule(logger, rule)
+			}
+		},
+	}
+	cmd.Flags().Int(flagPageSkip, 0, "(optional) pagination param: skip")
+	cmd.Flags().Int(flagPageLimit, 50, "(optional) pagination param: limit")
+
+	return cmd
+}
+
+// GetClientUpdatePriceAlertCmd returns a gRPC-client command for UpdatePriceAlert() request.
+func GetClientUpdatePriceAlertCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "alert-update",
+		Short:   "Update price alert rule for specified rule ID arg (only the set flags are changed)",
+		Example: "alert-update {rule_id} --enabled=false",
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := initLogger()
+
+			// create gRPC client
+			conn, err := createGRPCClientConnection(logger)
+			if err != nil {
+				logger.Fatalf(err.Error())
+			}
+			defer conn.Close()
+
+			client := v1.NewPriceAlertsClient(conn)
+
+			// get the current rule state and apply changes
+			getCtx, getCancel := context.WithTimeout(context.Background(), 1*time.Second)
+			defer getCancel()
+			rule, err := client.GetPriceAlert(getCtx, &v1.GetPriceAlertRequest{
+				Id: args[0],
+			})
+			if err != nil {
+				logger.Fatalf("request failed: %v", err)
+			}
+			parsePriceAlertFlags(logger, cmd.Flags(), rule, false)
+
+			// request
+			requestCtx, requestCancel := context.WithTimeout(context.Background(), 1*time.Second)
+			defer requestCancel()
+			resp, err := client.UpdatePriceAlert(requestCtx, &v1.UpdatePriceAlertRequest{
+				Rule: rule,
+			})
+			if err != nil {
+				logger.Fatalf("request failed: %v", err)
+			}
+
+			// print result
+			printPriceAlertRule(logger, resp)
+		},
+	}
+	addPriceAlertFlags(cmd, false)
+
+	return cmd
+}
+
+// GetClientDeletePriceAlertCmd returns a gRPC-client command for DeletePriceAlert() request.
+func GetClientDeletePriceAlertCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "alert-delete",
+		Short:   "Delete price alert rule for specified rule ID arg",
+		Example: "alert-delete {rule_id}",
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := initLogger()
+
+			// create gRPC client
+			conn, err := createGRPCClientConnection(logger)
+			if err != nil {
+				logger.Fatalf(err.Error())
+			}
+			defer conn.Close()
+
+			client := v1.NewPriceAlertsClient(conn)
+
+			// request
+			requestCtx, requestCancel := context.WithTimeout(context.Background(), 1*time.Second)
+			defer requestCancel()
+			if _, err := client.DeletePriceAlert(requestCtx, &v1.DeletePriceAlertRequest{
+				Id: args[0],
+			}); err != nil {
+				logger.Fatalf("request failed: %v", err)
+			}
+
+			// print result
+			logger.Infof("rule %s: deleted", args[0])
+		},
+	}
+
+	return cmd
+}
+
+// addPriceAlertFlags adds price alert rule cmd flags.
+func addPriceAlertFlags(cmd *cobra.Command, create bool) {
+	requiredPrefix := "(optional)"
+	if create {
+		requiredPrefix = "(required)"
+	}
+
+	cmd.Flags().String(flagAlertName, "", "(optional) rule name")
+	cmd.Flags().String(flagAlertProduct, "", requiredPrefix+" product name")
+	cmd.Flags().String(flagCSVCurrency, "", requiredPrefix+" ISO-4217 currency code of compared prices")
+	cmd.Flags().String(flagAlertCondition, "", requiredPrefix+" alert condition: above, below, change")
+	cmd.Flags().String(flagAlertThreshold, "", "(above / below conditions) price threshold")
+	cmd.Flags().Float64(flagAlertChangePercent, 0, "(change condition) min absolute price change percentage since the previous import")
+	cmd.Flags().Bool(flagAlertEnabled, true, "(optional) rule is evaluated on imports")
+}
+
+// parsePriceAlertFlags sets price alert rule fields from cmd flags (only changed flags are applied unless create is set).
+func parsePriceAlertFlags(logger *logrus.Logger, flags *pflag.FlagSet, rule *v1.PriceAlertRule, create bool) {
+	for flagName, target := range map[string]*string{
+		flagAlertName:      &rule.Name,
+		flagAlertProduct:   &rule.ProductName,
+		flagCSVCurrency:    &rule.Currency,
+		flagAlertThreshold: &rule.Threshold,
+	} {
+		if !create && !flags.Changed(flagName) {
+			continue
+		}
+
+		v, err := flags.GetString(flagName)
+		if err != nil {
+			logger.Fatalf("parsing %s flag: %v", flagName, err)
+		}
+		*target = v
+	}
+
+	if create || flags.Changed(flagAlertCondition) {
+		conditionStr, err := flags.GetString(flagAlertCondition)
+		if err != nil {
+			logger.Fatalf("parsing %s flag: %v", flagAlertCondition, err)
+		}
+		condition, ok := map[string]v1.PriceAlertCondition{
+			"above":  v1.PriceAlertCondition_Above,
+			"below":  v1.PriceAlertCondition_Below,
+			"change": v1.PriceAlertCondition_Change,
+		}[strings.ToLower(conditionStr)]
+		if !ok {
+			logger.Fatalf("%s flag: unknown condition %q (above, below, change)", flagAlertCondition, conditionStr)
+		}
+		rule.Condition = condition
+	}
+
+	if create || flags.Changed(flagAlertChangePercent) {
+		v, err := flags.GetFloat64(flagAlertChangePercent)
+		if err != nil {
+			logger.Fatalf("parsing %s flag: %v", flagAlertChangePercent, err)
+		}
+		rule.ChangePercent = v
+	}
+
+	if create || flags.Changed(flagAlertEnabled) {
+		rule.Enabled = parseBoolFlag(logger, flagAlertEnabled, flags)
+	}
+}
+
+// printPriceAlertRule prints price alert rule.
+func printPriceAlertRule(logger *logrus.Logger, rule *v1.PriceAlertRule) {
+	condition := fmt.Sprintf("%s %s %s", strings.ToLower(rule.Condition.String()), rule.Threshold, rule.Currency)
+	if rule.Condition == v1.PriceAlertCondition_Change {
+		condition = fmt.Sprintf("change > %.2f%% (%s)", rule.ChangePercent, rule.Currency)
+	}
+
+	state := "enabled"
+	if !rule.Enabled {
+		state = "disabled"
+	}
+
+	logger.Infof("%s\t->\t%s\t->\t%s\t->\t%s\t->\t%s",
+		rule.Id,
+		rule.ProductName,
+		condition,
+		state,
+		time.Unix(rule.UpdatedAt, 0).Format(time.RFC3339),
+	)
+	if rule.Name != "" {
+		logger.Infof("\tname: %s", rule.Name)
+	}
+	if rule.LastTriggeredAt != 0 {
+		logger.Infof("\tlast triggered: %s", time.Unix(rule.LastTriggeredAt, 0).Format(time.RFC3339))
+	}
+}
@@ -0,0 +1,298 @@
+package command
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/source"
+	"github.com/xitongsys/parquet-go/writer"
+
+	v1 "github.com/itiky/mdb-tutorial/pkg/api/v1"
+)
+
+const (
+	flagExportFormat = "format"
+	flagExportOutput = "output"
+	flagExportLimit  = "limit"
+	//
+	exportFormatCSV     = "csv"
+	exportFormatJSONL   = "jsonl"
+	exportFormatParquet = "parquet"
+)
+
+// priceEntryWriter writes exported price entries to the output.
+type priceEntryWriter interface {
+	// Write writes a single entry.
+	Write(entry *v1.PriceEntry) error
+	// Close flushes buffered data (output itself is not closed).
+	Close() error
+}
+
+// GetClientExportCmd returns a gRPC-client command for Stream() request.
+func GetClientExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "export",
+		Short:   "Export price entries to CSV, JSON Lines or Parquet file",
+		Example: "export --format parquet --output prices.parquet --name-prefix Product",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := initLogger()
+
+			// parse inputs
+			format, err := cmd.Flags().GetString(flagExportFormat)
+			if err != nil {
+				logger.Fatalf("parsing %s flag: %v", flagExportFormat, err)
+			}
+			format = strings.ToLower(format)
+
+			outputPath, err := cmd.Flags().GetString(flagExportOutput)
+			if err != nil {
+				logger.Fatalf("parsing %s flag: %v", flagExportOutput, err)
+			}
+			if outputPath == "" && format == exportFormatParquet {
+				logger.Fatalf("%s flag: required for %s format", flagExportOutput, exportFormatParquet)
+			}
+
+			limit := parseIntFlag(logger, flagExportLimit, cmd.Flags())
+			timeout := parseDurationFlag(logger, flagTimeout, cmd.Flags())
+			sortByName, sortByPrice, sortByTimestamp := parseSortFlag(flagSortByName, cmd.Flags()), parseSortFlag(flagSortByPrice, cmd.Flags()), parseSortFlag(flagSortByTimestamp, cmd.Flags())
+			filter := parsePriceEntriesFilterFlags(logger, cmd.Flags())
+
+			// open output
+			output := os.Stdout
+			if outputPath != "" {
+				file, err := os.Create(outputPath)
+				if err != nil {
+					logger.Fatalf("creating output file: %v", err)
+				}
+				defer file.Close()
+				output = file
+			}
+
+			entryWriter, err := newPriceEntryWriter(format, output)
+			if err != nil {
+				logger.Fatalf(err.Error())
+			}
+
+			// create gRPC client
+			conn, err := createGRPCClientConnection(logger)
+			if err != nil {
+				logger.Fatalf(err.Error())
+			}
+			defer conn.Close()
+
+			client := v1.NewPriceEntryReaderClient(conn)
+
+			// request
+			requestCtx, requestCancel := context.WithTimeout(context.Background(), timeout)
+			defer requestCancel()
+			stream, err := client.Stream(requestCtx, &v1.StreamRequest{
+				SortByName:      sortByName,
+				SortByPrice:     sortByPrice,
+				SortByTimestamp: sortByTimestamp,
+				Filter:          filter,
+				Limit:           int64(limit),
+			})
+			if err != nil {
+				logger.Fatalf("request failed: %v", err)
+			}
+
+			// write entries as those are received
+			entriesCnt := 0
+			for {
+				entry, err := stream.Recv()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					logger.Fatalf("receiving entry [%d]: %v", entriesCnt, err)
+				}
+
+				if err := entryWriter.Write(entry); err != nil {
+					logger.Fatalf("writing entry [%d]: %v", entriesCnt, err)
+				}
+				entriesCnt++
+			}
+
+			if err := entryWriter.Close(); err != nil {
+				logger.Fatalf("flushing output: %v", err)
+			}
+
+			// print result
+			if outputPath != "" {
+				logger.Infof("%d entries exported to %s (%s)", entriesCnt, outputPath, format)
+			}
+		},
+	}
+	cmd.Flags().String(flagExportFormat, exportFormatCSV, "(optional) output format (csv, jsonl, parquet)")
+	cmd.Flags().String(flagExportOutput, "", "(optional) output file path (stdout if not set, required for parquet)")
+	cmd.Flags().Int(flagExportLimit, 0, "(optional) max number of entries to export (0 - no limit)")
+	cmd.Flags().String(flagSortByName, "", "(optional) sort param: by product name (ASC/DESC)")
+	cmd.Flags().String(flagSortByPrice, "", "(optional) sort param: by price (ASC/DESC)")
+	cmd.Flags().String(flagSortByTimestamp, "", "(optional) sort param: by timestamp (ASC/DESC)")
+	cmd.Flags().Duration(flagTimeout, 1*time.Hour, "(optional) export timeout")
+	addPriceEntriesFilterFlags(cmd)
+
+	return cmd
+}
+
+// newPriceEntryWriter creates a price entries writer for the export format.
+func newPriceEntryWriter(format string, output *os.File) (priceEntryWriter, error) {
+	switch format {
+	case exportFormatCSV:
+		return newCSVPriceEntryWriter(output), nil
+	case exportFormatJSONL:
+		return newJSONLPriceEntryWriter(output), nil
+	case exportFormatParquet:
+		return newParquetPriceEntryWriter(output)
+	default:
+		return nil, fmt.Errorf("%s flag: unsupported format %q (csv, jsonl, parquet)", flagExportFormat, format)
+	}
+}
+
+// csvPriceEntryWriter writes price entries as CSV rows with a header.
+type csvPriceEntryWriter struct {
+	writer *csv.Writer
+}
+
+func newCSVPriceEntryWriter(output io.Writer) *csvPriceEntryWriter {
+	w := &csvPriceEntryWriter{
+		writer: csv.NewWriter(output),
+	}
+	_ = w.writer.Write([]string{"product_name", "price", "currency", "timestamp"})
+
+	return w
+}
+
+// Write implements priceEntryWriter interface.
+func (w *csvPriceEntryWriter) Write(entry *v1.PriceEntry) error {
+	return w.writer.Write([]string{
+		entry.ProductName,
+		entry.Price,
+		entry.Currency,
+		time.Unix(entry.Timestamp, 0).UTC().Format(time.RFC3339),
+	})
+}
+
+// Close implements priceEntryWriter interface.
+func (w *csvPriceEntryWriter) Close() error {
+	w.writer.Flush()
+
+	return w.writer.Error()
+}
+
+// jsonlPriceEntryWriter writes price entries as JSON objects (one per line).
+type jsonlPriceEntryWriter struct {
+	buf     *bufio.Writer
+	encoder *json.Encoder
+}
+
+// jsonlPriceEntry is a JSON Lines output entry.
+type jsonlPriceEntry struct {
+	ProductName string `json:"product_name"`
+	Price       string `json:"price"`
+	Currency    string `json:"currency,omitempty"`
+	Timestamp   string `json:"timestamp"`
+}
+
+func newJSONLPriceEntryWriter(output io.Writer) *jsonlPriceEntryWriter {
+	buf := bufio.NewWriter(output)
+
+	return &jsonlPriceEntryWriter{
+		buf:     buf,
+		encoder: json.NewEncoder(buf),
+	}
+}
+
+// Write implements priceEntryWriter interface.
+func (w *jsonlPriceEntryWriter) Write(entry *v1.PriceEntry) error {
+	return w.encoder.Encode(jsonlPriceEntry{
+		ProductName: entry.ProductName,
+		Price:       entry.Price,
+		Currency:    entry.Currency,
+		Timestamp:   time.Unix(entry.Timestamp, 0).UTC().Format(time.RFC3339),
+	})
+}
+
+// Close implements priceEntryWriter interface.
+func (w *jsonlPriceEntryWriter) Close() error {
+	return w.buf.Flush()
+}
+
+// parquetPriceEntryWriter writes price entries to a Parquet file (rows are flushed by row groups).
+type parquetPriceEntryWriter struct {
+	writer *writer.ParquetWriter
+}
+
+// parquetPriceEntry is a Parquet output row.
+// Price is kept as a decimal string as amounts have no fixed scale.
+type parquetPriceEntry struct {
+	ProductName string `parquet:"name=product_name, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	Price       string `parquet:"name=price, type=UTF8"`
+	Currency    string `parquet:"name=currency, type=UTF8, encoding=PLAIN_DICTIONARY"`
+	Timestamp   int64  `parquet:"name=timestamp, type=TIMESTAMP_MILLIS"`
+}
+
+func newParquetPriceEntryWriter(output *os.File) (*parquetPriceEntryWriter, error) {
+	pw, err := writer.NewParquetWriter(parquetFile{File: output}, new(parquetPriceEntry), 1)
+	if err != nil {
+		return nil, fmt.Errorf("creating parquet writer: %w", err)
+	}
+	pw.CompressionType = parquet.CompressionCodec_SNAPPY
+
+	return &parquetPriceEntryWriter{
+		writer: pw,
+	}, nil
+}
+
+// Write implements priceEntryWriter interface.
+func (w *parquetPriceEntryWriter) Write(entry *v1.PriceEntry) error {
+	return w.writer.Write(parquetPriceEntry{
+		ProductName: entry.ProductName,
+		Price:       entry.Price,
+		Currency:    entry.Currency,
+		Timestamp:   entry.Timestamp * 1000,
+	})
+}
+
+// Close implements priceEntryWriter interface.
+func (w *parquetPriceEntryWriter) Close() error {
+	return w.writer.WriteStop()
+}
+
+// parquetFile adapts os.File to the parquet-go source.ParquetFile interface.
+type parquetFile struct {
+	*os.File
+}
+
+// Open implements source.ParquetFile interface (empty name reopens the current file).
+func (f parquetFile) Open(name string) (source.ParquetFile, error) {
+	if name == "" && f.File != nil {
+		name = f.Name()
+	}
+
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return parquetFile{File: file}, nil
+}
+
+// Create implements source.ParquetFile interface.
+func (f parquetFile) Create(name string) (source.ParquetFile, error) {
+	file, err := os.Create(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return parquetFile{File: file}, nil
+}
@@ -0,0 +1,120 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+)
+
+const (
+	flagMigrateTo    = "to"
+	flagMigrateSteps = "steps"
+)
+
+// migrateCmd is a MongoDB schema migrations root command.
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "MongoDB schema migrations (indexes)",
+}
+
+// GetMigrateUpCmd returns a command applying pending migrations.
+func GetMigrateUpCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "up",
+		Short: "Apply pending migrations",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := initLogger()
+
+			targetVersion := parseIntFlag(logger, flagMigrateTo, cmd.Flags())
+
+			st, mdbClient := initStorage(logger)
+			defer mdbClient.Disconnect(context.Background()) // nolint:errcheck
+
+			ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration(common.MongoDBMigrateTimeout))
+			defer cancel()
+
+			applied, err := st.Migration().Up(ctx, targetVersion)
+			for _, m := range applied {
+				fmt.Printf("Applied: %04d %s\n", m.Version, m.Description)
+			}
+			if err != nil {
+				logger.Fatalf("Migrate up: %v", err)
+			}
+			if len(applied) == 0 {
+				fmt.Println("No pending migrations")
+			}
+		},
+	}
+	cmd.Flags().Int(flagMigrateTo, 0, "(optional) target version (default: the latest one)")
+
+	return cmd
+}
+
+// GetMigrateDownCmd returns a command reverting the latest applied migrations.
+func GetMigrateDownCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "down",
+		Short: "Revert the latest applied migrations",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := initLogger()
+
+			steps := parseIntFlag(logger, flagMigrateSteps, cmd.Flags())
+
+			st, mdbClient := initStorage(logger)
+			defer mdbClient.Disconnect(context.Background()) // nolint:errcheck
+
+			ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration(common.MongoDBMigrateTimeout))
+			defer cancel()
+
+			reverted, err := st.Migration().Down(ctx, steps)
+			for _, m := range reverted {
+				fmt.Printf("Reverted: %04d %s\n", m.Version, m.Description)
+			}
+			if err != nil {
+				logger.Fatalf("Migrate down: %v", err)
+			}
+			if len(reverted) == 0 {
+				fmt.Println("No applied migrations")
+			}
+		},
+	}
+	cmd.Flags().Int(flagMigrateSteps, 1, "(optional) number of migrations to revert")
+
+	return cmd
+}
+
+// GetMigrateStatusCmd returns a command printing migrations state.
+func GetMigrateStatusCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Short: "Print migrations state",
+		Run: func(cmd *cobra.Command, args []string) {
+			logger := initLogger()
+
+			st, mdbClient := initStorage(logger)
+			defer mdbClient.Disconnect(context.Background()) // nolint:errcheck
+
+			ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration(common.MongoDBMigrateTimeout))
+			defer cancel()
+
+			statuses, err := st.Migration().Status(ctx)
+			if err != nil {
+				logger.Fatalf("Migrate status: %v", err)
+			}
+			for _, status := range statuses {
+				fmt.Println(status.String())
+			}
+		},
+	}
+}
+
+func init() {
+	migrateCmd.AddCommand(GetMigrateUpCmd())
+	migrateCmd.AddCommand(GetMigrateDownCmd())
+	migrateCmd.AddCommand(GetMigrateStatusCmd())
+	rootCmd.AddCommand(migrateCmd)
+}
@@ -10,8 +10,13 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"go.mongodb.org/mongo-driver/mongo"
 
 	"github.com/itiky/mdb-tutorial/pkg/common"
+	"github.com/itiky/mdb-tutorial/pkg/model"
+	"github.com/itiky/mdb-tutorial/pkg/mongodb"
+	"github.com/itiky/mdb-tutorial/pkg/service"
+	"github.com/itiky/mdb-tutorial/pkg/storage"
 )
 
 var (
@@ -63,6 +68,30 @@ func initLogger() *logrus.Logger {
 	return l
 }
 
+// initStorage connects to MongoDB and creates the storage (connection params are taken from viper, crashes on failure).
+func initStorage(logger *logrus.Logger) (storage.Storage, *mongo.Client) {
+	mdbClient, err := mongodb.Connect(mongodb.Configuration{
+		Url:  viper.GetString(common.MongoDBUrl),
+		Port: viper.GetString(common.MongoDBPort),
+	})
+	if err != nil {
+		logger.Fatalf("MongoDB connection failed: %v", err)
+	}
+	logger.Infof("MongoDB: connected")
+
+	st, err := storage.NewStorage(
+		storage.WithMongoDBClient(mdbClient),
+		storage.WithDatabase(viper.GetString(common.MongoDBDatabase)),
+		storage.WithLegacyPriceCurrency(viper.GetString(common.AppCSVCurrency)),
+		storage.WithLogger(logger),
+	)
+	if err != nil {
+		logger.Fatalf("storage dep init: %v", err)
+	}
+
+	return st, mdbClient
+}
+
 // getServerTLSCertificate creates a TLS certificate used for gRPC server (cert file pair is taken from viper).
 func getServerTLSCertificate() (*tls.Certificate, error) {
 	certPath := viper.GetString(common.AppTLSCertPath)
@@ -88,6 +117,110 @@ func getServerTLSCertificate() (*tls.Certificate, error) {
 	return &certificate, nil
 }
 
+// getCSVDialect builds the default CSV-file format from config.
+func getCSVDialect() (model.CSVDialect, error) {
+	dialect := model.CSVDialect{
+		HasHeader:      viper.GetBool(common.AppCSVHeader),
+		ProductColumn:  viper.GetString(common.AppCSVProductColumn),
+		PriceColumn:    viper.GetString(common.AppCSVPriceColumn),
+		IgnoreColumns:  viper.GetStringSlice(common.AppCSVIgnoreColumns),
+		Currency:       viper.GetString(common.AppCSVCurrency),
+		CurrencyColumn: viper.GetString(common.AppCSVCurrencyCol),
+	}
+
+	for _, charParam := range []struct {
+		key      string
+		target   *rune
+		optional bool
+	}{
+		{key: common.AppCSVDelimiter, target: &dialect.Delimiter},
+		{key: common.AppCSVQuote, target: &dialect.Quote},
+		{key: common.AppCSVComment, target: &dialect.Comment, optional: true},
+		{key: common.AppCSVDecimalSep, target: &dialect.DecimalSeparator},
+	} {
+		value := []rune(viper.GetString(charParam.key))
+		if len(value) == 0 && charParam.optional {
+			continue
+		}
+		if len(value) != 1 {
+			return model.CSVDialect{}, fmt.Errorf("%s config: should be a single char", charParam.key)
+		}
+		*charParam.target = value[0]
+	}
+
+	if err := dialect.Validate(); err != nil {
+		return model.CSVDialect{}, fmt.Errorf("CSV dialect config: %v", err)
+	}
+
+	return dialect, nil
+}
+
+// getPriceAlertNotifiers builds the configured price alert notifiers (log notifier is always enabled, webhook one - if URL is set).
+func getPriceAlertNotifiers(logger *logrus.Logger) ([]service.PriceAlertNotifier, error) {
+	logNotifier, err := service.NewLogPriceAlertNotifier(logger)
+	if err != nil {
+		return nil, fmt.Errorf("alerts config: %v", err)
+	}
+	notifiers := []service.PriceAlertNotifier{logNotifier}
+
+	if webhookURL := viper.GetString(common.AppAlertsWebhookURL); webhookURL != "" {
+		notifier, err := service.NewWebhookPriceAlertNotifier(service.WebhookNotifierConfig{
+			URL:     webhookURL,
+			Timeout: viper.GetDuration(common.AppAlertsWebhookTimeout),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("alerts config: %v", err)
+		}
+		notifiers = append(notifiers, notifier)
+	}
+
+	return notifiers, nil
+}
+
+// getSourceFetchers builds the configured non-HTTP CSV-file source fetchers (file fetcher is enabled if roots are set, SFTP - if user is set).
+func getSourceFetchers() ([]service.SourceFetcher, error) {
+	fetchers := make([]service.SourceFetcher, 0)
+
+	if roots := viper.GetStringSlice(common.AppSourcesFileRoots); len(roots) > 0 {
+		fetcher, err := service.NewFileSourceFetcher(roots)
+		if err != nil {
+			return nil, fmt.Errorf("sources config: %v", err)
+		}
+		fetchers = append(fetchers, fetcher)
+	}
+
+	if viper.GetBool(common.AppSourcesS3Enabled) {
+		fetcher, err := service.NewS3SourceFetcher(service.S3FetcherConfig{
+			Endpoint:        viper.GetString(common.AppSourcesS3Endpoint),
+			Region:          viper.GetString(common.AppSourcesS3Region),
+			AccessKeyID:     viper.GetString(common.AppSourcesS3AccessKeyID),
+			SecretAccessKey: viper.GetString(common.AppSourcesS3SecretAccessKey),
+			PathStyle:       viper.GetBool(common.AppSourcesS3PathStyle),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("sources config: %v", err)
+		}
+		fetchers = append(fetchers, fetcher)
+	}
+
+	if viper.GetString(common.AppSourcesSFTPUser) != "" {
+		fetcher, err := service.NewSFTPSourceFetcher(service.SFTPFetcherConfig{
+			User:                  viper.GetString(common.AppSourcesSFTPUser),
+			Password:              viper.GetString(common.AppSourcesSFTPPassword),
+			PrivateKeyPath:        viper.GetString(common.AppSourcesSFTPPrivateKeyPath),
+			KnownHostsPath:        viper.GetString(common.AppSourcesSFTPKnownHostsPath),
+			InsecureIgnoreHostKey: viper.GetBool(common.AppSourcesSFTPInsecureHostKey),
+			ConnectTimeout:        viper.GetDuration(common.AppSourcesSFTPConnectTimeout),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("sources config: %v", err)
+		}
+		fetchers = append(fetchers, fetcher)
+	}
+
+	return fetchers, nil
+}
+
 func init() {
 	rootCmd.PersistentFlags().StringVar(&configFile, "config", "", "config file path")
 	rootCmd.PersistentFlags().StringVar(&logLevel, "log-level", "info", "logging level (debug, info, warn, error, fatal, panic)")
@@ -14,11 +14,13 @@ import (
 
 	v1 "github.com/itiky/mdb-tutorial/pkg/api/v1"
 	"github.com/itiky/mdb-tutorial/pkg/common"
-	"github.com/itiky/mdb-tutorial/pkg/mongodb"
+	"github.com/itiky/mdb-tutorial/pkg/model"
 	"github.com/itiky/mdb-tutorial/pkg/service"
-	"github.com/itiky/mdb-tutorial/pkg/storage"
 )
 
+// importJobsExpiryCheckInterval is a period of failing import jobs with expired leases (jobs of crashed instances).
+const importJobsExpiryCheckInterval = 30 * time.Second
+
 // serverCmd is a gRPC-server start command.
 var serverCmd = &cobra.Command{
 	Use:   "start",
@@ -26,45 +28,123 @@ var serverCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		logger := initLogger()
 
-		// MongoDB connection
-		mdbClient, err := mongodb.Connect(mongodb.Configuration{
-			Url:  viper.GetString(common.MongoDBUrl),
-			Port: viper.GetString(common.MongoDBPort),
-		})
+		// Init dependencies
+		storage, mdbClient := initStorage(logger)
+
+		if viper.GetBool(common.MongoDBMigrateOnStart) {
+			migrateCtx, migrateCancel := context.WithTimeout(context.Background(), viper.GetDuration(common.MongoDBMigrateTimeout))
+			applied, err := storage.Migration().Up(migrateCtx, 0)
+			migrateCancel()
+			if err != nil {
+				logger.Fatalf("MongoDB migrations: %v", err)
+			}
+			logger.Infof("MongoDB migrations: %d applied", len(applied))
+		}
+
+		sourceFetchers, err := getSourceFetchers()
 		if err != nil {
-			logger.Fatalf("MongoDB connection failed: %v", err)
+			logger.Fatalf(err.Error())
 		}
-		logger.Infof("MongoDB: connected")
 
-		// Init dependencies
-		storage, err := storage.NewStorage(
-			storage.WithMongoDBClient(mdbClient),
-			storage.WithDatabase(viper.GetString(common.MongoDBDatabase)),
-			storage.WithLogger(logger),
-		)
+		alertNotifiers, err := getPriceAlertNotifiers(logger)
 		if err != nil {
-			logger.Fatalf("storage dep init: %v", err)
+			logger.Fatalf(err.Error())
 		}
 
-		service, err := service.NewService(
+		importAtomicity := model.ImportAtomicity(viper.GetString(common.AppImportAtomic))
+		if importAtomicity != model.ImportAtomicityNone {
+			checkCtx, checkCancel := context.WithTimeout(context.Background(), 10*time.Second)
+			supported, err := storage.SupportsTransactions(checkCtx)
+			checkCancel()
+			if err != nil {
+				logger.Fatalf("MongoDB transactions support check: %v", err)
+			}
+			if !supported {
+				logger.Errorf("Import atomicity %q requires MongoDB transactions, which aren't supported by a standalone server (a replica set or a sharded cluster is required): falling back to %q", importAtomicity, model.ImportAtomicityNone)
+				importAtomicity = model.ImportAtomicityNone
+			}
+		}
+
+		serviceOpts := []service.Option{
 			service.WithStorage(storage),
 			service.WithLogger(logger),
-		)
+			service.WithImportMode(model.ImportMode(viper.GetString(common.AppImportMode))),
+			service.WithImportAtomicity(importAtomicity),
+			service.WithFileAtomicityMaxRows(viper.GetInt(common.AppImportFileAtomicityMaxRows)),
+			service.WithMaxDecompressedSize(viper.GetInt64(common.AppImportMaxDecompressedSize)),
+			service.WithZipLimits(model.ZipLimits{
+				MaxEntries:          viper.GetInt(common.AppImportZipMaxEntries),
+				MaxUncompressedSize: viper.GetInt64(common.AppImportZipMaxUncompressedSize),
+			}),
+			service.WithDownloadRetryPolicy(model.DownloadRetryPolicy{
+				MaxAttempts:    viper.GetInt(common.AppDownloadMaxAttempts),
+				InitialBackoff: viper.GetDuration(common.AppDownloadInitialBackoff),
+				MaxBackoff:     viper.GetDuration(common.AppDownloadMaxBackoff),
+				AttemptTimeout: viper.GetDuration(common.AppDownloadAttemptTimeout),
+			}),
+		}
+		for _, fetcher := range sourceFetchers {
+			serviceOpts = append(serviceOpts, service.WithSourceFetcher(fetcher))
+		}
+		for _, notifier := range alertNotifiers {
+			serviceOpts = append(serviceOpts, service.WithPriceAlertNotifier(notifier))
+		}
+		if instanceID := viper.GetString(common.AppInstanceID); instanceID != "" {
+			serviceOpts = append(serviceOpts, service.WithInstanceID(instanceID))
+		}
+
+		service, err := service.NewService(serviceOpts...)
 		if err != nil {
 			logger.Fatalf("service dep init: %v", err)
 		}
 
+		// jobs of the previous run can't be resumed
+		recoverCtx, recoverCancel := context.WithTimeout(context.Background(), 30*time.Second)
+		_, err = service.ImportJobs().FailInterrupted(recoverCtx)
+		recoverCancel()
+		if err != nil {
+			logger.Fatalf("import jobs recovery: %v", err)
+		}
+
+		// jobs of other instances crashed while this one is running
+		expiryCtx, expiryCancel := context.WithCancel(context.Background())
+		expiryDone := make(chan struct{})
+		go func() {
+			defer close(expiryDone)
+
+			ticker := time.NewTicker(importJobsExpiryCheckInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ticker.C:
+					if _, err := service.ImportJobs().FailExpired(expiryCtx); err != nil && expiryCtx.Err() == nil {
+						logger.Errorf("import jobs expiry check: %v", err)
+					}
+				case <-expiryCtx.Done():
+					return
+				}
+			}
+		}()
+
 		// get TLS certificate
 		certificate, err := getServerTLSCertificate()
 		if err != nil {
 			logger.Fatalf(err.Error())
 		}
 
+		// get default CSV-file format
+		csvDialect, err := getCSVDialect()
+		if err != nil {
+			logger.Fatalf(err.Error())
+		}
+
 		// Start gRPC server
 		server, err := v1.NewServer(
 			v1.WithService(service),
 			v1.WithLogger(logger),
 			v1.WithCSVChunkSize(viper.GetInt(common.AppChunkSize)),
+			v1.WithCSVChunkWorkers(viper.GetInt(common.AppChunkWorkers)),
+			v1.WithCSVDialect(csvDialect),
 			v1.WithTLS(certificate),
 		)
 		if err != nil {
@@ -88,12 +168,31 @@ var serverCmd = &cobra.Command{
 		signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
 		<-stop
 
-		// Shutdown
+		// Shutdown: in-flight RPCs (synchronous uploads included) are finished first, those are canceled on timeout
+		serverStopped := make(chan struct{})
+		go func() {
+			server.GracefulStop()
+			close(serverStopped)
+		}()
+		select {
+		case <-serverStopped:
+			logger.Infof("gRPC server: stopped")
+		case <-time.After(viper.GetDuration(common.ServerShutdownTimeout)):
+			server.Stop()
+			logger.Warnf("gRPC server: stopped (graceful stop timeout, in-flight requests are canceled)")
+		}
+
+		expiryCancel()
+		<-expiryDone
+
 		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer shutdownCancel()
 
-		server.Stop()
-		logger.Infof("gRPC server: stopped")
+		if err := service.ImportJobs().Shutdown(shutdownCtx); err != nil {
+			logger.Errorf("import jobs: %v", err)
+		} else {
+			logger.Infof("import jobs: stopped")
+		}
 		_ = mdbClient.Disconnect(shutdownCtx)
 		logger.Infof("MongoDB: disconnected")
 	},
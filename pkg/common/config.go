@@ -6,29 +6,118 @@ import (
 
 const (
 	// Application
-	AppLogLevel    = "app.logLevel"
-	AppChunkSize   = "app.chunkSize"
-	AppTLSCertPath = "app.tls.certPath"
-	AppTLSKeyPath  = "app.tls.keyPath"
+	AppLogLevel     = "app.logLevel"
+	AppInstanceID   = "app.instanceID"
+	AppChunkSize    = "app.chunkSize"
+	AppChunkWorkers = "app.chunkWorkers"
+	AppTLSCertPath  = "app.tls.certPath"
+	AppTLSKeyPath   = "app.tls.keyPath"
+	AppImportMode   = "app.import.mode"
+	AppImportAtomic = "app.import.atomicity"
+	// Application: file import atomicity transaction limit
+	AppImportFileAtomicityMaxRows = "app.import.fileAtomicityMaxRows"
+	// Application: gzip / zstd CSV-file decompression limit
+	AppImportMaxDecompressedSize = "app.import.maxDecompressedSize"
+	// Application: zip archive extraction limits
+	AppImportZipMaxEntries          = "app.import.zip.maxEntries"
+	AppImportZipMaxUncompressedSize = "app.import.zip.maxUncompressedSize"
+	// Application: CSV-file download retry policy
+	AppDownloadMaxAttempts    = "app.download.maxAttempts"
+	AppDownloadInitialBackoff = "app.download.initialBackoff"
+	AppDownloadMaxBackoff     = "app.download.maxBackoff"
+	AppDownloadAttemptTimeout = "app.download.attemptTimeout"
+	// Application: CSV-file source fetchers
+	AppSourcesFileRoots           = "app.sources.file.roots"
+	AppSourcesS3Enabled           = "app.sources.s3.enabled"
+	AppSourcesS3Endpoint          = "app.sources.s3.endpoint"
+	AppSourcesS3Region            = "app.sources.s3.region"
+	AppSourcesS3AccessKeyID       = "app.sources.s3.accessKeyID"
+	AppSourcesS3SecretAccessKey   = "app.sources.s3.secretAccessKey"
+	AppSourcesS3PathStyle         = "app.sources.s3.pathStyle"
+	AppSourcesSFTPUser            = "app.sources.sftp.user"
+	AppSourcesSFTPPassword        = "app.sources.sftp.password"
+	AppSourcesSFTPPrivateKeyPath  = "app.sources.sftp.privateKeyPath"
+	AppSourcesSFTPKnownHostsPath  = "app.sources.sftp.knownHostsPath"
+	AppSourcesSFTPInsecureHostKey = "app.sources.sftp.insecureIgnoreHostKey"
+	AppSourcesSFTPConnectTimeout  = "app.sources.sftp.connectTimeout"
+	// Application: price alert notifiers
+	AppAlertsWebhookURL     = "app.alerts.webhook.url"
+	AppAlertsWebhookTimeout = "app.alerts.webhook.timeout"
+	// Application: default CSV-file format
+	AppCSVDelimiter     = "app.csv.delimiter"
+	AppCSVQuote         = "app.csv.quote"
+	AppCSVComment       = "app.csv.comment"
+	AppCSVHeader        = "app.csv.header"
+	AppCSVProductColumn = "app.csv.productColumn"
+	AppCSVPriceColumn   = "app.csv.priceColumn"
+	AppCSVIgnoreColumns = "app.csv.ignoreColumns"
+	AppCSVDecimalSep    = "app.csv.decimalSeparator"
+	AppCSVCurrency      = "app.csv.currency"
+	AppCSVCurrencyCol   = "app.csv.currencyColumn"
 	// Server
 	ServerHost = "server.host"
 	ServerPort = "server.port"
+	// Server: graceful stop timeout (in-flight requests are canceled after)
+	ServerShutdownTimeout = "server.shutdownTimeout"
 	// MongoDB
 	MongoDBUrl      = "mdb.url"
 	MongoDBPort     = "mdb.port"
 	MongoDBDatabase = "mdb.database"
+	// MongoDB: schema migrations
+	MongoDBMigrateOnStart = "mdb.migrations.onStart"
+	MongoDBMigrateTimeout = "mdb.migrations.timeout"
 )
 
 func init() {
 	// Application
 	viper.SetDefault(AppLogLevel, "info")
+	viper.SetDefault(AppInstanceID, "")
 	viper.SetDefault(AppChunkSize, "3")
+	viper.SetDefault(AppChunkWorkers, 1)
 	viper.SetDefault(AppTLSCertPath, "")
 	viper.SetDefault(AppTLSKeyPath, "")
+	viper.SetDefault(AppImportMode, "tmpfile")
+	viper.SetDefault(AppImportAtomic, "none")
+	viper.SetDefault(AppImportFileAtomicityMaxRows, 100000)
+	viper.SetDefault(AppImportMaxDecompressedSize, 1073741824)
+	viper.SetDefault(AppImportZipMaxEntries, 1000)
+	viper.SetDefault(AppImportZipMaxUncompressedSize, 1073741824)
+	viper.SetDefault(AppDownloadMaxAttempts, 5)
+	viper.SetDefault(AppDownloadInitialBackoff, "1s")
+	viper.SetDefault(AppDownloadMaxBackoff, "30s")
+	viper.SetDefault(AppDownloadAttemptTimeout, "0s")
+	viper.SetDefault(AppSourcesFileRoots, []string{})
+	viper.SetDefault(AppSourcesS3Enabled, false)
+	viper.SetDefault(AppSourcesS3Endpoint, "")
+	viper.SetDefault(AppSourcesS3Region, "us-east-1")
+	viper.SetDefault(AppSourcesS3AccessKeyID, "")
+	viper.SetDefault(AppSourcesS3SecretAccessKey, "")
+	viper.SetDefault(AppSourcesS3PathStyle, false)
+	viper.SetDefault(AppSourcesSFTPUser, "")
+	viper.SetDefault(AppSourcesSFTPPassword, "")
+	viper.SetDefault(AppSourcesSFTPPrivateKeyPath, "")
+	viper.SetDefault(AppSourcesSFTPKnownHostsPath, "")
+	viper.SetDefault(AppSourcesSFTPInsecureHostKey, false)
+	viper.SetDefault(AppSourcesSFTPConnectTimeout, "10s")
+	viper.SetDefault(AppAlertsWebhookURL, "")
+	viper.SetDefault(AppAlertsWebhookTimeout, "10s")
+	viper.SetDefault(AppCSVDelimiter, ";")
+	viper.SetDefault(AppCSVQuote, `"`)
+	viper.SetDefault(AppCSVComment, "")
+	viper.SetDefault(AppCSVHeader, false)
+	viper.SetDefault(AppCSVProductColumn, "0")
+	viper.SetDefault(AppCSVPriceColumn, "1")
+	viper.SetDefault(AppCSVIgnoreColumns, []string{})
+	viper.SetDefault(AppCSVDecimalSep, ".")
+	viper.SetDefault(AppCSVCurrency, "USD")
+	viper.SetDefault(AppCSVCurrencyCol, "")
 	// Server
 	viper.SetDefault(ServerHost, "127.0.0.1")
+	viper.SetDefault(ServerShutdownTimeout, "30s")
 	// MongoDB
 	viper.SetDefault(MongoDBUrl, "localhost")
 	viper.SetDefault(ServerPort, "27017")
 	viper.SetDefault(MongoDBDatabase, "db")
+	viper.SetDefault(MongoDBMigrateOnStart, true)
+	viper.SetDefault(MongoDBMigrateTimeout, "10m")
 }
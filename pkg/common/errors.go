@@ -3,6 +3,7 @@ package common
 import "fmt"
 
 var (
-	ErrNotFound     = fmt.Errorf("not found")
-	ErrInvalidInput = fmt.Errorf("invalid input")
+	ErrNotFound      = fmt.Errorf("not found")
+	ErrInvalidInput  = fmt.Errorf("invalid input")
+	ErrAlreadyExists = fmt.Errorf("already exists")
 )
@@ -54,9 +54,11 @@ func (o SortOptions) ToBSON() []bson.E {
 }
 
 // PaginationOption keeps pagination request info.
+// Cursor is an opaque continuation token returned by the previous page request (keyset pagination, only supported by some requests).
 type PaginationOption struct {
-	Skip  int
-	Limit int
+	Skip   int
+	Limit  int
+	Cursor string
 }
 
 // Validate validates PaginationOption.
@@ -70,6 +72,9 @@ func (o PaginationOption) Validate() error {
 	if o.Limit > MaxLimit {
 		return fmt.Errorf("limit: should be LT %d", MaxLimit)
 	}
+	if o.Cursor != "" && o.Skip != 0 {
+		return fmt.Errorf("skip: should be 0 if cursor is set")
+	}
 
 	return nil
 }
@@ -81,3 +86,11 @@ func NewPaginationOption(skip, limit int) PaginationOption {
 		Limit: limit,
 	}
 }
+
+// NewCursorPaginationOption creates a new PaginationOption object for keyset pagination.
+func NewCursorPaginationOption(cursor string, limit int) PaginationOption {
+	return PaginationOption{
+		Limit:  limit,
+		Cursor: cursor,
+	}
+}
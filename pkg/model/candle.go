@@ -0,0 +1,78 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+)
+
+const (
+	CandleIntervalHour CandleInterval = "hour"
+	CandleIntervalDay  CandleInterval = "day"
+	CandleIntervalWeek CandleInterval = "week"
+)
+
+// CandleInterval defines candle bucket size (buckets are UTC aligned, weeks start on Monday).
+type CandleInterval string
+
+// IsValid checks CandleInterval is supported.
+func (i CandleInterval) IsValid() bool {
+	switch i {
+	case CandleIntervalHour, CandleIntervalDay, CandleIntervalWeek:
+		return true
+	default:
+		return false
+	}
+}
+
+// Truncate returns the interval bucket start for the DateTime.
+func (i CandleInterval) Truncate(t time.Time) time.Time {
+	t = t.UTC()
+	switch i {
+	case CandleIntervalHour:
+		return t.Truncate(time.Hour)
+	case CandleIntervalDay:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	case CandleIntervalWeek:
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	default:
+		return t
+	}
+}
+
+// Candle keeps open / high / low / close prices of a product within an interval bucket.
+type Candle struct {
+	// Bucket start DateTime
+	Start    time.Time `json:"start" bson:"start"`
+	Currency string    `json:"currency" bson:"currency"`
+	// The first and the last bucket prices (in the import and CSV-file rows order)
+	Open  Money `json:"open" bson:"open"`
+	Close Money `json:"close" bson:"close"`
+	// Max and min bucket prices
+	High Money `json:"high" bson:"high"`
+	Low  Money `json:"low" bson:"low"`
+	// Number of bucket price points
+	Count int64 `json:"count" bson:"count"`
+}
+
+// CandlesParams keeps product candles query params.
+type CandlesParams struct {
+	// Prices import DateTime range [from, to) (optional)
+	TimestampFrom time.Time
+	TimestampTo   time.Time
+	Interval      CandleInterval
+}
+
+// Validate validates CandlesParams.
+func (p CandlesParams) Validate() error {
+	if !p.TimestampFrom.IsZero() && !p.TimestampTo.IsZero() && !p.TimestampTo.After(p.TimestampFrom) {
+		return fmt.Errorf("%w: timestampFrom / timestampTo: to should be GT from (%s / %s)", common.ErrInvalidInput, p.TimestampFrom, p.TimestampTo)
+	}
+	if !p.Interval.IsValid() {
+		return fmt.Errorf("%w: interval: unknown (%s)", common.ErrInvalidInput, p.Interval)
+	}
+
+	return nil
+}
@@ -11,8 +11,33 @@ type CSVImport struct {
 // CSVEntry contains one CSV import file row data.
 type CSVEntry struct {
 	ProductName string
-	Price       int
+	Price       Money
+	// CSV-file line number (0 if unknown), defines the entry order within the import
+	Row int
 }
 
 // CSVEntries is a slice of CSVEntry objects.
 type CSVEntries []CSVEntry
+
+const (
+	CSVCompressionNone CSVCompression = ""
+	CSVCompressionGzip CSVCompression = "gzip"
+	CSVCompressionZstd CSVCompression = "zstd"
+	CSVCompressionZip  CSVCompression = "zip"
+
+	// Default max decompressed size of gzip / zstd CSV-files [bytes]
+	DefaultCSVMaxDecompressedSize = 1 << 30
+)
+
+// CSVCompression defines downloaded CSV-file compression / archive format.
+type CSVCompression string
+
+// CSVFile keeps downloaded CSV-file data.
+type CSVFile struct {
+	// Local file path
+	Path string
+	// Prices import DateTime
+	Timestamp time.Time
+	// Source metadata
+	Source ImportSource
+}
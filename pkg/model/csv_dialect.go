@@ -0,0 +1,181 @@
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+)
+
+const (
+	// CSVColumnIgnoreAll is a CSVDialect.IgnoreColumns wildcard ignoring all the unmapped columns.
+	CSVColumnIgnoreAll = "*"
+)
+
+// CSVDialect defines CSV-file format.
+type CSVDialect struct {
+	// Fields delimiter
+	Delimiter rune `json:"delimiter" bson:"delimiter"`
+	// Quote char (ASCII only)
+	Quote rune `json:"quote" bson:"quote"`
+	// Comment line prefix char (0 - comments are disabled)
+	Comment rune `json:"comment" bson:"comment"`
+	// First row contains column names
+	HasHeader bool `json:"has_header" bson:"has_header"`
+	// Product name column: header name or 0-based index
+	ProductColumn string `json:"product_column" bson:"product_column"`
+	// Price column: header name or 0-based index
+	PriceColumn string `json:"price_column" bson:"price_column"`
+	// Columns (header names or 0-based indexes) expected in a file, but skipped
+	IgnoreColumns []string `json:"ignore_columns" bson:"ignore_columns"`
+	// Price decimal separator ('.' or ',')
+	DecimalSeparator rune `json:"decimal_separator" bson:"decimal_separator"`
+	// Price ISO-4217 currency code (default for empty currency column values)
+	Currency string `json:"currency" bson:"currency"`
+	// Optional currency column: header name or 0-based index
+	CurrencyColumn string `json:"currency_column" bson:"currency_column"`
+}
+
+// Validate validates CSVDialect.
+func (d CSVDialect) Validate() error {
+	if !isValidCSVDelimiter(d.Delimiter) || d.Delimiter == '"' {
+		return fmt.Errorf("%w: delimiter: invalid (%q)", common.ErrInvalidInput, d.Delimiter)
+	}
+	if d.Quote <= 0 || d.Quote >= utf8.RuneSelf || !isValidCSVDelimiter(d.Quote) {
+		return fmt.Errorf("%w: quote: should be a printable ASCII char (%q)", common.ErrInvalidInput, d.Quote)
+	}
+	if d.Quote == d.Delimiter {
+		return fmt.Errorf("%w: quote: should differ from delimiter", common.ErrInvalidInput)
+	}
+	if d.Comment != 0 {
+		if !isValidCSVDelimiter(d.Comment) || d.Comment == '"' {
+			return fmt.Errorf("%w: comment: invalid (%q)", common.ErrInvalidInput, d.Comment)
+		}
+		if d.Comment == d.Delimiter || d.Comment == d.Quote {
+			return fmt.Errorf("%w: comment: should differ from delimiter and quote", common.ErrInvalidInput)
+		}
+	}
+
+	if d.ProductColumn == "" {
+		return fmt.Errorf("%w: productColumn: empty", common.ErrInvalidInput)
+	}
+	if d.PriceColumn == "" {
+		return fmt.Errorf("%w: priceColumn: empty", common.ErrInvalidInput)
+	}
+	if d.ProductColumn == d.PriceColumn {
+		return fmt.Errorf("%w: productColumn / priceColumn: should differ", common.ErrInvalidInput)
+	}
+	if d.CurrencyColumn != "" && (d.CurrencyColumn == d.ProductColumn || d.CurrencyColumn == d.PriceColumn) {
+		return fmt.Errorf("%w: currencyColumn: should differ from productColumn / priceColumn", common.ErrInvalidInput)
+	}
+
+	if d.DecimalSeparator != '.' && d.DecimalSeparator != ',' {
+		return fmt.Errorf("%w: decimalSeparator: should be '.' or ',' (%q)", common.ErrInvalidInput, d.DecimalSeparator)
+	}
+	if d.DecimalSeparator == d.Delimiter {
+		return fmt.Errorf("%w: decimalSeparator: should differ from delimiter", common.ErrInvalidInput)
+	}
+	if d.Currency == "" && d.CurrencyColumn == "" {
+		return fmt.Errorf("%w: currency / currencyColumn: one of them should be set", common.ErrInvalidInput)
+	}
+	if d.Currency != "" && !IsValidCurrency(d.Currency) {
+		return fmt.Errorf("%w: currency: invalid ISO-4217 code (%s)", common.ErrInvalidInput, d.Currency)
+	}
+
+	// columns are referenced by index if there are no column names
+	if !d.HasHeader {
+		columns := append([]string{d.ProductColumn, d.PriceColumn}, d.IgnoreColumns...)
+		if d.CurrencyColumn != "" {
+			columns = append(columns, d.CurrencyColumn)
+		}
+		for _, column := range columns {
+			if column == CSVColumnIgnoreAll {
+				continue
+			}
+			if _, ok := ParseCSVColumnIndex(column); !ok {
+				return fmt.Errorf("%w: column %q: should be a 0-based index for a file without header", common.ErrInvalidInput, column)
+			}
+		}
+	}
+
+	return nil
+}
+
+// IgnoresAll checks if all the unmapped columns should be ignored.
+func (d CSVDialect) IgnoresAll() bool {
+	for _, column := range d.IgnoreColumns {
+		if column == CSVColumnIgnoreAll {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NewDefaultCSVDialect returns the default CSV-file format: PRODUCT_NAME;PRICE (USD prices with '.' decimal separator).
+func NewDefaultCSVDialect() CSVDialect {
+	return CSVDialect{
+		Delimiter:        ';',
+		Quote:            '"',
+		Comment:          0,
+		HasHeader:        false,
+		ProductColumn:    "0",
+		PriceColumn:      "1",
+		IgnoreColumns:    []string{},
+		DecimalSeparator: '.',
+		Currency:         "USD",
+		CurrencyColumn:   "",
+	}
+}
+
+// CSVProcessParams keeps CSV-file processing parameters.
+type CSVProcessParams struct {
+	// Number of entries per chunk
+	ChunkSize int
+	// Number of concurrent chunk workers (0 - a single worker)
+	Workers int
+	// Stop processing on the first failed chunk (the rest chunks are not reported)
+	FailFast bool
+	// CSV-file format
+	Dialect CSVDialect
+}
+
+// Validate validates CSVProcessParams.
+func (p CSVProcessParams) Validate() error {
+	if p.ChunkSize <= 0 {
+		return fmt.Errorf("%w: chunkSize should be GT 0", common.ErrInvalidInput)
+	}
+	if p.Workers < 0 {
+		return fmt.Errorf("%w: workers should be GTE 0", common.ErrInvalidInput)
+	}
+	if err := p.Dialect.Validate(); err != nil {
+		return fmt.Errorf("dialect: %w", err)
+	}
+
+	return nil
+}
+
+// GetWorkers returns the number of concurrent chunk workers.
+func (p CSVProcessParams) GetWorkers() int {
+	if p.Workers <= 0 {
+		return 1
+	}
+
+	return p.Workers
+}
+
+// ParseCSVColumnIndex parses 0-based column index.
+func ParseCSVColumnIndex(column string) (int, bool) {
+	idx, err := strconv.Atoi(column)
+	if err != nil || idx < 0 {
+		return 0, false
+	}
+
+	return idx, true
+}
+
+// isValidCSVDelimiter checks if rune could be used as a CSV special char.
+func isValidCSVDelimiter(r rune) bool {
+	return r != 0 && r != '\r' && r != '\n' && r != utf8.RuneError && utf8.ValidRune(r)
+}
@@ -0,0 +1,94 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+)
+
+// DownloadRetryPolicy defines CSV-file download retry parameters.
+type DownloadRetryPolicy struct {
+	// Max number of download attempts (including resumed ones)
+	MaxAttempts int
+	// Delay before the 2nd attempt (doubled for every next one)
+	InitialBackoff time.Duration
+	// Max delay between attempts
+	MaxBackoff time.Duration
+	// Single attempt timeout (0 - no limit)
+	AttemptTimeout time.Duration
+}
+
+// Validate validates DownloadRetryPolicy.
+func (p DownloadRetryPolicy) Validate() error {
+	if p.MaxAttempts <= 0 {
+		return fmt.Errorf("%w: maxAttempts: should be GT 0", common.ErrInvalidInput)
+	}
+	if p.InitialBackoff < 0 {
+		return fmt.Errorf("%w: initialBackoff: should be GTE 0", common.ErrInvalidInput)
+	}
+	if p.MaxBackoff < p.InitialBackoff {
+		return fmt.Errorf("%w: maxBackoff: should be GTE initialBackoff", common.ErrInvalidInput)
+	}
+	if p.AttemptTimeout < 0 {
+		return fmt.Errorf("%w: attemptTimeout: should be GTE 0", common.ErrInvalidInput)
+	}
+
+	return nil
+}
+
+// Backoff returns delay before the attempt (1-based).
+func (p DownloadRetryPolicy) Backoff(attempt int) time.Duration {
+	if attempt <= 1 {
+		return 0
+	}
+
+	backoff := p.InitialBackoff
+	for i := 2; i < attempt && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+
+	return backoff
+}
+
+// NewDefaultDownloadRetryPolicy returns the default download retry policy.
+func NewDefaultDownloadRetryPolicy() DownloadRetryPolicy {
+	return DownloadRetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: 1 * time.Second,
+		MaxBackoff:     30 * time.Second,
+		AttemptTimeout: 0,
+	}
+}
+
+// DownloadAttempt keeps a single CSV-file download attempt result.
+type DownloadAttempt struct {
+	// Attempt sequence number (1-based)
+	Number int
+	// Requested content offset (GT 0 for resumed attempts)
+	Offset int64
+	// HTTP response status code (0 if request has failed)
+	StatusCode int
+	// Number of body bytes received
+	BytesRead int64
+	// Attempt duration
+	Duration time.Duration
+	// Attempt error (empty if succeeded)
+	Error string
+}
+
+// String returns attempt result summary.
+func (a DownloadAttempt) String() string {
+	str := strings.Builder{}
+	str.WriteString(fmt.Sprintf("#%d: offset %d, status %d, %d bytes, %s", a.Number, a.Offset, a.StatusCode, a.BytesRead, a.Duration.Round(time.Millisecond)))
+	if a.Error != "" {
+		str.WriteString(": ")
+		str.WriteString(a.Error)
+	}
+
+	return str.String()
+}
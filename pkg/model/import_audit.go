@@ -0,0 +1,70 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+)
+
+const (
+	ImportAuditActionDelete ImportAuditAction = "delete"
+	//
+	importRollbackActorMaxLen  = 256
+	importRollbackReasonMaxLen = 1024
+)
+
+// ImportAuditAction defines prices import management operation type.
+type ImportAuditAction string
+
+// ImportRollbackParams keeps prices import deletion request parameters.
+type ImportRollbackParams struct {
+	// Operation initiator (required)
+	Actor string
+	// Operation initiator network address (if known)
+	ClientAddr string
+	// Operation reason (optional)
+	Reason string
+	// Delete products that have no prices left after the import deletion
+	DeleteOrphanProducts bool
+}
+
+// Validate validates ImportRollbackParams.
+func (p ImportRollbackParams) Validate() error {
+	if p.Actor == "" {
+		return fmt.Errorf("%w: actor: empty", common.ErrInvalidInput)
+	}
+	if len(p.Actor) > importRollbackActorMaxLen {
+		return fmt.Errorf("%w: actor: should be LTE %d chars", common.ErrInvalidInput, importRollbackActorMaxLen)
+	}
+	if len(p.Reason) > importRollbackReasonMaxLen {
+		return fmt.Errorf("%w: reason: should be LTE %d chars", common.ErrInvalidInput, importRollbackReasonMaxLen)
+	}
+
+	return nil
+}
+
+// ImportAuditEntry keeps prices import management operation record.
+type ImportAuditEntry struct {
+	ID primitive.ObjectID `json:"_id" bson:"_id"`
+	// Operation type
+	Action ImportAuditAction `json:"action" bson:"action"`
+	// Operation initiator
+	Actor string `json:"actor" bson:"actor"`
+	// Operation initiator network address (if known)
+	ClientAddr string `json:"client_addr" bson:"client_addr"`
+	// Operation reason
+	Reason string `json:"reason" bson:"reason"`
+	// Import job ID (if the operation targets a job)
+	JobID string `json:"job_id" bson:"job_id"`
+	// Affected prices import DateTimes
+	Timestamps []time.Time `json:"timestamps" bson:"timestamps"`
+	// Number of deleted PricesImport objects
+	DeletedPriceImports int64 `json:"deleted_price_imports" bson:"deleted_price_imports"`
+	// Number of deleted orphan Product objects
+	DeletedProducts int64 `json:"deleted_products" bson:"deleted_products"`
+	// Record create DateTime
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+}
@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+)
+
+// ImportDiff keeps two prices imports comparison result.
+// Product price within an import is the highest CSV-file row one of the currency,
+// prices of a currency present in a single import only are not compared.
+type ImportDiff struct {
+	// Compared imports DateTimes (seconds precision)
+	FromTimestamp time.Time
+	ToTimestamp   time.Time
+	// Products of the "to" import only
+	NewProducts []ProductPrices
+	// Products of the "from" import only
+	RemovedProducts []ProductPrices
+	// Changed prices of products of both imports
+	Increased []PriceChange
+	Decreased []PriceChange
+	// Number of not changed prices of products of both imports
+	UnchangedCount int
+}
+
+// ProductPrices keeps product prices within an import (one per currency).
+type ProductPrices struct {
+	Name   string
+	Prices []Money
+}
+
+// PriceChange keeps product price change between two imports.
+type PriceChange struct {
+	Name string
+	From Money
+	To   Money
+	// Absolute delta (To - From)
+	Delta Money
+	// Delta percentage of the From price (0 if From is zero)
+	DeltaPercent float64
+}
@@ -0,0 +1,172 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+)
+
+const (
+	ImportJobStateQueued      ImportJobState = "queued"
+	ImportJobStateDownloading ImportJobState = "downloading"
+	ImportJobStateProcessing  ImportJobState = "processing"
+	ImportJobStateDone        ImportJobState = "done"
+	ImportJobStateFailed      ImportJobState = "failed"
+	ImportJobStateSkipped     ImportJobState = "skipped"
+	ImportJobStateRolledBack  ImportJobState = "rolled_back"
+)
+
+const (
+	ImportModeTmpFile ImportMode = "tmpfile"
+	ImportModeStream  ImportMode = "stream"
+)
+
+const (
+	ImportAtomicityNone  ImportAtomicity = "none"
+	ImportAtomicityChunk ImportAtomicity = "chunk"
+	ImportAtomicityFile  ImportAtomicity = "file"
+
+	// Default max number of CSV-file rows imported within a single file atomicity transaction
+	// (MongoDB transaction lifetime is limited, 60s by default)
+	DefaultImportFileAtomicityMaxRows = 100000
+)
+
+// ImportMode defines how downloaded CSV-files are processed:
+//   - tmpfile: file is downloaded to a temp dir first (duplicates are skipped before processing, zip archives are supported);
+//   - stream: response body is processed on the fly without touching the disk (duplicates are rolled back after processing);
+type ImportMode string
+
+// Validate validates ImportMode.
+func (m ImportMode) Validate() error {
+	switch m {
+	case ImportModeTmpFile, ImportModeStream:
+		return nil
+	default:
+		return fmt.Errorf("%w: import mode: unknown (%s)", common.ErrInvalidInput, m)
+	}
+}
+
+// ImportAtomicity defines how imported CSV-file data is committed:
+//   - none: every chunk write is committed independently (a failed import could be partially applied);
+//   - chunk: every chunk is written within a transaction (a failed chunk write leaves no partial data,
+//     valid rows of chunks with parsing errors are still imported), chunks are written by a single worker;
+//   - file: the whole file (all archive entries) is written within a single transaction by a single worker,
+//     processing is stopped on the first failed chunk or once the max rows limit is exceeded and nothing is imported;
+type ImportAtomicity string
+
+// Validate validates ImportAtomicity.
+func (a ImportAtomicity) Validate() error {
+	switch a {
+	case ImportAtomicityNone, ImportAtomicityChunk, ImportAtomicityFile:
+		return nil
+	default:
+		return fmt.Errorf("%w: import atomicity: unknown (%s)", common.ErrInvalidInput, a)
+	}
+}
+
+// ImportJobState defines CSV-file import job state.
+type ImportJobState string
+
+// IsFinal checks if job state can't be changed anymore.
+func (s ImportJobState) IsFinal() bool {
+	return s == ImportJobStateDone || s == ImportJobStateFailed || s == ImportJobStateSkipped || s == ImportJobStateRolledBack
+}
+
+// ImportJobParams keeps import job request parameters.
+type ImportJobParams struct {
+	// Source CSV-file URL
+	URL string
+	// Import the file even if the same content has already been imported
+	Force bool
+	// CSV-file processing params
+	CSVParams CSVProcessParams
+}
+
+// ImportJob keeps asynchronous CSV-file import job data.
+type ImportJob struct {
+	ID primitive.ObjectID `json:"_id" bson:"_id"`
+	// Source CSV-file URL
+	URL string `json:"url" bson:"url"`
+	// Re-import of the already imported content is requested
+	Force bool `json:"force" bson:"force"`
+	// CSV-file format
+	Dialect CSVDialect `json:"dialect" bson:"dialect"`
+	// Import data commit mode
+	Atomicity ImportAtomicity `json:"atomicity" bson:"atomicity"`
+	// Server instance ID the job is run by (unfinished jobs are failed on the instance restart)
+	Owner string `json:"owner" bson:"owner"`
+	// Current job state
+	State ImportJobState `json:"state" bson:"state"`
+	// Prices import DateTime (set once the file is downloaded)
+	ImportTimestamp time.Time `json:"import_timestamp" bson:"import_timestamp"`
+	// Downloaded file metadata (set once the file is downloaded)
+	Source ImportSource `json:"source" bson:"source"`
+	// Chunks processing progress
+	Progress ImportJobProgress `json:"progress" bson:"progress"`
+	// Processed archive entries (for archived sources only)
+	ArchiveEntries []ImportArchiveEntry `json:"archive_entries" bson:"archive_entries"`
+	// Accumulated failed chunks errors
+	ChunkErrors []ImportChunkError `json:"chunk_errors" bson:"chunk_errors"`
+	// Job level error (set for the failed state)
+	Error string `json:"error" bson:"error"`
+	// Job create / last update DateTimes
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" bson:"updated_at"`
+}
+
+// ImportTimestamps returns import DateTimes of the job prices: archive entries are imported with their own ones.
+func (j ImportJob) ImportTimestamps() []time.Time {
+	if len(j.ArchiveEntries) == 0 {
+		return []time.Time{j.ImportTimestamp}
+	}
+
+	timestamps := make([]time.Time, 0, len(j.ArchiveEntries))
+	for _, archiveEntry := range j.ArchiveEntries {
+		timestamps = append(timestamps, archiveEntry.ImportTimestamp)
+	}
+
+	return timestamps
+}
+
+// ImportJobProgress is an embedded ImportJob struct.
+type ImportJobProgress struct {
+	// Number of processed chunks (including failed ones)
+	ChunksProcessed int `json:"chunks_processed" bson:"chunks_processed"`
+	// Number of failed chunks
+	ChunksFailed int `json:"chunks_failed" bson:"chunks_failed"`
+	// Number of imported CSV entries
+	EntriesProcessed int `json:"entries_processed" bson:"entries_processed"`
+}
+
+// ImportArchiveEntry is an embedded ImportJob struct.
+// Every archive CSV-file is imported separately with its own prices import DateTime.
+type ImportArchiveEntry struct {
+	Name            string    `json:"name" bson:"name"`
+	ImportTimestamp time.Time `json:"import_timestamp" bson:"import_timestamp"`
+}
+
+// ImportChunkError is an embedded ImportJob struct.
+type ImportChunkError struct {
+	ChunkID int `json:"chunk_id" bson:"chunk_id"`
+	// Archive entry name (for archived sources only)
+	ArchiveEntry   string   `json:"archive_entry" bson:"archive_entry"`
+	ParsingErrors  []string `json:"parsing_errors" bson:"parsing_errors"`
+	ExecutionError string   `json:"execution_error" bson:"execution_error"`
+}
+
+// ImportChunkResult contains CSV-file chunk processing result.
+type ImportChunkResult struct {
+	ChunkID int
+	// Number of chunk entries passed to the worker
+	Entries int
+	// Chunk errors (nil if succeeded)
+	Error *ImportChunkError
+}
+
+// IsFailed checks if chunk processing has failed.
+func (r ImportChunkResult) IsFailed() bool {
+	return r.Error != nil
+}
@@ -0,0 +1,39 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ImportSource keeps imported CSV-file source metadata.
+type ImportSource struct {
+	// Source CSV-file URL
+	URL string `json:"url" bson:"url"`
+	// HTTP ETag header value (if provided by source)
+	ETag string `json:"etag" bson:"etag"`
+	// File size [bytes]
+	Size int64 `json:"size" bson:"size"`
+	// File content SHA-256 hash (HEX)
+	ContentHash string `json:"content_hash" bson:"content_hash"`
+	// File compression / archive format (empty for plain CSV-files)
+	Compression CSVCompression `json:"compression" bson:"compression"`
+}
+
+// ImportLedgerEntry keeps CSV-file import record.
+// Not forced entries claim the content hash uniquely, so concurrent imports of the same content are skipped.
+type ImportLedgerEntry struct {
+	ID primitive.ObjectID `json:"_id" bson:"_id"`
+	// Imported file metadata
+	Source ImportSource `json:"source" bson:"source"`
+	// Prices import DateTime
+	Timestamp time.Time `json:"timestamp" bson:"timestamp"`
+	// Import job ID (optional)
+	JobID primitive.ObjectID `json:"job_id" bson:"job_id,omitempty"`
+	// Forced re-import record (doesn't claim the content hash)
+	Forced bool `json:"forced" bson:"forced"`
+	// Content is being imported (the claim is completed once the import is done or released if it fails)
+	Pending bool `json:"pending" bson:"pending"`
+	// Record create DateTime
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+}
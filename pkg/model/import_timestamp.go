@@ -0,0 +1,19 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ImportTimestamp keeps prices import timestamp reservation.
+// Every import (zip archive entry included) reserves its own timestamp uniquely,
+// so prices of different imports are never merged into the same one.
+type ImportTimestamp struct {
+	// Prices import DateTime (milliseconds precision)
+	Timestamp time.Time `json:"timestamp" bson:"_id"`
+	// Import job ID the timestamp is reserved by
+	JobID primitive.ObjectID `json:"job_id" bson:"job_id"`
+	// Record create DateTime
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+}
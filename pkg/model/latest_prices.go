@@ -0,0 +1,47 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+)
+
+const (
+	latestPricesFilterMaxNames = 1000
+)
+
+// LatestPrices is an output for Product / the most recent PricesImport aggregate.
+type LatestPrices struct {
+	Name string `json:"name" bson:"name"`
+	// Latest prices import DateTime
+	Timestamp time.Time `json:"timestamp" bson:"timestamp"`
+	// Latest prices import prices
+	Prices []Money `json:"prices" bson:"prices"`
+}
+
+// LatestPricesFilter keeps LatestPrices query filters.
+type LatestPricesFilter struct {
+	// Exact product names (all products if empty)
+	ProductNames []string
+	// Prices as of the DateTime: the latest price import with timestamp LTE (the most recent one if zero)
+	AsOf time.Time
+}
+
+// Validate validates LatestPricesFilter.
+func (f LatestPricesFilter) Validate() error {
+	if len(f.ProductNames) > latestPricesFilterMaxNames {
+		return fmt.Errorf("%w: productNames: should be LTE %d items", common.ErrInvalidInput, latestPricesFilterMaxNames)
+	}
+
+	for i, name := range f.ProductNames {
+		if name == "" {
+			return fmt.Errorf("%w: productNames [%d]: empty", common.ErrInvalidInput, i)
+		}
+		if len(name) > priceEntriesFilterNameMaxLen {
+			return fmt.Errorf("%w: productNames [%d]: should be LTE %d chars", common.ErrInvalidInput, i, priceEntriesFilterNameMaxLen)
+		}
+	}
+
+	return nil
+}
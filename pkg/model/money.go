@@ -0,0 +1,192 @@
+package model
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+)
+
+const (
+	// Max number of significant digits Decimal128 keeps exactly
+	moneyMaxDigits = 34
+)
+
+var (
+	moneyAmountRegexp   = regexp.MustCompile(`^[+-]?[0-9]+(\.[0-9]+)?$`)
+	moneyCurrencyRegexp = regexp.MustCompile(`^[A-Z]{3}$`)
+)
+
+// Money keeps an exact decimal amount with ISO-4217 currency code.
+type Money struct {
+	Amount   primitive.Decimal128 `json:"amount" bson:"amount"`
+	Currency string               `json:"currency" bson:"currency"`
+}
+
+// Validate validates Money.
+func (m Money) Validate() error {
+	if m.Amount.IsNaN() || m.Amount.IsInf() != 0 {
+		return fmt.Errorf("%w: amount: not a number (%s)", common.ErrInvalidInput, m.Amount)
+	}
+	if !IsValidCurrency(m.Currency) {
+		return fmt.Errorf("%w: currency: invalid ISO-4217 code (%s)", common.ErrInvalidInput, m.Currency)
+	}
+
+	return nil
+}
+
+// IsNegative checks if amount is LT 0.
+func (m Money) IsNegative() bool {
+	return m.rat().Sign() < 0
+}
+
+// Sign returns amount sign: -1 if LT 0, 0 if EQ 0, +1 if GT 0.
+func (m Money) Sign() int {
+	return m.rat().Sign()
+}
+
+// Cmp compares amounts (currency is not taken into account): -1 if LT, 0 if EQ, +1 if GT.
+func (m Money) Cmp(other Money) int {
+	return m.rat().Cmp(other.rat())
+}
+
+// Sub returns exact amounts difference (m - other) keeping m currency.
+func (m Money) Sub(other Money) (Money, error) {
+	bi1, exp1, err := m.Amount.BigInt()
+	if err != nil {
+		return Money{}, fmt.Errorf("%w: amount: %v", common.ErrInvalidInput, err)
+	}
+	bi2, exp2, err := other.Amount.BigInt()
+	if err != nil {
+		return Money{}, fmt.Errorf("%w: other amount: %v", common.ErrInvalidInput, err)
+	}
+
+	// align exponents to the smallest one
+	exp := exp1
+	if exp2 < exp {
+		exp = exp2
+	}
+	bi1.Mul(bi1, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp1-exp)), nil))
+	bi2.Mul(bi2, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp2-exp)), nil))
+
+	value, ok := primitive.ParseDecimal128FromBigInt(new(big.Int).Sub(bi1, bi2), exp)
+	if !ok {
+		return Money{}, fmt.Errorf("%w: amounts difference overflow", common.ErrInvalidInput)
+	}
+
+	return Money{Amount: value, Currency: m.Currency}, nil
+}
+
+// PercentOf returns m amount as a percentage of the base amount (ok is false if base is zero).
+func (m Money) PercentOf(base Money) (float64, bool) {
+	baseRat := base.rat()
+	if baseRat.Sign() == 0 {
+		return 0, false
+	}
+
+	percent, _ := new(big.Rat).Mul(new(big.Rat).Quo(m.rat(), baseRat), big.NewRat(100, 1)).Float64()
+
+	return percent, true
+}
+
+// String returns amount with currency, like "12.99 USD".
+func (m Money) String() string {
+	return fmt.Sprintf("%s %s", m.Amount.String(), m.Currency)
+}
+
+// rat converts amount to big.Rat (NaN and Inf are converted to zero).
+func (m Money) rat() *big.Rat {
+	bi, exp, err := m.Amount.BigInt()
+	if err != nil {
+		return new(big.Rat)
+	}
+
+	r := new(big.Rat).SetInt(bi)
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(exp))), nil))
+	if exp >= 0 {
+		return r.Mul(r, scale)
+	}
+
+	return r.Quo(r, scale)
+}
+
+// ParseMoney parses a decimal amount string using decimalSeparator ('.' or ',').
+// Thousands separators and exponents are not supported, so the amount is parsed exactly.
+func ParseMoney(amount string, decimalSeparator rune, currency string) (Money, error) {
+	value, err := parseAmount(amount, decimalSeparator)
+	if err != nil {
+		return Money{}, err
+	}
+
+	m := Money{
+		Amount:   value,
+		Currency: strings.ToUpper(strings.TrimSpace(currency)),
+	}
+	if err := m.Validate(); err != nil {
+		return Money{}, err
+	}
+
+	return m, nil
+}
+
+// ParseAmount parses a '.' separated decimal amount string without currency (used for amount comparisons only).
+func ParseAmount(amount string) (Money, error) {
+	value, err := parseAmount(amount, '.')
+	if err != nil {
+		return Money{}, err
+	}
+
+	return Money{Amount: value}, nil
+}
+
+// parseAmount parses a decimal amount string to Decimal128.
+func parseAmount(amount string, decimalSeparator rune) (primitive.Decimal128, error) {
+	amount = strings.TrimSpace(amount)
+	if decimalSeparator != '.' {
+		if strings.ContainsRune(amount, '.') {
+			return primitive.Decimal128{}, fmt.Errorf("%w: amount: unexpected decimal separator (%s)", common.ErrInvalidInput, amount)
+		}
+		amount = strings.Replace(amount, string(decimalSeparator), ".", 1)
+	}
+
+	if !moneyAmountRegexp.MatchString(amount) {
+		return primitive.Decimal128{}, fmt.Errorf("%w: amount: invalid decimal (%s)", common.ErrInvalidInput, amount)
+	}
+	if digits := len(strings.TrimLeft(amount, "+-")) - strings.Count(amount, "."); digits > moneyMaxDigits {
+		return primitive.Decimal128{}, fmt.Errorf("%w: amount: too many digits (%s)", common.ErrInvalidInput, amount)
+	}
+
+	value, err := primitive.ParseDecimal128(amount)
+	if err != nil {
+		return primitive.Decimal128{}, fmt.Errorf("%w: amount: %v", common.ErrInvalidInput, err)
+	}
+
+	return value, nil
+}
+
+// MustParseMoney parses a '.' separated decimal amount string (crashes on failure).
+func MustParseMoney(amount, currency string) Money {
+	m, err := ParseMoney(amount, '.', currency)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+// IsValidCurrency checks if code is a valid ISO-4217 alphabetic currency code format.
+func IsValidCurrency(code string) bool {
+	return moneyCurrencyRegexp.MatchString(code)
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+
+	return v
+}
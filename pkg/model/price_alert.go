@@ -0,0 +1,132 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+)
+
+const (
+	// Price crosses the threshold upwards
+	PriceAlertConditionAbove PriceAlertCondition = "above"
+	// Price crosses the threshold downwards
+	PriceAlertConditionBelow PriceAlertCondition = "below"
+	// Price moves more than the change percentage between imports (in any direction)
+	PriceAlertConditionChange PriceAlertCondition = "change"
+	//
+	priceAlertNameMaxLen = 256
+)
+
+// PriceAlertCondition defines price alert rule trigger condition.
+type PriceAlertCondition string
+
+// IsValid checks PriceAlertCondition is supported.
+func (c PriceAlertCondition) IsValid() bool {
+	switch c {
+	case PriceAlertConditionAbove, PriceAlertConditionBelow, PriceAlertConditionChange:
+		return true
+	default:
+		return false
+	}
+}
+
+// PriceAlertRule keeps a product price alert rule.
+type PriceAlertRule struct {
+	ID primitive.ObjectID `json:"_id" bson:"_id"`
+	// Rule name (optional)
+	Name string `json:"name" bson:"name"`
+	// Exact product name
+	ProductName string `json:"product_name" bson:"product_name"`
+	// Compared prices ISO-4217 currency code
+	Currency  string              `json:"currency" bson:"currency"`
+	Condition PriceAlertCondition `json:"condition" bson:"condition"`
+	// Price threshold (above / below conditions)
+	Threshold Money `json:"threshold" bson:"threshold"`
+	// Price change percentage threshold (change condition)
+	ChangePercent float64 `json:"change_percent" bson:"change_percent"`
+	// Disabled rules are not evaluated
+	Enabled bool `json:"enabled" bson:"enabled"`
+	// The last notification DateTime (zero if never triggered)
+	LastTriggeredAt time.Time `json:"last_triggered_at" bson:"last_triggered_at"`
+	// Record create / update DateTimes
+	CreatedAt time.Time `json:"created_at" bson:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" bson:"updated_at"`
+}
+
+// Validate validates PriceAlertRule user defined fields.
+func (r PriceAlertRule) Validate() error {
+	if len(r.Name) > priceAlertNameMaxLen {
+		return fmt.Errorf("%w: name: should be LTE %d chars", common.ErrInvalidInput, priceAlertNameMaxLen)
+	}
+	if r.ProductName == "" {
+		return fmt.Errorf("%w: productName: empty", common.ErrInvalidInput)
+	}
+	if len(r.ProductName) > priceEntriesFilterNameMaxLen {
+		return fmt.Errorf("%w: productName: should be LTE %d chars", common.ErrInvalidInput, priceEntriesFilterNameMaxLen)
+	}
+	if !IsValidCurrency(r.Currency) {
+		return fmt.Errorf("%w: currency: invalid ISO-4217 code (%s)", common.ErrInvalidInput, r.Currency)
+	}
+
+	switch r.Condition {
+	case PriceAlertConditionAbove, PriceAlertConditionBelow:
+		if err := r.Threshold.Validate(); err != nil {
+			return fmt.Errorf("threshold: %w", err)
+		}
+		if r.Threshold.Currency != r.Currency {
+			return fmt.Errorf("%w: threshold: currency mismatch (%s / %s)", common.ErrInvalidInput, r.Threshold.Currency, r.Currency)
+		}
+		if r.Threshold.IsNegative() {
+			return fmt.Errorf("%w: threshold: should be GTE 0 (%s)", common.ErrInvalidInput, r.Threshold)
+		}
+	case PriceAlertConditionChange:
+		if r.ChangePercent <= 0 {
+			return fmt.Errorf("%w: changePercent: should be GT 0 (%f)", common.ErrInvalidInput, r.ChangePercent)
+		}
+	default:
+		return fmt.Errorf("%w: condition: unknown (%s)", common.ErrInvalidInput, r.Condition)
+	}
+
+	return nil
+}
+
+// PriceAlertNotification keeps a triggered price alert rule notification.
+type PriceAlertNotification struct {
+	Rule PriceAlertRule
+	// Triggering prices import DateTime
+	ImportTimestamp time.Time
+	// The import price and the previous one (HasPreviousPrice is false if the product had no prices of the currency before)
+	Price            Money
+	PreviousPrice    Money
+	HasPreviousPrice bool
+	// Price change percentage relative to the previous price (0 if not available)
+	DeltaPercent float64
+}
+
+// Message returns a human-readable notification text.
+func (n PriceAlertNotification) Message() string {
+	ruleName := n.Rule.ID.Hex()
+	if n.Rule.Name != "" {
+		ruleName = fmt.Sprintf("%s (%s)", n.Rule.Name, ruleName)
+	}
+
+	previousPrice := "none"
+	if n.HasPreviousPrice {
+		previousPrice = n.PreviousPrice.String()
+	}
+
+	var condition string
+	switch n.Rule.Condition {
+	case PriceAlertConditionChange:
+		condition = fmt.Sprintf("moved more than %g%% (%+.2f%%)", n.Rule.ChangePercent, n.DeltaPercent)
+	default:
+		condition = fmt.Sprintf("crossed %s %s", n.Rule.Condition, n.Rule.Threshold)
+	}
+
+	return fmt.Sprintf("price alert %s: %q price %s: %s -> %s (import %s)",
+		ruleName, n.Rule.ProductName, condition, previousPrice, n.Price, n.ImportTimestamp.Format(time.RFC3339),
+	)
+}
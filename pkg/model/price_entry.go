@@ -1,21 +1,108 @@
 package model
 
 import (
+	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/itiky/mdb-tutorial/pkg/common"
 )
 
+const (
+	priceEntriesFilterNameMaxLen = 1024
+)
+
 // PriceEntry is an output for Product / PricesImport aggregate.
 type PriceEntry struct {
 	Name      string    `json:"name" bson:"name"`
-	Price     int       `json:"price" bson:"price"`
+	Price     Money     `json:"price" bson:"price"`
 	Timestamp time.Time `json:"timestamp" bson:"timestamp"`
 }
 
 // PriceEntries is a slice of PriceEntry objects.
 type PriceEntries []PriceEntry
 
+// PriceEntriesPage keeps a page of PriceEntry objects.
+type PriceEntriesPage struct {
+	Entries PriceEntries
+	// Opaque continuation token for the next page request (empty if there are no more entries)
+	NextCursor string
+	// There are more entries after the page
+	HasMore bool
+	// Total number of filtered entries (-1 if not calculated)
+	TotalCount int64
+}
+
+// PriceEntriesFilter keeps PriceEntry query filters (set ones are combined with AND).
+type PriceEntriesFilter struct {
+	// Exact product name
+	ProductName string
+	// Product name prefix
+	ProductNamePrefix string
+	// Product name regular expression (MongoDB PCRE syntax)
+	ProductNameRegex string
+	// Price currency (ISO-4217 code)
+	Currency string
+	// Price amount range [min, max] of the Currency (amounts of different currencies aren't comparable, so Currency is required)
+	PriceMin *Money
+	PriceMax *Money
+	// Prices import DateTime range [from, to)
+	TimestampFrom time.Time
+	TimestampTo   time.Time
+}
+
+// Validate validates PriceEntriesFilter.
+func (f PriceEntriesFilter) Validate() error {
+	for _, nameFilter := range []struct {
+		name  string
+		value string
+	}{
+		{name: "productName", value: f.ProductName},
+		{name: "productNamePrefix", value: f.ProductNamePrefix},
+		{name: "productNameRegex", value: f.ProductNameRegex},
+	} {
+		if len(nameFilter.value) > priceEntriesFilterNameMaxLen {
+			return fmt.Errorf("%w: %s: should be LTE %d chars", common.ErrInvalidInput, nameFilter.name, priceEntriesFilterNameMaxLen)
+		}
+	}
+	// Go RE2 syntax is a subset of PCRE, so that is only a sanity check
+	if f.ProductNameRegex != "" {
+		if _, err := regexp.Compile(f.ProductNameRegex); err != nil {
+			return fmt.Errorf("%w: productNameRegex: %v", common.ErrInvalidInput, err)
+		}
+	}
+
+	if f.Currency != "" && !IsValidCurrency(f.Currency) {
+		return fmt.Errorf("%w: currency: invalid ISO-4217 code (%s)", common.ErrInvalidInput, f.Currency)
+	}
+	if (f.PriceMin != nil || f.PriceMax != nil) && f.Currency == "" {
+		return fmt.Errorf("%w: priceMin / priceMax: currency is required", common.ErrInvalidInput)
+	}
+	for _, priceFilter := range []struct {
+		name  string
+		value *Money
+	}{
+		{name: "priceMin", value: f.PriceMin},
+		{name: "priceMax", value: f.PriceMax},
+	} {
+		if priceFilter.value == nil {
+			continue
+		}
+		if priceFilter.value.Amount.IsNaN() || priceFilter.value.Amount.IsInf() != 0 {
+			return fmt.Errorf("%w: %s: not a number (%s)", common.ErrInvalidInput, priceFilter.name, priceFilter.value.Amount)
+		}
+	}
+	if f.PriceMin != nil && f.PriceMax != nil && f.PriceMin.Cmp(*f.PriceMax) > 0 {
+		return fmt.Errorf("%w: priceMin / priceMax: min should be LTE max (%s / %s)", common.ErrInvalidInput, f.PriceMin.Amount, f.PriceMax.Amount)
+	}
+
+	if !f.TimestampFrom.IsZero() && !f.TimestampTo.IsZero() && !f.TimestampTo.After(f.TimestampFrom) {
+		return fmt.Errorf("%w: timestampFrom / timestampTo: to should be GT from (%s / %s)", common.ErrInvalidInput, f.TimestampFrom, f.TimestampTo)
+	}
+
+	return nil
+}
+
 // PriceEntriesSortOption defines PriceEntry sorter interface.
 type PriceEntriesSortOption func(s common.SortOptions) common.SortOptions
 
@@ -34,7 +121,7 @@ func PriceEntrySortByProductName(order common.Order) PriceEntriesSortOption {
 func PriceEntrySortByPrice(order common.Order) PriceEntriesSortOption {
 	return func(s common.SortOptions) common.SortOptions {
 		s = append(s, common.SortOption{
-			FieldName: "price",
+			FieldName: "price.amount",
 			Order:     order,
 		})
 		return s
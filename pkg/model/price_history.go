@@ -0,0 +1,71 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+)
+
+const (
+	PriceHistoryDownsamplingFirst PriceHistoryDownsampling = "first"
+	PriceHistoryDownsamplingLast  PriceHistoryDownsampling = "last"
+	PriceHistoryDownsamplingMin   PriceHistoryDownsampling = "min"
+	PriceHistoryDownsamplingMax   PriceHistoryDownsampling = "max"
+	//
+	priceHistoryMinBucketSize = time.Second
+)
+
+// PriceHistoryDownsampling defines the function selecting a single price per history bucket.
+type PriceHistoryDownsampling string
+
+// IsValid checks PriceHistoryDownsampling is supported.
+func (d PriceHistoryDownsampling) IsValid() bool {
+	switch d {
+	case PriceHistoryDownsamplingFirst, PriceHistoryDownsamplingLast, PriceHistoryDownsamplingMin, PriceHistoryDownsamplingMax:
+		return true
+	default:
+		return false
+	}
+}
+
+// PriceHistoryPoint is a single product price history series point.
+type PriceHistoryPoint struct {
+	// Prices import DateTime (bucket start DateTime if downsampled)
+	Timestamp time.Time `json:"timestamp" bson:"timestamp"`
+	Price     Money     `json:"price" bson:"price"`
+}
+
+// PriceHistoryParams keeps product price history query params.
+type PriceHistoryParams struct {
+	// Prices import DateTime range [from, to) (optional)
+	TimestampFrom time.Time
+	TimestampTo   time.Time
+	// Downsampling bucket size (0 - no downsampling), buckets are aligned to UNIX epoch
+	BucketSize time.Duration
+	// Downsampling function (required if BucketSize is set)
+	Downsampling PriceHistoryDownsampling
+}
+
+// Validate validates PriceHistoryParams.
+func (p PriceHistoryParams) Validate() error {
+	if !p.TimestampFrom.IsZero() && !p.TimestampTo.IsZero() && !p.TimestampTo.After(p.TimestampFrom) {
+		return fmt.Errorf("%w: timestampFrom / timestampTo: to should be GT from (%s / %s)", common.ErrInvalidInput, p.TimestampFrom, p.TimestampTo)
+	}
+
+	if p.BucketSize == 0 {
+		if p.Downsampling != "" {
+			return fmt.Errorf("%w: downsampling: bucketSize is not set", common.ErrInvalidInput)
+		}
+		return nil
+	}
+
+	if p.BucketSize < priceHistoryMinBucketSize || p.BucketSize%time.Millisecond != 0 {
+		return fmt.Errorf("%w: bucketSize: should be GTE %v with milliseconds precision (%v)", common.ErrInvalidInput, priceHistoryMinBucketSize, p.BucketSize)
+	}
+	if !p.Downsampling.IsValid() {
+		return fmt.Errorf("%w: downsampling: unknown function (%s)", common.ErrInvalidInput, p.Downsampling)
+	}
+
+	return nil
+}
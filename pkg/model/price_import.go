@@ -19,5 +19,32 @@ type PricesImport struct {
 
 // Price is an embedded PricesImport struct.
 type Price struct {
-	Value int `json:"value" bson:"value"`
+	Value    primitive.Decimal128 `json:"value" bson:"value"`
+	Currency string               `json:"currency" bson:"currency"`
+	// Source CSV-file line number (0 if unknown), prices are kept sorted by it
+	Row int `json:"row,omitempty" bson:"row,omitempty"`
+}
+
+// NewPrice creates a new Price object.
+func NewPrice(m Money) Price {
+	return Price{
+		Value:    m.Amount,
+		Currency: m.Currency,
+	}
+}
+
+// NewCSVEntryPrice creates a new Price object from CSVEntry keeping its row number.
+func NewCSVEntryPrice(entry CSVEntry) Price {
+	price := NewPrice(entry.Price)
+	price.Row = entry.Row
+
+	return price
+}
+
+// Money converts Price to Money.
+func (p Price) Money() Money {
+	return Money{
+		Amount:   p.Value,
+		Currency: p.Currency,
+	}
 }
@@ -0,0 +1,17 @@
+package model
+
+// PriceStats keeps product prices statistics (prices of different currencies are aggregated separately).
+type PriceStats struct {
+	Name     string `json:"name" bson:"name"`
+	Currency string `json:"currency" bson:"currency"`
+	Min      Money  `json:"min" bson:"min"`
+	Max      Money  `json:"max" bson:"max"`
+	Mean     Money  `json:"mean" bson:"mean"`
+	Median   Money  `json:"median" bson:"median"`
+	// Population standard deviation
+	StdDev float64 `json:"std_dev" bson:"std_dev"`
+	// Number of price points
+	PricesCount int64 `json:"prices_count" bson:"prices_count"`
+	// Number of price imports containing the price points
+	ImportsCount int64 `json:"imports_count" bson:"imports_count"`
+}
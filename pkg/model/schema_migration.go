@@ -0,0 +1,43 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// SchemaMigration keeps applied DB schema migration record.
+type SchemaMigration struct {
+	// Migration version (unique)
+	Version int `json:"version" bson:"_id"`
+	// Migration description
+	Description string `json:"description" bson:"description"`
+	// Migration apply DateTime
+	AppliedAt time.Time `json:"applied_at" bson:"applied_at"`
+}
+
+// SchemaMigrationStatus keeps known DB schema migration state.
+type SchemaMigrationStatus struct {
+	// Migration version
+	Version int
+	// Migration description
+	Description string
+	// Migration is applied
+	Applied bool
+	// Migration apply DateTime (empty if not applied)
+	AppliedAt time.Time
+	// Migration is applied, but unknown to the current application version
+	Unknown bool
+}
+
+// String implements fmt.Stringer interface.
+func (s SchemaMigrationStatus) String() string {
+	state := "pending"
+	if s.Applied {
+		state = "applied at " + s.AppliedAt.Format(time.RFC3339)
+	}
+	if s.Unknown {
+		state += " (unknown)"
+	}
+
+	return fmt.Sprintf("%04d %s: %s", s.Version, s.Description, state)
+}
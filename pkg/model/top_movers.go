@@ -0,0 +1,130 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+)
+
+const (
+	TopMoversDirectionGainers TopMoversDirection = "gainers"
+	TopMoversDirectionLosers  TopMoversDirection = "losers"
+	//
+	topMoversMaxLimit = 1000
+)
+
+// TopMoversDirection defines price change direction to rank products by.
+type TopMoversDirection string
+
+// IsValid checks TopMoversDirection is supported.
+func (d TopMoversDirection) IsValid() bool {
+	switch d {
+	case TopMoversDirectionGainers, TopMoversDirectionLosers:
+		return true
+	default:
+		return false
+	}
+}
+
+// TopMoversParams keeps top movers query params.
+type TopMoversParams struct {
+	// Compared DateTimes: product prices as of those (the last two imports are compared if both are zero:
+	// only products present in both imports)
+	TimestampFrom time.Time
+	TimestampTo   time.Time
+	// Price change direction
+	Direction TopMoversDirection
+	// Rank by the delta percentage instead of the absolute delta (prices changed from zero are skipped)
+	Relative bool
+	// Currency filter (required for the absolute delta ranking, as deltas of different currencies aren't comparable)
+	Currency string
+	// Max number of products
+	Limit int
+}
+
+// Validate validates TopMoversParams.
+func (p TopMoversParams) Validate() error {
+	if p.TimestampFrom.IsZero() != p.TimestampTo.IsZero() {
+		return fmt.Errorf("%w: timestampFrom / timestampTo: both or none should be set", common.ErrInvalidInput)
+	}
+	if !p.TimestampFrom.IsZero() && !p.TimestampTo.After(p.TimestampFrom) {
+		return fmt.Errorf("%w: timestampFrom / timestampTo: to should be GT from (%s / %s)", common.ErrInvalidInput, p.TimestampFrom, p.TimestampTo)
+	}
+	if !p.Direction.IsValid() {
+		return fmt.Errorf("%w: direction: unknown (%s)", common.ErrInvalidInput, p.Direction)
+	}
+	if p.Currency == "" && !p.Relative {
+		return fmt.Errorf("%w: currency: required for the absolute delta ranking", common.ErrInvalidInput)
+	}
+	if p.Currency != "" && !IsValidCurrency(p.Currency) {
+		return fmt.Errorf("%w: currency: invalid ISO-4217 code (%s)", common.ErrInvalidInput, p.Currency)
+	}
+	if p.Limit <= 0 || p.Limit > topMoversMaxLimit {
+		return fmt.Errorf("%w: limit: should be in (0, %d] range", common.ErrInvalidInput, topMoversMaxLimit)
+	}
+
+	return nil
+}
+
+// TopMoversPricesFilter defines compared price imports.
+// Either both "as of" DateTimes or both exact import timestamps groups should be set.
+type TopMoversPricesFilter struct {
+	// Compared DateTimes: the latest price import as of those is used per product
+	AsOfFrom time.Time
+	AsOfTo   time.Time
+	// Compared exact import timestamps groups: all the imports of a single import job (zip archive entries)
+	ImportFrom []time.Time
+	ImportTo   []time.Time
+}
+
+// IsExact checks if exact import timestamps groups are compared.
+func (f TopMoversPricesFilter) IsExact() bool {
+	return len(f.ImportFrom) > 0 || len(f.ImportTo) > 0
+}
+
+// Validate validates TopMoversPricesFilter.
+func (f TopMoversPricesFilter) Validate() error {
+	if f.IsExact() {
+		if !f.AsOfFrom.IsZero() || !f.AsOfTo.IsZero() {
+			return fmt.Errorf("%w: asOfFrom / asOfTo: can not be set with importFrom / importTo", common.ErrInvalidInput)
+		}
+		if len(f.ImportFrom) == 0 || len(f.ImportTo) == 0 {
+			return fmt.Errorf("%w: importFrom / importTo: both should be set", common.ErrInvalidInput)
+		}
+		for _, fromTimestamp := range f.ImportFrom {
+			for _, toTimestamp := range f.ImportTo {
+				if fromTimestamp.Equal(toTimestamp) {
+					return fmt.Errorf("%w: importFrom / importTo: groups intersect (%s)", common.ErrInvalidInput, fromTimestamp)
+				}
+			}
+		}
+
+		return nil
+	}
+
+	if f.AsOfFrom.IsZero() || f.AsOfTo.IsZero() {
+		return fmt.Errorf("%w: asOfFrom / asOfTo: can not be empty", common.ErrInvalidInput)
+	}
+
+	return nil
+}
+
+// TopMoversPrices is an output for Product / compared PricesImports aggregate.
+type TopMoversPrices struct {
+	Name string `json:"name" bson:"name"`
+	// Compared price imports prices (in the import timestamps and the CSV-file rows order)
+	FromPrices []Money `json:"from_prices" bson:"from_prices"`
+	ToPrices   []Money `json:"to_prices" bson:"to_prices"`
+}
+
+// TopMovers keeps products with the largest price changes between two points in time.
+// Product price is the last one of the currency within the latest price import as of the DateTime,
+// prices of a currency present at a single point only are not compared.
+type TopMovers struct {
+	// Compared DateTimes (import job timestamps for the last two imports: the first archive entry one for zip archives)
+	FromTimestamp time.Time
+	ToTimestamp   time.Time
+	// Price changes ordered by the ranking delta (the largest change first)
+	Movers []PriceChange
+}
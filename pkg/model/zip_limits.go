@@ -0,0 +1,35 @@
+package model
+
+import (
+	"fmt"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+)
+
+// ZipLimits defines zip archive extraction limits (protects from zip bombs).
+type ZipLimits struct {
+	// Max number of archive entries (directories and non-CSV files included)
+	MaxEntries int
+	// Max total uncompressed size of archive CSV-files [bytes]
+	MaxUncompressedSize int64
+}
+
+// Validate validates ZipLimits.
+func (l ZipLimits) Validate() error {
+	if l.MaxEntries <= 0 {
+		return fmt.Errorf("%w: maxEntries: should be GT 0", common.ErrInvalidInput)
+	}
+	if l.MaxUncompressedSize <= 0 {
+		return fmt.Errorf("%w: maxUncompressedSize: should be GT 0", common.ErrInvalidInput)
+	}
+
+	return nil
+}
+
+// NewDefaultZipLimits returns the default zip archive extraction limits.
+func NewDefaultZipLimits() ZipLimits {
+	return ZipLimits{
+		MaxEntries:          1000,
+		MaxUncompressedSize: 1 << 30,
+	}
+}
@@ -18,6 +18,8 @@ const (
 type Configuration struct {
 	Url  string
 	Port string
+	// Connect to the host only (replica set members discovery is disabled)
+	Direct bool
 }
 
 // Connect creates a new MongoDB client.
@@ -25,7 +27,7 @@ func Connect(config Configuration) (*mongo.Client, error) {
 	uri := fmt.Sprintf("mongodb://%s:%s/", config.Url, config.Port)
 
 	client, err := mongo.NewClient(
-		options.Client().ApplyURI(uri),
+		options.Client().ApplyURI(uri).SetDirect(config.Direct),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("creating client: %w", err)
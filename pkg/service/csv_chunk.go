@@ -16,6 +16,9 @@ const (
 // csvChunkWorker is a CSV-file chunk writer/executor interface.
 type csvChunkWorker func(ctx context.Context, csvImport model.CSVImport) error
 
+// csvChunkReporter is a CSV-file chunk processing result receiver interface.
+type csvChunkReporter func(result model.ImportChunkResult)
+
 // csvChunk keeps CSV file chunk data.
 type csvChunk struct {
 	id             int
@@ -26,11 +29,8 @@ type csvChunk struct {
 }
 
 // addEntry appends a new CSV entry to chunk.
-func (c *csvChunk) addEntry(productName string, price int) {
-	c.entries = append(c.entries, model.CSVEntry{
-		ProductName: productName,
-		Price:       price,
-	})
+func (c *csvChunk) addEntry(entry model.CSVEntry) {
+	c.entries = append(c.entries, entry)
 }
 
 // addParsingError adds a new parsing error to chunk.
@@ -90,6 +90,31 @@ func (c *csvChunk) getErrorString() string {
 	return str.String()
 }
 
+// getResult builds a chunk processing result.
+func (c *csvChunk) getResult() model.ImportChunkResult {
+	result := model.ImportChunkResult{
+		ChunkID: c.id,
+		Entries: len(c.entries),
+	}
+	if !c.isFailed() {
+		return result
+	}
+
+	chunkErr := &model.ImportChunkError{
+		ChunkID:       c.id,
+		ParsingErrors: make([]string, 0, len(c.parsingErrors)),
+	}
+	for _, err := range c.parsingErrors {
+		chunkErr.ParsingErrors = append(chunkErr.ParsingErrors, err.Error())
+	}
+	if c.executionError != nil {
+		chunkErr.ExecutionError = c.executionError.Error()
+	}
+	result.Error = chunkErr
+
+	return result
+}
+
 // getStateString returns current chunk state.
 func (c *csvChunk) getStateString() string {
 	return fmt.Sprintf("chunkID %d: %d", c.id, len(c.entries))
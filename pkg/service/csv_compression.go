@@ -0,0 +1,245 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/itiky/mdb-tutorial/pkg/model"
+)
+
+const (
+	// Number of file leading bytes required to detect compression format
+	csvCompressionMagicSize = 4
+)
+
+var (
+	gzipMagic     = []byte{0x1f, 0x8b}
+	zstdMagic     = []byte{0x28, 0xb5, 0x2f, 0xfd}
+	zipMagic      = []byte{'P', 'K', 0x03, 0x04}
+	zipEmptyMagic = []byte{'P', 'K', 0x05, 0x06}
+)
+
+// csvEntryHandler is a decompressed CSV-data receiver interface.
+// entryName is empty for non-archived files.
+type csvEntryHandler func(entryName string, reader io.Reader) error
+
+// csvCompressionHints keeps downloaded file metadata used to guess its compression format.
+type csvCompressionHints struct {
+	contentEncoding string
+	contentType     string
+	fileURL         string
+}
+
+// guess returns compression format declared by file metadata (Content-Encoding, Content-Type, extension).
+func (h csvCompressionHints) guess() model.CSVCompression {
+	for _, encoding := range strings.Split(h.contentEncoding, ",") {
+		switch strings.ToLower(strings.TrimSpace(encoding)) {
+		case "gzip", "x-gzip":
+			return model.CSVCompressionGzip
+		case "zstd":
+			return model.CSVCompressionZstd
+		}
+	}
+
+	if mediaType, _, err := mime.ParseMediaType(h.contentType); err == nil {
+		switch mediaType {
+		case "application/gzip", "application/x-gzip":
+			return model.CSVCompressionGzip
+		case "application/zstd":
+			return model.CSVCompressionZstd
+		case "application/zip", "application/x-zip-compressed":
+			return model.CSVCompressionZip
+		}
+	}
+
+	filePath := h.fileURL
+	if u, err := url.Parse(h.fileURL); err == nil {
+		filePath = u.Path
+	}
+	switch strings.ToLower(path.Ext(filePath)) {
+	case ".gz", ".gzip":
+		return model.CSVCompressionGzip
+	case ".zst", ".zstd":
+		return model.CSVCompressionZstd
+	case ".zip":
+		return model.CSVCompressionZip
+	}
+
+	return model.CSVCompressionNone
+}
+
+// detectCSVCompression detects file compression format by its leading (magic) bytes.
+func detectCSVCompression(magic []byte) model.CSVCompression {
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		return model.CSVCompressionGzip
+	case bytes.HasPrefix(magic, zstdMagic):
+		return model.CSVCompressionZstd
+	case bytes.HasPrefix(magic, zipMagic), bytes.HasPrefix(magic, zipEmptyMagic):
+		return model.CSVCompressionZip
+	default:
+		return model.CSVCompressionNone
+	}
+}
+
+// extractCSVFile opens file and passes decompressed CSV-data to the handler.
+// Every CSV-file of a zip archive is passed separately (directories and non-CSV entries are skipped) within the zipLimits,
+// gzip / zstd decompressed size is limited by the maxSize.
+func extractCSVFile(filePath string, compression model.CSVCompression, zipLimits model.ZipLimits, maxSize int64, handler csvEntryHandler) error {
+	if compression == model.CSVCompressionZip {
+		return extractCSVZipArchive(filePath, zipLimits, handler)
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return fmt.Errorf("file open failed: %w", err)
+	}
+	defer file.Close()
+
+	reader, err := newCSVDecompressor(file, compression, maxSize)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	return handler("", reader)
+}
+
+// extractCSVZipArchive passes every zip archive CSV-file to the handler.
+// Archive is rejected before extraction if the limits are exceeded by the number of entries or the declared CSV-files size,
+// declared sizes could be forged, so the read size is limited as well.
+func extractCSVZipArchive(filePath string, limits model.ZipLimits, handler csvEntryHandler) error {
+	archive, err := zip.OpenReader(filePath)
+	if err != nil {
+		return fmt.Errorf("zip archive open failed: %w", err)
+	}
+	defer archive.Close()
+
+	if len(archive.File) > limits.MaxEntries {
+		return fmt.Errorf("zip archive: %d entries exceed the limit of %d", len(archive.File), limits.MaxEntries)
+	}
+
+	declaredSize := uint64(0)
+	for _, entry := range archive.File {
+		if isCSVArchiveEntry(entry) {
+			declaredSize += entry.UncompressedSize64
+		}
+	}
+	if declaredSize > uint64(limits.MaxUncompressedSize) {
+		return fmt.Errorf("zip archive: uncompressed size %d exceeds the limit of %d bytes", declaredSize, limits.MaxUncompressedSize)
+	}
+
+	entriesFound, sizeLeft := 0, limits.MaxUncompressedSize
+	for _, entry := range archive.File {
+		if !isCSVArchiveEntry(entry) {
+			continue
+		}
+		entriesFound++
+
+		entryHandler := func(entryName string, reader io.Reader) error {
+			return handler(entryName, &sizeLimitReader{reader: reader, sizeLeft: &sizeLeft, limit: limits.MaxUncompressedSize, format: "zip archive"})
+		}
+		if err := extractCSVZipEntry(entry, entryHandler); err != nil {
+			return fmt.Errorf("zip entry %s: %w", entry.Name, err)
+		}
+	}
+
+	if entriesFound == 0 {
+		return fmt.Errorf("zip archive: no CSV-files found")
+	}
+
+	return nil
+}
+
+// extractCSVZipEntry passes a single zip archive entry to the handler.
+func extractCSVZipEntry(entry *zip.File, handler csvEntryHandler) error {
+	reader, err := entry.Open()
+	if err != nil {
+		return fmt.Errorf("open failed: %w", err)
+	}
+	defer reader.Close()
+
+	return handler(entry.Name, reader)
+}
+
+// sizeLimitReader fails the read once the total uncompressed size limit is exceeded
+// (sizeLeft is shared by all the zip archive entries).
+type sizeLimitReader struct {
+	reader   io.Reader
+	sizeLeft *int64
+	limit    int64
+	format   string
+}
+
+// Read implements io.Reader interface.
+func (r *sizeLimitReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	*r.sizeLeft -= int64(n)
+	if *r.sizeLeft < 0 {
+		return n, fmt.Errorf("%s: uncompressed size exceeds the limit of %d bytes", r.format, r.limit)
+	}
+
+	return n, err
+}
+
+// isCSVArchiveEntry checks if zip archive entry is a CSV-file.
+func isCSVArchiveEntry(entry *zip.File) bool {
+	if entry.FileInfo().IsDir() {
+		return false
+	}
+	// skip macOS archiver metadata
+	if strings.HasPrefix(entry.Name, "__MACOSX/") || strings.HasPrefix(path.Base(entry.Name), "._") {
+		return false
+	}
+
+	return strings.EqualFold(path.Ext(entry.Name), ".csv")
+}
+
+// limitedReadCloser is a size limited decompressor.
+type limitedReadCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// newLimitedReadCloser wraps decompressor with the maxSize decompressed size limit (protects from gzip / zstd bombs).
+func newLimitedReadCloser(decompressor io.ReadCloser, maxSize int64, format string) io.ReadCloser {
+	sizeLeft := maxSize
+
+	return limitedReadCloser{
+		Reader: &sizeLimitReader{reader: decompressor, sizeLeft: &sizeLeft, limit: maxSize, format: format},
+		Closer: decompressor,
+	}
+}
+
+// newCSVDecompressor wraps reader with a stream decompressor, decompressed data size is limited by the maxSize.
+func newCSVDecompressor(reader io.Reader, compression model.CSVCompression, maxSize int64) (io.ReadCloser, error) {
+	switch compression {
+	case model.CSVCompressionNone:
+		return ioutil.NopCloser(reader), nil
+	case model.CSVCompressionGzip:
+		gzipReader, err := gzip.NewReader(reader)
+		if err != nil {
+			return nil, fmt.Errorf("gzip reader: %w", err)
+		}
+		return newLimitedReadCloser(gzipReader, maxSize, "gzip"), nil
+	case model.CSVCompressionZstd:
+		zstdReader, err := zstd.NewReader(reader)
+		if err != nil {
+			return nil, fmt.Errorf("zstd reader: %w", err)
+		}
+		return newLimitedReadCloser(zstdReader.IOReadCloser(), maxSize, "zstd"), nil
+	default:
+		return nil, fmt.Errorf("unsupported stream compression: %s", compression)
+	}
+}
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+	"github.com/itiky/mdb-tutorial/pkg/model"
+)
+
+// csvRowParser extracts CSV entry fields from a row according to model.CSVDialect.
+type csvRowParser struct {
+	productIdx int
+	priceIdx   int
+	// currency column index (-1 if not set)
+	currencyIdx int
+	// price format
+	decimalSeparator rune
+	defaultCurrency  string
+	// expected row length (0 - any length covering mapped columns)
+	rowLength int
+	// restores original quote chars swapped by newCSVReader (nil if not needed)
+	unswapQuotes func(string) string
+}
+
+// parse builds a CSV entry for a row.
+func (p csvRowParser) parse(row []string) (model.CSVEntry, error) {
+	if p.rowLength > 0 && len(row) != p.rowLength {
+		return model.CSVEntry{}, fmt.Errorf("invalid row length (%d)", len(row))
+	}
+	if p.productIdx >= len(row) || p.priceIdx >= len(row) || p.currencyIdx >= len(row) {
+		return model.CSVEntry{}, fmt.Errorf("invalid row length (%d)", len(row))
+	}
+
+	field := func(idx int) string {
+		if p.unswapQuotes != nil {
+			return p.unswapQuotes(row[idx])
+		}
+		return row[idx]
+	}
+
+	currency := p.defaultCurrency
+	if p.currencyIdx >= 0 {
+		if v := strings.TrimSpace(field(p.currencyIdx)); v != "" {
+			currency = v
+		}
+	}
+
+	price, err := model.ParseMoney(field(p.priceIdx), p.decimalSeparator, currency)
+	if err != nil {
+		return model.CSVEntry{}, fmt.Errorf("price convertion failed (%s %s): %v", field(p.priceIdx), currency, err)
+	}
+
+	return model.CSVEntry{
+		ProductName: field(p.productIdx),
+		Price:       price,
+	}, nil
+}
+
+// newCSVRowParser resolves dialect columns using an optional header row.
+// Every column should be either mapped or ignored, so unexpected file format changes are detected.
+func newCSVRowParser(dialect model.CSVDialect, header []string) (*csvRowParser, error) {
+	p := &csvRowParser{
+		currencyIdx:      -1,
+		decimalSeparator: dialect.DecimalSeparator,
+		defaultCurrency:  dialect.Currency,
+	}
+	if dialect.Quote != '"' {
+		p.unswapQuotes = newQuoteSwapper(byte(dialect.Quote))
+	}
+
+	// resolve column index by header name or by 0-based index
+	headerIdxs := make(map[string]int, len(header))
+	for i, name := range header {
+		if p.unswapQuotes != nil {
+			name = p.unswapQuotes(name)
+		}
+		headerIdxs[normalizeCSVColumnName(name)] = i
+	}
+	resolveColumn := func(column string) (int, bool) {
+		if idx, ok := headerIdxs[normalizeCSVColumnName(column)]; ok {
+			return idx, true
+		}
+		if idx, ok := model.ParseCSVColumnIndex(column); ok && (header == nil || idx < len(header)) {
+			return idx, true
+		}
+
+		return 0, false
+	}
+
+	var ok bool
+	if p.productIdx, ok = resolveColumn(dialect.ProductColumn); !ok {
+		return nil, fmt.Errorf("%w: product column %q: not found", common.ErrInvalidInput, dialect.ProductColumn)
+	}
+	if p.priceIdx, ok = resolveColumn(dialect.PriceColumn); !ok {
+		return nil, fmt.Errorf("%w: price column %q: not found", common.ErrInvalidInput, dialect.PriceColumn)
+	}
+	if p.productIdx == p.priceIdx {
+		return nil, fmt.Errorf("%w: product / price columns: point to the same column (%d)", common.ErrInvalidInput, p.productIdx)
+	}
+	if dialect.CurrencyColumn != "" {
+		if p.currencyIdx, ok = resolveColumn(dialect.CurrencyColumn); !ok {
+			return nil, fmt.Errorf("%w: currency column %q: not found", common.ErrInvalidInput, dialect.CurrencyColumn)
+		}
+		if p.currencyIdx == p.productIdx || p.currencyIdx == p.priceIdx {
+			return nil, fmt.Errorf("%w: currency column: points to the product / price column (%d)", common.ErrInvalidInput, p.currencyIdx)
+		}
+	}
+
+	// any row length is fine, if the unmapped columns are ignored
+	if dialect.IgnoresAll() {
+		return p, nil
+	}
+
+	knownIdxs := map[int]bool{p.productIdx: true, p.priceIdx: true}
+	if p.currencyIdx >= 0 {
+		knownIdxs[p.currencyIdx] = true
+	}
+	for _, column := range dialect.IgnoreColumns {
+		idx, ok := resolveColumn(column)
+		if !ok {
+			return nil, fmt.Errorf("%w: ignored column %q: not found", common.ErrInvalidInput, column)
+		}
+		knownIdxs[idx] = true
+	}
+
+	p.rowLength = len(header)
+	if header == nil {
+		p.rowLength = len(knownIdxs)
+	}
+	for idx := 0; idx < p.rowLength; idx++ {
+		if !knownIdxs[idx] {
+			return nil, fmt.Errorf("%w: column [%d]: neither mapped nor ignored", common.ErrInvalidInput, idx)
+		}
+	}
+
+	return p, nil
+}
+
+// newCSVReader creates a CSV-reader configured with dialect.
+// As csv.Reader supports only double quotes, a custom quote char is swapped with the double quote one
+// within the input stream (csvRowParser swaps them back for parsed fields).
+func newCSVReader(reader io.Reader, dialect model.CSVDialect) *csv.Reader {
+	if dialect.Quote != '"' {
+		reader = &quoteSwapReader{
+			reader: reader,
+			swap:   newQuoteSwapper(byte(dialect.Quote)),
+		}
+	}
+
+	csvReader := csv.NewReader(reader)
+	csvReader.Comma = dialect.Delimiter
+	csvReader.Comment = dialect.Comment
+	// row length is checked by csvRowParser
+	csvReader.FieldsPerRecord = -1
+
+	return csvReader
+}
+
+// quoteSwapReader is an io.Reader which swaps a custom quote char with the double quote one.
+type quoteSwapReader struct {
+	reader io.Reader
+	swap   func(string) string
+}
+
+// Read implements io.Reader interface.
+func (r *quoteSwapReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	copy(p[:n], r.swap(string(p[:n])))
+
+	return n, err
+}
+
+// newQuoteSwapper returns a func swapping quote char with the double quote one (ASCII only, so length is kept).
+func newQuoteSwapper(quote byte) func(string) string {
+	replacer := strings.NewReplacer(string(quote), `"`, `"`, string(quote))
+
+	return replacer.Replace
+}
+
+// normalizeCSVColumnName converts column name to a comparable form.
+func normalizeCSVColumnName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
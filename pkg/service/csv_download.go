@@ -0,0 +1,313 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+	"github.com/itiky/mdb-tutorial/pkg/model"
+)
+
+var _ SourceReader = (*csvDownload)(nil)
+
+// csvDownloadError is a failed download error with attempts history.
+type csvDownloadError struct {
+	err      error
+	attempts []model.DownloadAttempt
+}
+
+// Error implements error interface.
+func (e csvDownloadError) Error() string {
+	history := make([]string, 0, len(e.attempts))
+	for _, attempt := range e.attempts {
+		history = append(history, attempt.String())
+	}
+
+	return fmt.Sprintf("%v (attempts: [%s])", e.err, strings.Join(history, "; "))
+}
+
+// Unwrap returns the last attempt error.
+func (e csvDownloadError) Unwrap() error {
+	return e.err
+}
+
+// csvDownload is a resumable HTTP GET response body reader.
+// Failed requests and interrupted body reads are retried with exponential backoff,
+// interrupted downloads are resumed with Range requests if server supports them (and provides a validator for If-Range),
+// otherwise download is started over (if restart func is set).
+type csvDownload struct {
+	ctx    context.Context
+	url    string
+	policy model.DownloadRetryPolicy
+	logger *logrus.Logger
+	// called if download should start over (nil - download can't be restarted)
+	restart func() error
+	// the latest full content (200) response headers
+	header http.Header
+	// full content state
+	size            int64
+	validator       string
+	rangesSupported bool
+	// current state
+	offset        int64
+	body          io.ReadCloser
+	cancelAttempt context.CancelFunc
+	attemptStart  time.Time
+	attempts      []model.DownloadAttempt
+	done          bool
+}
+
+// Read implements io.Reader interface.
+func (d *csvDownload) Read(p []byte) (int, error) {
+	for {
+		if d.done {
+			return 0, io.EOF
+		}
+		if d.body == nil {
+			if err := d.open(); err != nil {
+				return 0, err
+			}
+		}
+
+		n, err := d.body.Read(p)
+		d.offset += int64(n)
+		d.attempts[len(d.attempts)-1].BytesRead += int64(n)
+		if err == nil {
+			return n, nil
+		}
+
+		if err == io.EOF {
+			if d.size < 0 || d.offset >= d.size {
+				d.finishAttempt(nil)
+				d.done = true
+				return n, io.EOF
+			}
+			err = io.ErrUnexpectedEOF
+		}
+
+		// body read is interrupted: received data is returned, the next Read resumes download
+		d.finishAttempt(fmt.Errorf("reading body: %w", err))
+		if n > 0 {
+			return n, nil
+		}
+	}
+}
+
+// Open performs the first request (if not yet) to make response headers available.
+func (d *csvDownload) Open() error {
+	if d.body != nil || d.done {
+		return nil
+	}
+
+	return d.open()
+}
+
+// Meta implements SourceReader interface (the latest full content response headers are used).
+func (d *csvDownload) Meta() SourceMeta {
+	return SourceMeta{
+		ETag:            d.header.Get("ETag"),
+		ContentType:     d.header.Get("Content-Type"),
+		ContentEncoding: d.header.Get("Content-Encoding"),
+	}
+}
+
+// Close implements io.Closer interface.
+func (d *csvDownload) Close() error {
+	d.closeBody()
+	return nil
+}
+
+// open performs download requests until success or attempts limit is reached.
+func (d *csvDownload) open() error {
+	var lastErr error
+	for {
+		attemptNumber := len(d.attempts) + 1
+		if attemptNumber > d.policy.MaxAttempts {
+			return d.newError(fmt.Errorf("attempts limit reached: %w", lastErr))
+		}
+
+		if backoff := d.policy.Backoff(attemptNumber); backoff > 0 {
+			select {
+			case <-d.ctx.Done():
+				return d.newError(d.ctx.Err())
+			case <-time.After(backoff):
+			}
+		}
+
+		retryable, err := d.request(attemptNumber)
+		if err == nil {
+			return nil
+		}
+		d.finishAttempt(err)
+		lastErr = err
+
+		if !retryable || d.ctx.Err() != nil {
+			return d.newError(err)
+		}
+	}
+}
+
+// request performs a single download request.
+// Returns false if the error can't be fixed with a retry.
+func (d *csvDownload) request(attemptNumber int) (bool, error) {
+	// resume or start over
+	resume := false
+	if d.offset > 0 {
+		if d.rangesSupported && d.validator != "" {
+			resume = true
+		} else if err := d.startOver(); err != nil {
+			return false, err
+		}
+	}
+
+	d.attempts = append(d.attempts, model.DownloadAttempt{Number: attemptNumber, Offset: d.offset})
+	d.attemptStart = time.Now()
+
+	// build request
+	attemptCtx, attemptCancel := d.ctx, context.CancelFunc(func() {})
+	if d.policy.AttemptTimeout > 0 {
+		attemptCtx, attemptCancel = context.WithTimeout(d.ctx, d.policy.AttemptTimeout)
+	}
+
+	req, err := http.NewRequestWithContext(attemptCtx, http.MethodGet, d.url, nil)
+	if err != nil {
+		attemptCancel()
+		return false, fmt.Errorf("%w: request build failed: %v", common.ErrInvalidInput, err)
+	}
+	// Go HTTP client decompresses gzip encoded responses itself, that breaks Range offsets
+	req.Header.Set("Accept-Encoding", "identity")
+	if resume {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", d.offset))
+		req.Header.Set("If-Range", d.validator)
+	}
+
+	// request
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		attemptCancel()
+		return true, fmt.Errorf("GET failed: %v", err)
+	}
+	d.attempts[len(d.attempts)-1].StatusCode = resp.StatusCode
+
+	switch {
+	case resp.StatusCode == http.StatusPartialContent && resume:
+		if start, ok := parseContentRangeStart(resp.Header.Get("Content-Range")); !ok || start != d.offset {
+			resp.Body.Close()
+			attemptCancel()
+			d.rangesSupported = false
+			return true, fmt.Errorf("unexpected Content-Range: %s", resp.Header.Get("Content-Range"))
+		}
+	case resp.StatusCode == http.StatusOK:
+		// content has changed or Range is ignored
+		if resume {
+			if err := d.startOver(); err != nil {
+				resp.Body.Close()
+				attemptCancel()
+				return false, err
+			}
+		}
+		d.header = resp.Header
+		d.size = resp.ContentLength
+		d.rangesSupported = resp.Header.Get("Accept-Ranges") == "bytes"
+		d.validator = resp.Header.Get("ETag")
+		if d.validator == "" || strings.HasPrefix(d.validator, "W/") {
+			d.validator = resp.Header.Get("Last-Modified")
+		}
+	case resp.StatusCode >= http.StatusInternalServerError, resp.StatusCode == http.StatusRequestTimeout, resp.StatusCode == http.StatusTooManyRequests:
+		resp.Body.Close()
+		attemptCancel()
+		return true, fmt.Errorf("GET failed: %s", resp.Status)
+	default:
+		resp.Body.Close()
+		attemptCancel()
+		return false, fmt.Errorf("%w: GET failed: %s", common.ErrNotFound, resp.Status)
+	}
+
+	d.body, d.cancelAttempt = resp.Body, attemptCancel
+
+	return true, nil
+}
+
+// startOver resets the download state to start it from scratch.
+func (d *csvDownload) startOver() error {
+	if d.restart == nil {
+		return fmt.Errorf("download can't be resumed (Range requests are not supported or content has changed) and restarted")
+	}
+	if err := d.restart(); err != nil {
+		return fmt.Errorf("restarting download: %w", err)
+	}
+	d.logger.Warnf("CSV download: %s: can't be resumed, starting over", d.url)
+	d.offset = 0
+
+	return nil
+}
+
+// finishAttempt closes the current attempt body and saves the attempt result.
+func (d *csvDownload) finishAttempt(err error) {
+	d.closeBody()
+	if len(d.attempts) == 0 {
+		return
+	}
+
+	attempt := &d.attempts[len(d.attempts)-1]
+	attempt.Duration = time.Since(d.attemptStart)
+	if err != nil {
+		attempt.Error = err.Error()
+		d.logger.Warnf("CSV download: %s: attempt %s", d.url, attempt.String())
+	}
+}
+
+// closeBody closes the current attempt body (if any).
+func (d *csvDownload) closeBody() {
+	if d.body != nil {
+		d.body.Close()
+		d.body = nil
+	}
+	if d.cancelAttempt != nil {
+		d.cancelAttempt()
+		d.cancelAttempt = nil
+	}
+}
+
+// newError builds an error with attempts history.
+func (d *csvDownload) newError(err error) error {
+	return csvDownloadError{
+		err:      err,
+		attempts: append([]model.DownloadAttempt(nil), d.attempts...),
+	}
+}
+
+// newCSVDownload creates a new csvDownload object (request is performed on the first Read).
+func newCSVDownload(ctx context.Context, url string, policy model.DownloadRetryPolicy, logger *logrus.Logger) *csvDownload {
+	return &csvDownload{
+		ctx:    ctx,
+		url:    url,
+		policy: policy,
+		logger: logger,
+		header: http.Header{},
+		size:   -1,
+	}
+}
+
+// parseContentRangeStart parses "bytes {start}-{end}/{size}" Content-Range header start value.
+func parseContentRangeStart(value string) (int64, bool) {
+	value = strings.TrimPrefix(value, "bytes ")
+	idx := strings.Index(value, "-")
+	if idx <= 0 {
+		return 0, false
+	}
+
+	start, err := strconv.ParseInt(value[:idx], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return start, true
+}
@@ -2,11 +2,12 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strings"
-	"sync"
+	"time"
 
 	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	"github.com/itiky/mdb-tutorial/pkg/common"
 	"github.com/itiky/mdb-tutorial/pkg/model"
@@ -19,6 +20,7 @@ var _ CSVImporterService = (*csvImporterService)(nil)
 type csvImporterService struct {
 	storage storage.Storage
 	logger  *logrus.Logger
+	alerts  priceAlertsService
 }
 
 // ImportPrices implements CSVImporterService interface.
@@ -31,82 +33,165 @@ func (s csvImporterService) ImportPrices(ctx context.Context, csvImport model.CS
 		return fmt.Errorf("%w: csvImport.Entries: empty", common.ErrInvalidInput)
 	}
 
-	// prepare map-reduce data (products with prices set)
+	// prepare map-reduce data (products with prices set), product names order is kept
+	productNames := make([]string, 0)
 	productsMap := make(map[string][]model.Price)
 	for i, entry := range csvImport.Entries {
 		// sanity check
 		if entry.ProductName == "" {
 			return fmt.Errorf("%w: csvImport.Entries[%d].ProductName (%s)", common.ErrInvalidInput, i, entry.ProductName)
 		}
-		if entry.Price < 0 {
-			return fmt.Errorf("%w: csvImport.Entries[%d].Price (%d)", common.ErrInvalidInput, i, entry.Price)
+		if err := entry.Price.Validate(); err != nil {
+			return fmt.Errorf("csvImport.Entries[%d].Price: %w", i, err)
+		}
+		if entry.Price.IsNegative() {
+			return fmt.Errorf("%w: csvImport.Entries[%d].Price (%s)", common.ErrInvalidInput, i, entry.Price)
 		}
 
 		// update set
-		prices := productsMap[entry.ProductName]
-		prices = append(prices, model.Price{
-			Value: entry.Price,
+		prices, found := productsMap[entry.ProductName]
+		if !found {
+			productNames = append(productNames, entry.ProductName)
+		}
+		productsMap[entry.ProductName] = append(prices, model.NewCSVEntryPrice(entry))
+	}
+
+	// reduce: upsert products
+	productIDs, err := s.storage.Product().BulkUpsertByNames(ctx, productNames)
+	if err != nil {
+		return fmt.Errorf("products bulk upsert failed (%d): %w", len(productNames), err)
+	}
+
+	// reduce: upsert PricesImport objects
+	pricesImports := make([]model.PricesImport, 0, len(productNames))
+	for _, name := range productNames {
+		pricesImports = append(pricesImports, model.PricesImport{
+			ProductID: productIDs[name],
+			Timestamp: csvImport.Timestamp,
+			Prices:    productsMap[name],
 		})
-		productsMap[entry.ProductName] = prices
-	}
-
-	// reduce
-	wg := sync.WaitGroup{}
-	errsCh := make(chan error, len(productsMap))
-	for productName, productPrices := range productsMap {
-		wg.Add(1)
-		go func(name string, prices []model.Price) {
-			defer wg.Done()
-
-			// upsert product
-			productID, err := s.storage.Product().UpsertByName(ctx, model.Product{Name: name})
-			if err != nil {
-				errsCh <- fmt.Errorf("product %s: product object upsert failed: %w", name, err)
-				return
-			}
-
-			// if product already exists (not inserted), fetch its ID
-			if productID.IsZero() {
-				product, err := s.storage.Product().GetByName(ctx, name)
-				if err != nil {
-					errsCh <- fmt.Errorf("product %s: fetch object failed: %w", name, err)
-					return
-				}
-				productID = product.ID
-			}
-
-			// prepare PricesImport object and upsert it
-			pricesImport := model.PricesImport{
-				ProductID: productID,
-				Timestamp: csvImport.Timestamp,
-				Prices:    prices,
-			}
-
-			pricesImportID, err := s.storage.PriceImport().UpsertByProductIDAndTimestamp(ctx, pricesImport)
-			if err != nil {
-				errsCh <- fmt.Errorf("product %s: pricesImport object upsert failed: %w", name, err)
-				return
-			}
-
-			if !pricesImportID.IsZero() {
-				s.logger.Infof("CSV import: product %s import prices set: %s", name, csvImport.Timestamp)
-			} else {
-				s.logger.Warnf("CSV import: product %s import prices updated: %s", name, csvImport.Timestamp)
-			}
-		}(productName, productPrices)
-	}
-
-	// wait for workers to finish and checks for accumulated errors
-	wg.Wait()
-	close(errsCh)
-
-	errs := make([]string, 0)
-	for err := range errsCh {
-		errs = append(errs, err.Error())
-	}
-	if len(errs) > 0 {
-		return fmt.Errorf("partially failed (%d / %d): %s", len(productsMap), len(errs), strings.Join(errs, ", "))
 	}
 
+	// prices are appended: chunks of the same import are written concurrently and may contain the same product
+	createdCnt, err := s.storage.PriceImport().BulkAppendByProductIDAndTimestamp(ctx, pricesImports)
+	if err != nil {
+		return fmt.Errorf("pricesImports bulk upsert failed (%d): %w", len(pricesImports), err)
+	}
+
+	s.logger.Infof("CSV import: %d products import prices set, %d updated: %s", createdCnt, int64(len(pricesImports))-createdCnt, csvImport.Timestamp)
+
 	return nil
 }
+
+// CheckSource implements CSVImporterService interface.
+func (s csvImporterService) CheckSource(ctx context.Context, source model.ImportSource, force bool) error {
+	if source.ContentHash == "" {
+		return fmt.Errorf("%w: source.ContentHash: empty", common.ErrInvalidInput)
+	}
+
+	entry, err := s.storage.ImportLedger().GetLatestByContentHash(ctx, source.ContentHash)
+	if err != nil {
+		if errors.Is(err, common.ErrNotFound) {
+			return nil
+		}
+		return fmt.Errorf("import ledger lookup: %w", err)
+	}
+
+	if force {
+		s.logger.Warnf("CSV import: content %s has already been imported (%s), forced re-import", source.ContentHash, entry.Timestamp)
+		return nil
+	}
+
+	return fmt.Errorf("%w: content %s has already been imported from %s: %s", common.ErrAlreadyExists, source.ContentHash, entry.Source.URL, entry.Timestamp)
+}
+
+// ClaimSource implements CSVImporterService interface.
+func (s csvImporterService) ClaimSource(ctx context.Context, entry model.ImportLedgerEntry) (primitive.ObjectID, error) {
+	// entries recorded before claims were introduced are not covered by the unique index
+	if err := s.CheckSource(ctx, entry.Source, entry.Forced); err != nil {
+		return primitive.ObjectID{}, err
+	}
+
+	ledgerStorage := s.storage.ImportLedger()
+	entryID, err := ledgerStorage.Create(ctx, entry)
+	if errors.Is(err, common.ErrAlreadyExists) {
+		released, releaseErr := s.releaseStaleClaim(ctx, entry.Source.ContentHash)
+		if releaseErr != nil {
+			return primitive.ObjectID{}, fmt.Errorf("releasing stale import ledger claim: %w", releaseErr)
+		}
+		if released {
+			entryID, err = ledgerStorage.Create(ctx, entry)
+		}
+	}
+	if err != nil {
+		if errors.Is(err, common.ErrAlreadyExists) {
+			return primitive.ObjectID{}, fmt.Errorf("%w: content %s is being imported or has already been imported", common.ErrAlreadyExists, entry.Source.ContentHash)
+		}
+		return primitive.ObjectID{}, fmt.Errorf("import ledger record: %w", err)
+	}
+
+	return entryID, nil
+}
+
+// CompleteSource implements CSVImporterService interface.
+func (s csvImporterService) CompleteSource(ctx context.Context, entryID primitive.ObjectID) error {
+	if err := s.storage.ImportLedger().Complete(ctx, entryID); err != nil {
+		return fmt.Errorf("import ledger record: %w", err)
+	}
+
+	return nil
+}
+
+// ReleaseSource implements CSVImporterService interface.
+func (s csvImporterService) ReleaseSource(ctx context.Context, entryID primitive.ObjectID) error {
+	if _, err := s.storage.ImportLedger().DeletePending(ctx, entryID); err != nil {
+		return fmt.Errorf("import ledger record: %w", err)
+	}
+
+	return nil
+}
+
+// releaseStaleClaim deletes the pending content hash claim if its import job has not succeeded (failed on the server restart, etc.).
+// Returns true if the claim has been released.
+func (s csvImporterService) releaseStaleClaim(ctx context.Context, contentHash string) (bool, error) {
+	entry, err := s.storage.ImportLedger().GetClaimByContentHash(ctx, contentHash)
+	if err != nil {
+		if errors.Is(err, common.ErrNotFound) {
+			// released concurrently
+			return true, nil
+		}
+		return false, err
+	}
+	if !entry.Pending || entry.JobID.IsZero() {
+		return false, nil
+	}
+
+	job, err := s.storage.ImportJob().GetByID(ctx, entry.JobID.Hex())
+	if err != nil && !errors.Is(err, common.ErrNotFound) {
+		return false, err
+	}
+	if err == nil && (!job.State.IsFinal() || job.State == model.ImportJobStateDone) {
+		return false, nil
+	}
+
+	if _, err := s.storage.ImportLedger().DeletePending(ctx, entry.ID); err != nil {
+		return false, err
+	}
+	s.logger.Warnf("CSV import: stale content %s claim of the import job %s is released", contentHash, entry.JobID.Hex())
+
+	return true, nil
+}
+
+// EvaluateAlerts implements CSVImporterService interface.
+func (s csvImporterService) EvaluateAlerts(ctx context.Context, importTimestamp time.Time) (int, error) {
+	notifications, err := s.alerts.evaluate(ctx, importTimestamp)
+	if err != nil {
+		return 0, fmt.Errorf("price alerts: %w", err)
+	}
+
+	if len(notifications) > 0 {
+		s.logger.Infof("CSV import: %d price alert rules triggered: %s", len(notifications), importTimestamp)
+	}
+
+	return len(notifications), nil
+}
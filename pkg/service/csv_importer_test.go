@@ -3,8 +3,13 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
 	"time"
 
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
 
 	"github.com/itiky/mdb-tutorial/pkg/common"
@@ -25,6 +30,11 @@ func (s *ServiceTestSuite) TestService_CSVImporter() {
 	)
 	require.NoError(t, err)
 
+	// import ledger content hash claims rely on the unique index
+	require.NoError(t, client.Database(testutils.TestMongoDBDatabase).Collection(storage.MigrationsCollection).Drop(ctx))
+	_, err = svcStorage.Migration().Up(ctx, 0)
+	require.NoError(t, err)
+
 	service, err := NewService(
 		WithStorage(svcStorage),
 	)
@@ -35,7 +45,7 @@ func (s *ServiceTestSuite) TestService_CSVImporter() {
 	{
 		csvImport := model.CSVImport{
 			Timestamp: time.Time{},
-			Entries:   model.CSVEntries{model.CSVEntry{ProductName: "name", Price: 100}},
+			Entries:   model.CSVEntries{model.CSVEntry{ProductName: "name", Price: model.MustParseMoney("100", "USD")}},
 		}
 
 		err := targetSvc.ImportPrices(ctx, csvImport)
@@ -59,7 +69,7 @@ func (s *ServiceTestSuite) TestService_CSVImporter() {
 	{
 		csvImport := model.CSVImport{
 			Timestamp: time.Now(),
-			Entries:   model.CSVEntries{model.CSVEntry{ProductName: "", Price: 100}},
+			Entries:   model.CSVEntries{model.CSVEntry{ProductName: "", Price: model.MustParseMoney("100", "USD")}},
 		}
 
 		err := targetSvc.ImportPrices(ctx, csvImport)
@@ -71,7 +81,7 @@ func (s *ServiceTestSuite) TestService_CSVImporter() {
 	{
 		csvImport := model.CSVImport{
 			Timestamp: time.Now(),
-			Entries:   model.CSVEntries{model.CSVEntry{ProductName: "name", Price: -1}},
+			Entries:   model.CSVEntries{model.CSVEntry{ProductName: "name", Price: model.MustParseMoney("-1", "USD")}},
 		}
 
 		err := targetSvc.ImportPrices(ctx, csvImport)
@@ -79,18 +89,33 @@ func (s *ServiceTestSuite) TestService_CSVImporter() {
 		require.True(t, errors.Is(err, common.ErrInvalidInput))
 	}
 
-	// check ImportPrices: ok
+	// check ImportPrices: invalid currency
 	{
+		price := model.MustParseMoney("100", "USD")
+		price.Currency = "usd"
 		csvImport := model.CSVImport{
 			Timestamp: time.Now(),
+			Entries:   model.CSVEntries{model.CSVEntry{ProductName: "name", Price: price}},
+		}
+
+		err := targetSvc.ImportPrices(ctx, csvImport)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check ImportPrices: ok
+	importTimestamp := time.Now().UTC().Truncate(time.Millisecond)
+	{
+		csvImport := model.CSVImport{
+			Timestamp: importTimestamp,
 			Entries: model.CSVEntries{
-				model.CSVEntry{ProductName: "Product Z", Price: 0},
-				model.CSVEntry{ProductName: "Product X", Price: 5},
-				model.CSVEntry{ProductName: "Product Y", Price: 50},
-				model.CSVEntry{ProductName: "Product X", Price: 10},
-				model.CSVEntry{ProductName: "Product Y", Price: 55},
-				model.CSVEntry{ProductName: "Product Y", Price: 60},
-				model.CSVEntry{ProductName: "Product Z", Price: 100},
+				model.CSVEntry{ProductName: "Product Z", Price: model.MustParseMoney("0", "USD")},
+				model.CSVEntry{ProductName: "Product X", Price: model.MustParseMoney("5", "USD")},
+				model.CSVEntry{ProductName: "Product Y", Price: model.MustParseMoney("50", "USD")},
+				model.CSVEntry{ProductName: "Product X", Price: model.MustParseMoney("10", "USD")},
+				model.CSVEntry{ProductName: "Product Y", Price: model.MustParseMoney("55.99", "EUR")},
+				model.CSVEntry{ProductName: "Product Y", Price: model.MustParseMoney("60", "USD")},
+				model.CSVEntry{ProductName: "Product Z", Price: model.MustParseMoney("100", "USD")},
 			},
 		}
 
@@ -105,4 +130,216 @@ func (s *ServiceTestSuite) TestService_CSVImporter() {
 		require.NoError(t, err)
 		require.Len(t, priceImports, 3)
 	}
+
+	// check ImportPrices: the next chunk of the same import appends prices
+	{
+		csvImport := model.CSVImport{
+			Timestamp: importTimestamp,
+			Entries: model.CSVEntries{
+				model.CSVEntry{ProductName: "Product X", Price: model.MustParseMoney("15", "USD")},
+			},
+		}
+
+		err := targetSvc.ImportPrices(ctx, csvImport)
+		require.NoError(t, err)
+
+		productIDs, err := svcStorage.Product().BulkUpsertByNames(ctx, []string{"Product X"})
+		require.NoError(t, err)
+
+		priceImports, err := svcStorage.PriceImport().GetAll(ctx, importTimestamp, productIDs["Product X"].Hex())
+		require.NoError(t, err)
+		require.Len(t, priceImports, 1)
+		require.Len(t, priceImports[0].Prices, 3)
+		require.Equal(t, 0, priceImports[0].Prices[2].Money().Cmp(model.MustParseMoney("15", "USD")))
+	}
+
+	// check CheckSource: invalid input
+	source := model.ImportSource{
+		URL:         "http://localhost/1.csv",
+		Size:        100,
+		ContentHash: "hash",
+	}
+	{
+		err := targetSvc.CheckSource(ctx, model.ImportSource{}, false)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check CheckSource: new content
+	{
+		err := targetSvc.CheckSource(ctx, source, false)
+		require.NoError(t, err)
+	}
+
+	// check ClaimSource: pending claim of a running job
+	jobID, err := svcStorage.ImportJob().Create(ctx, model.ImportJob{URL: source.URL, State: model.ImportJobStateProcessing})
+	require.NoError(t, err)
+	claim := model.ImportLedgerEntry{
+		Source:    source,
+		Timestamp: time.Now().UTC(),
+		JobID:     jobID,
+		Pending:   true,
+	}
+	{
+		_, err := targetSvc.ClaimSource(ctx, claim)
+		require.NoError(t, err)
+
+		// pending claims are not reported as imported
+		require.NoError(t, targetSvc.CheckSource(ctx, source, false))
+	}
+
+	// check ClaimSource: the content is being imported
+	{
+		_, err := targetSvc.ClaimSource(ctx, claim)
+		require.True(t, errors.Is(err, common.ErrAlreadyExists))
+	}
+
+	// check ClaimSource: stale claim of the failed job is released
+	{
+		require.NoError(t, svcStorage.ImportJob().SetState(ctx, jobID, model.ImportJobStateFailed, "interrupted"))
+
+		claimID, err := targetSvc.ClaimSource(ctx, claim)
+		require.NoError(t, err)
+
+		// check ReleaseSource
+		require.NoError(t, targetSvc.ReleaseSource(ctx, claimID))
+	}
+
+	// check CompleteSource
+	{
+		require.NoError(t, svcStorage.ImportJob().SetState(ctx, jobID, model.ImportJobStateProcessing, ""))
+
+		claimID, err := targetSvc.ClaimSource(ctx, claim)
+		require.NoError(t, err)
+		require.NoError(t, targetSvc.CompleteSource(ctx, claimID))
+	}
+
+	// check CheckSource: already imported content
+	{
+		err := targetSvc.CheckSource(ctx, source, false)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrAlreadyExists))
+	}
+
+	// check CheckSource: already imported content (forced)
+	{
+		err := targetSvc.CheckSource(ctx, source, true)
+		require.NoError(t, err)
+	}
+
+	// check ClaimSource: already imported content (not forced / forced)
+	{
+		claim.Pending = false
+		_, err := targetSvc.ClaimSource(ctx, claim)
+		require.True(t, errors.Is(err, common.ErrAlreadyExists))
+
+		claim.Forced = true
+		_, err = targetSvc.ClaimSource(ctx, claim)
+		require.NoError(t, err)
+	}
+}
+
+// BenchmarkCSVImporter_ImportPrices compares the bulk ImportPrices write path with the former per-product one.
+func BenchmarkCSVImporter_ImportPrices(b *testing.B) {
+	ctx := context.Background()
+
+	r := testutils.NewResources(testutils.WithLogLevel(logrus.ErrorLevel))
+	defer r.Shutdown(ctx)
+
+	client := testutils.PrepareMongoDBFixtures(ctx, r, fixtures.NewEmptyMongoDBFixtures())
+	svcStorage, err := storage.NewStorage(
+		storage.WithDatabase(testutils.TestMongoDBDatabase),
+		storage.WithMongoDBClient(client),
+	)
+	require.NoError(b, err)
+
+	service, err := NewService(
+		WithStorage(svcStorage),
+	)
+	require.NoError(b, err)
+	targetSvc := service.CSVImporter()
+
+	// chunk of 1000 entries: 500 products with 2 prices each
+	csvImport := model.CSVImport{
+		Entries: make(model.CSVEntries, 0, 1000),
+	}
+	for i := 0; i < 1000; i++ {
+		csvImport.Entries = append(csvImport.Entries, model.CSVEntry{
+			ProductName: fmt.Sprintf("Product_%d", i%500),
+			Price:       model.MustParseMoney(strconv.Itoa(i), "USD"),
+		})
+	}
+
+	baseTimestamp, iteration := time.Now().UTC(), 0
+	for _, bm := range []struct {
+		name       string
+		importFunc func(ctx context.Context, csvImport model.CSVImport) error
+	}{
+		{
+			name: "PerProduct",
+			importFunc: func(ctx context.Context, csvImport model.CSVImport) error {
+				return importPricesPerProduct(ctx, svcStorage, csvImport)
+			},
+		},
+		{
+			name:       "Bulk",
+			importFunc: targetSvc.ImportPrices,
+		},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				// every iteration creates new price imports for the existing products
+				iteration++
+				csvImport.Timestamp = baseTimestamp.Add(time.Duration(iteration) * time.Second)
+
+				if err := bm.importFunc(ctx, csvImport); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// importPricesPerProduct is the former ImportPrices write path: a goroutine with up to 3 round-trips per product.
+func importPricesPerProduct(ctx context.Context, st storage.Storage, csvImport model.CSVImport) error {
+	productsMap := make(map[string][]model.Price)
+	for _, entry := range csvImport.Entries {
+		productsMap[entry.ProductName] = append(productsMap[entry.ProductName], model.NewPrice(entry.Price))
+	}
+
+	wg := sync.WaitGroup{}
+	errsCh := make(chan error, len(productsMap))
+	for productName, productPrices := range productsMap {
+		wg.Add(1)
+		go func(name string, prices []model.Price) {
+			defer wg.Done()
+
+			productID, err := st.Product().UpsertByName(ctx, model.Product{Name: name})
+			if err != nil {
+				errsCh <- err
+				return
+			}
+			if productID.IsZero() {
+				product, err := st.Product().GetByName(ctx, name)
+				if err != nil {
+					errsCh <- err
+					return
+				}
+				productID = product.ID
+			}
+
+			_, err = st.PriceImport().UpsertByProductIDAndTimestamp(ctx, model.PricesImport{
+				ProductID: productID,
+				Timestamp: csvImport.Timestamp,
+				Prices:    prices,
+			})
+			if err != nil {
+				errsCh <- err
+			}
+		}(productName, productPrices)
+	}
+
+	wg.Wait()
+	close(errsCh)
+
+	return <-errsCh
 }
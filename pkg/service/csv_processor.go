@@ -1,160 +1,408 @@
 package service
 
 import (
+	"bufio"
 	"context"
-	"encoding/csv"
 	"fmt"
 	"io"
-	"net/http"
-	"net/url"
+	"io/ioutil"
 	"os"
 	"path"
-	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/sirupsen/logrus"
 
 	"github.com/itiky/mdb-tutorial/pkg/common"
+	"github.com/itiky/mdb-tutorial/pkg/model"
 )
 
 var _ CSVProcessorService = (*csvProcessorService)(nil)
 
 type csvProcessorService struct {
-	logger *logrus.Logger
+	logger            *logrus.Logger
+	fetchers          sourceFetchers
+	zipLimits         model.ZipLimits
+	maxDecompressSize int64
 }
 
-// Download implements CSVDownloaderService interface.
-func (s csvProcessorService) Download(inputPath string) (outputFilePath string, downloadTimestamp time.Time, retErr error) {
-	// input check
-	if _, err := url.Parse(inputPath); err != nil {
-		retErr = fmt.Errorf("%w: path invalid: %v", common.ErrInvalidInput, err)
+// Download implements CSVProcessorService interface.
+func (s csvProcessorService) Download(ctx context.Context, inputPath string) (retFile model.CSVFile, retErr error) {
+	// create a tmp file to avoid potentially big RAM usage
+	downloadTimestamp := time.Now().UTC()
+	outputFileName := fmt.Sprintf("prices_import_%s.csv", downloadTimestamp.Format("2006-01-02T15-04-05"))
+	outputFilePath := path.Join(os.TempDir(), outputFileName)
+
+	outputFile, err := os.Create(outputFilePath)
+	if err != nil {
+		retErr = fmt.Errorf("creating tmpFile %s: %v", outputFilePath, err)
 		return
 	}
+	defer func() {
+		outputFile.Close()
+		if retErr != nil {
+			os.Remove(outputFilePath)
+		}
+	}()
 
-	// download
-	resp, err := http.Get(inputPath)
+	// copy response body calculating the content hash on the fly (file is rewritten if download can't be resumed)
+	hasher := newContentHasher()
+	restart := func() error {
+		if err := outputFile.Truncate(0); err != nil {
+			return err
+		}
+		if _, err := outputFile.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		hasher.Reset()
+
+		return nil
+	}
+
+	source, err := s.fetchers.fetch(ctx, inputPath, restart)
 	if err != nil {
-		retErr = fmt.Errorf("GET failed: %v", err)
+		retErr = err
 		return
 	}
-	defer resp.Body.Close()
+	defer source.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		retErr = fmt.Errorf("%w: GET failed: %s", common.ErrNotFound, resp.Status)
+	if _, err := io.Copy(io.MultiWriter(outputFile, hasher), source); err != nil {
+		retErr = fmt.Errorf("body to file copy failed: %w", err)
+		return
+	}
+	n := hasher.Size()
+	if n == 0 {
+		retErr = fmt.Errorf("body to file copy failed: written bytes (%d)", n)
 		return
 	}
 
-	// create a tmp file to avoid potentially big RAM usage
-	downloadTimestamp = time.Now().UTC()
-	outputFileName := fmt.Sprintf("prices_import_%s.csv", downloadTimestamp.Format("2006-01-02T15-04-05"))
-	outputFilePath = path.Join(os.TempDir(), outputFileName)
+	// detect compression by magic bytes, as metadata could be misleading
+	magic := make([]byte, csvCompressionMagicSize)
+	magicLen, err := outputFile.ReadAt(magic, 0)
+	if err != nil && err != io.EOF {
+		retErr = fmt.Errorf("reading tmpFile %s: %v", outputFilePath, err)
+		return
+	}
 
-	outputFile, err := os.Create(outputFilePath)
+	compression := detectCSVCompression(magic[:magicLen])
+	meta := source.Meta()
+	hints := csvCompressionHints{
+		contentEncoding: meta.ContentEncoding,
+		contentType:     meta.ContentType,
+		fileURL:         inputPath,
+	}
+	if hintedCompression := hints.guess(); hintedCompression != compression {
+		s.logger.Warnf("CSV download: %s: declared compression %q doesn't match the content one %q (content is used)", inputPath, hintedCompression, compression)
+	}
+
+	retFile = model.CSVFile{
+		Path:      outputFilePath,
+		Timestamp: downloadTimestamp,
+		Source: model.ImportSource{
+			URL:         inputPath,
+			ETag:        meta.ETag,
+			Size:        n,
+			ContentHash: hasher.Hash(),
+			Compression: compression,
+		},
+	}
+	s.logger.Infof("file %s downloaded: %s (%d bytes, sha256: %s)", inputPath, outputFilePath, n, retFile.Source.ContentHash)
+
+	return
+}
+
+// Stream implements CSVProcessorService interface.
+func (s csvProcessorService) Stream(ctx context.Context, inputPath string, entryHandler csvEntryHandler) (retSource model.ImportSource, retErr error) {
+	// input check
+	if entryHandler == nil {
+		retErr = fmt.Errorf("%w: entryHandler is nil", common.ErrInvalidInput)
+		return
+	}
+
+	// fetch (processed data can't be rolled back, so no restarts)
+	source, err := s.fetchers.fetch(ctx, inputPath, nil)
 	if err != nil {
-		retErr = fmt.Errorf("creating tmpFile %s: %v", outputFilePath, err)
+		retErr = err
+		return
+	}
+	defer source.Close()
+
+	// calculate the content hash on the fly and peek magic bytes to detect compression
+	hasher := newContentHasher()
+	bodyReader := bufio.NewReader(io.TeeReader(source, hasher))
+
+	magic, err := bodyReader.Peek(csvCompressionMagicSize)
+	if err != nil && err != io.EOF {
+		retErr = fmt.Errorf("reading body: %w", err)
+		return
+	}
+
+	compression := detectCSVCompression(magic)
+	meta := source.Meta()
+	hints := csvCompressionHints{
+		contentEncoding: meta.ContentEncoding,
+		contentType:     meta.ContentType,
+		fileURL:         inputPath,
+	}
+	if hintedCompression := hints.guess(); hintedCompression != compression {
+		s.logger.Warnf("CSV stream: %s: declared compression %q doesn't match the content one %q (content is used)", inputPath, hintedCompression, compression)
+	}
+	if compression == model.CSVCompressionZip {
+		retErr = fmt.Errorf("%w: zip archives can't be streamed (tmpfile import mode is required)", common.ErrInvalidInput)
 		return
 	}
-	defer outputFile.Close()
 
-	// copy response body
-	n, err := io.Copy(outputFile, resp.Body)
+	// process the body (handler reads it at its own pace, so the sender is slowed down by TCP flow control)
+	reader, err := newCSVDecompressor(bodyReader, compression, s.maxDecompressSize)
 	if err != nil {
-		retErr = fmt.Errorf("body to file copy failed: %v", err)
+		retErr = err
 		return
 	}
-	if n == 0 {
-		retErr = fmt.Errorf("body to file copy failed: written bytes (%d)", n)
+	defer reader.Close()
+
+	if err := entryHandler("", reader); err != nil {
+		retErr = err
+		return
+	}
+
+	// read the rest of the body (if any), so the hash covers the whole content
+	if _, err := io.Copy(ioutil.Discard, bodyReader); err != nil {
+		retErr = fmt.Errorf("reading body: %w", err)
+		return
+	}
+	if hasher.Size() == 0 {
+		retErr = fmt.Errorf("reading body: empty")
 		return
 	}
 
-	s.logger.Infof("file %s downloaded: %s", inputPath, outputFilePath)
+	retSource = model.ImportSource{
+		URL:         inputPath,
+		ETag:        meta.ETag,
+		Size:        hasher.Size(),
+		ContentHash: hasher.Hash(),
+		Compression: compression,
+	}
 
 	return
 }
 
-// ParseCSV implements CSVDownloaderService interface.
+// Extract implements CSVProcessorService interface.
+func (s csvProcessorService) Extract(csvFile model.CSVFile, entryHandler csvEntryHandler) error {
+	if csvFile.Path == "" {
+		return fmt.Errorf("%w: csvFile.Path: empty", common.ErrInvalidInput)
+	}
+	if entryHandler == nil {
+		return fmt.Errorf("%w: entryHandler is nil", common.ErrInvalidInput)
+	}
+
+	return extractCSVFile(csvFile.Path, csvFile.Source.Compression, s.zipLimits, s.maxDecompressSize, entryHandler)
+}
+
+// Process implements CSVProcessorService interface.
+// Chunks are processed by a pipeline: a reader goroutine parses chunks, workers execute them concurrently,
+// results are reported (and errors are aggregated) in the chunks order.
 func (s csvProcessorService) Process(
 	ctx context.Context,
-	reader io.Reader, importTimestamp time.Time,
-	chunkSize int, chunkWorker csvChunkWorker,
+	reader io.Reader, importTimestamp time.Time, params model.CSVProcessParams,
+	chunkWorker csvChunkWorker, chunkReporter csvChunkReporter,
 ) error {
 
 	// input check
 	if reader == nil {
 		return fmt.Errorf("%w: reader is nil", common.ErrInvalidInput)
 	}
-	if chunkSize <= 0 {
-		return fmt.Errorf("%w: chunkSize should be GT 0", common.ErrInvalidInput)
+	if err := params.Validate(); err != nil {
+		return err
 	}
 	if chunkWorker == nil {
 		return fmt.Errorf("%w: chunkWorker is nil", common.ErrInvalidInput)
 	}
+	workersNum := params.GetWorkers()
 
-	// configure CSV-reader
-	csvReader := csv.NewReader(reader)
-	csvReader.Comma = ';'
+	// configure CSV-reader and read the header (if any)
+	csvReader := newCSVReader(reader, params.Dialect)
 
-	// processChunk executes chunk and accumulates errors
-	retErrStrings := make([]string, 0)
-	processFailedChunk := func(chunk *csvChunk) {
-		errStr := chunk.getErrorString()
-		retErrStrings = append(retErrStrings, errStr)
-		s.logger.Errorf("processing CSV: %s", errStr)
-	}
-	processChunk := func(chunk *csvChunk) {
-		chunk.execute(ctx, chunkWorker)
-		if chunk.isFailed() {
-			processFailedChunk(chunk)
-		} else {
-			s.logger.Infof("processing CSV: %s", chunk.getStateString())
-		}
-	}
-
-	// read file line by line
-	curLineNumber, curChunkID := 0, 1
-	curChunk := newCSVChunk(curChunkID, chunkSize, importTimestamp)
-	for {
+	curLineNumber := 0
+	var header []string
+	if params.Dialect.HasHeader {
 		curLineNumber++
-
-		// read line and process reading error
 		row, err := csvReader.Read()
 		if err != nil {
 			if err == io.EOF {
+				return nil
+			}
+			return fmt.Errorf("reading header: %w", err)
+		}
+		header = row
+	}
+
+	rowParser, err := newCSVRowParser(params.Dialect, header)
+	if err != nil {
+		return fmt.Errorf("dialect columns: %w", err)
+	}
+
+	// pipeline stages are stopped on ctx cancel, channels are bounded to limit RAM usage
+	pipelineCtx, pipelineCancel := context.WithCancel(ctx)
+	defer pipelineCancel()
+
+	chunksCh := make(chan *csvChunk, workersNum)
+	resultsCh := make(chan *csvChunk, workersNum)
+	stagesWg := sync.WaitGroup{}
+
+	// in-flight chunks semaphore (executed and queued ones), a slot is released once the chunk is reported:
+	// a slow chunk blocks the reader, so chunks waiting to be reported in order don't pile up in RAM
+	inFlightCh := make(chan struct{}, 2*workersNum)
+
+	// reader stage: read file line by line building chunks
+	stagesWg.Add(1)
+	go func() {
+		defer stagesWg.Done()
+		defer close(chunksCh)
+
+		sendChunk := func(chunk *csvChunk) bool {
+			select {
+			case inFlightCh <- struct{}{}:
+			case <-pipelineCtx.Done():
+				return false
+			}
+
+			select {
+			case chunksCh <- chunk:
+				return true
+			case <-pipelineCtx.Done():
+				return false
+			}
+		}
+
+		curChunkID := 1
+		curChunk := newCSVChunk(curChunkID, params.ChunkSize, importTimestamp)
+		for {
+			if pipelineCtx.Err() != nil {
+				return
+			}
+			curLineNumber++
+
+			// read line and process reading error
+			row, err := csvReader.Read()
+			if err != nil {
+				if err == io.EOF {
+					break
+				}
+				curChunk.addParsingError(curLineNumber, err)
 				break
 			}
-			curChunk.addParsingError(curLineNumber, err)
-			break
+
+			// parse row
+			entry, err := rowParser.parse(row)
+			if err != nil {
+				curChunk.addParsingError(curLineNumber, err)
+				continue
+			}
+			entry.Row = curLineNumber
+
+			// append entry and pass the current chunk to workers
+			curChunk.addEntry(entry)
+			if curChunk.isFull() {
+				if !sendChunk(curChunk) {
+					return
+				}
+				curChunkID++
+				curChunk = newCSVChunk(curChunkID, params.ChunkSize, importTimestamp)
+			}
 		}
 
-		// parse row
-		if len(row) != 2 {
-			curChunk.addParsingError(curLineNumber, fmt.Errorf("invalid row length (%d)", len(row)))
-			continue
+		// the last chunk (not full or failed without entries)
+		if !curChunk.isEmpty() || curChunk.isFailed() {
+			sendChunk(curChunk)
 		}
-		productName := row[0]
-		price, err := strconv.ParseInt(strings.TrimSpace(row[1]), 10, 32)
-		if err != nil {
-			curChunk.addParsingError(curLineNumber, fmt.Errorf("price convertion failed (%s)", row[1]))
-			continue
+	}()
+
+	// workers stage: execute chunks (chunks without entries are passed through)
+	workersWg := sync.WaitGroup{}
+	for i := 0; i < workersNum; i++ {
+		workersWg.Add(1)
+		go func() {
+			defer workersWg.Done()
+
+			for chunk := range chunksCh {
+				if pipelineCtx.Err() != nil {
+					return
+				}
+				if !chunk.isEmpty() {
+					chunk.execute(pipelineCtx, chunkWorker)
+				}
+
+				select {
+				case resultsCh <- chunk:
+				case <-pipelineCtx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	stagesWg.Add(1)
+	go func() {
+		defer stagesWg.Done()
+		workersWg.Wait()
+		close(resultsCh)
+	}()
+
+	// results stage: report chunks in order accumulating errors (pending chunks are limited by the in-flight semaphore)
+	retErrStrings := make([]string, 0)
+	reportChunk := func(chunk *csvChunk) {
+		if chunk.isFailed() {
+			errStr := chunk.getErrorString()
+			retErrStrings = append(retErrStrings, errStr)
+			s.logger.Errorf("processing CSV: %s", errStr)
+		} else {
+			s.logger.Infof("processing CSV: %s", chunk.getStateString())
 		}
 
-		// append entry and process the current chunk
-		curChunk.addEntry(productName, int(price))
-		if curChunk.isFull() {
-			processChunk(curChunk)
-			curChunk = newCSVChunk(curChunkID, chunkSize, importTimestamp)
+		if chunkReporter != nil {
+			chunkReporter(chunk.getResult())
 		}
 	}
 
-	// check the last chunk (not full)
-	if !curChunk.isEmpty() {
-		processChunk(curChunk)
-	} else if curChunk.isFailed() {
-		processFailedChunk(curChunk)
+	nextChunkID, stopped := 1, false
+	pendingChunks := make(map[int]*csvChunk)
+	for chunk := range resultsCh {
+		if stopped {
+			continue
+		}
+
+		pendingChunks[chunk.id] = chunk
+		for {
+			nextChunk, found := pendingChunks[nextChunkID]
+			if !found {
+				break
+			}
+			delete(pendingChunks, nextChunkID)
+			nextChunkID++
+			reportChunk(nextChunk)
+			<-inFlightCh
+
+			// stop other stages, chunks already being executed are drained
+			if params.FailFast && nextChunk.isFailed() {
+				stopped = true
+				pipelineCancel()
+				break
+			}
+		}
 	}
 
-	// check chunk errors
+	// wait for all stages to stop (reader must not be used after return)
+	pipelineCancel()
+	stagesWg.Wait()
+
+	// check cancellation and chunk errors
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("processing cancelled (%d chunks reported): %w", nextChunkID-1, err)
+	}
+	if stopped {
+		return fmt.Errorf("stopped on the failed chunk (%d chunks reported): %s", nextChunkID-1, retErrStrings[len(retErrStrings)-1])
+	}
 	if len(retErrStrings) > 0 {
 		return fmt.Errorf("partially processed: %s", strings.Join(retErrStrings, ", "))
 	}
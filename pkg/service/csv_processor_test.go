@@ -1,13 +1,27 @@
 package service
 
 import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strconv"
 	"strings"
+	"sync"
+	"testing"
 	"time"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/stretchr/testify/require"
 
+	"github.com/itiky/mdb-tutorial/pkg/common"
 	"github.com/itiky/mdb-tutorial/pkg/model"
 )
 
@@ -35,22 +49,417 @@ func (s *ServiceTestSuite) TestService_CSVProcessor_Download() {
 
 	// check Download: invalid url
 	{
-		_, _, err := targetSvc.Download("")
+		_, err := targetSvc.Download(context.Background(), "")
 		require.Error(t, err)
 	}
 
 	// check Download: ok
 	{
-		filePath, timestamp, err := targetSvc.Download("https://people.sc.fsu.edu/~jburkardt/data/csv/addresses.csv")
+		csvFile, err := targetSvc.Download(context.Background(), "https://people.sc.fsu.edu/~jburkardt/data/csv/addresses.csv")
 		require.NoError(t, err)
-		require.NotEmpty(t, filePath)
-		require.False(t, timestamp.IsZero())
+		require.NotEmpty(t, csvFile.Path)
+		require.False(t, csvFile.Timestamp.IsZero())
+		require.NotEmpty(t, csvFile.Source.ContentHash)
 
-		fStat, err := os.Stat(filePath)
+		fStat, err := os.Stat(csvFile.Path)
 		require.NoError(t, err)
 		require.NotZero(t, fStat.Size())
+		require.Equal(t, fStat.Size(), csvFile.Source.Size)
 
-		os.Remove(filePath)
+		os.Remove(csvFile.Path)
+	}
+}
+
+func (s *ServiceTestSuite) TestService_CSVProcessor_Extract() {
+	t := s.T()
+
+	service, err := NewService()
+	require.NoError(t, err)
+	targetSvc := service.CSVProcessor()
+
+	// writeFile creates a tmp file removed on test end
+	writeFile := func(data []byte) string {
+		file, err := ioutil.TempFile("", "prices_extract_test_*")
+		require.NoError(t, err)
+		defer file.Close()
+		t.Cleanup(func() { os.Remove(file.Name()) })
+
+		_, err = file.Write(data)
+		require.NoError(t, err)
+
+		return file.Name()
+	}
+
+	// extractWith runs Extract of the processor collecting entries content
+	extractWith := func(processor CSVProcessorService, data []byte, compression model.CSVCompression) ([][2]string, error) {
+		entries := make([][2]string, 0)
+		csvFile := model.CSVFile{
+			Path:   writeFile(data),
+			Source: model.ImportSource{Compression: compression},
+		}
+
+		err := processor.Extract(csvFile, func(entryName string, reader io.Reader) error {
+			content, err := ioutil.ReadAll(reader)
+			if err != nil {
+				return err
+			}
+			entries = append(entries, [2]string{entryName, string(content)})
+			return nil
+		})
+
+		return entries, err
+	}
+	extract := func(data []byte, compression model.CSVCompression) ([][2]string, error) {
+		return extractWith(targetSvc, data, compression)
+	}
+
+	// gzip / zstd compressed content
+	gzipBuf := &bytes.Buffer{}
+	{
+		writer := gzip.NewWriter(gzipBuf)
+		_, err := writer.Write([]byte(mockCSV))
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+	}
+	zstdBuf := &bytes.Buffer{}
+	{
+		writer, err := zstd.NewWriter(zstdBuf)
+		require.NoError(t, err)
+		_, err = writer.Write([]byte(mockCSV))
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+	}
+	zipData := newMockZipArchive(t, [][2]string{
+		{"1.csv", "Product_1;1\n"},
+		{"__MACOSX/._1.csv", "metadata"},
+		{"notes.txt", "not a CSV-file"},
+		{"data/2.CSV", "Product_2;2\n"},
+	})
+
+	// check compression detection
+	{
+		require.Equal(t, model.CSVCompressionNone, detectCSVCompression([]byte(mockCSV)))
+		require.Equal(t, model.CSVCompressionGzip, detectCSVCompression(gzipBuf.Bytes()))
+		require.Equal(t, model.CSVCompressionZstd, detectCSVCompression(zstdBuf.Bytes()))
+		require.Equal(t, model.CSVCompressionZip, detectCSVCompression(zipData))
+
+		require.Equal(t, model.CSVCompressionGzip, csvCompressionHints{contentEncoding: "gzip"}.guess())
+		require.Equal(t, model.CSVCompressionZstd, csvCompressionHints{contentType: "application/zstd"}.guess())
+		require.Equal(t, model.CSVCompressionZip, csvCompressionHints{fileURL: "http://localhost/prices.ZIP?v=1"}.guess())
+		require.Equal(t, model.CSVCompressionNone, csvCompressionHints{fileURL: "http://localhost/prices.csv", contentType: "text/csv"}.guess())
+	}
+
+	// check Extract: invalid input
+	{
+		err := targetSvc.Extract(model.CSVFile{}, func(string, io.Reader) error { return nil })
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check Extract: plain, gzip and zstd files
+	for _, tc := range []struct {
+		data        []byte
+		compression model.CSVCompression
+	}{
+		{data: []byte(mockCSV), compression: model.CSVCompressionNone},
+		{data: gzipBuf.Bytes(), compression: model.CSVCompressionGzip},
+		{data: zstdBuf.Bytes(), compression: model.CSVCompressionZstd},
+	} {
+		entries, err := extract(tc.data, tc.compression)
+		require.NoError(t, err, tc.compression)
+		require.Len(t, entries, 1, tc.compression)
+		require.Empty(t, entries[0][0], tc.compression)
+		require.Equal(t, mockCSV, entries[0][1], tc.compression)
+	}
+
+	// check Extract: zip archive CSV-files only
+	{
+		entries, err := extract(zipData, model.CSVCompressionZip)
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		require.Equal(t, [2]string{"1.csv", "Product_1;1\n"}, entries[0])
+		require.Equal(t, [2]string{"data/2.CSV", "Product_2;2\n"}, entries[1])
+	}
+
+	// check Extract: zip archive without CSV-files
+	{
+		_, err := extract(newMockZipArchive(t, [][2]string{{"notes.txt", ""}}), model.CSVCompressionZip)
+		require.Error(t, err)
+	}
+
+	// check Extract: zip archive limits
+	{
+		_, err := NewService(WithZipLimits(model.ZipLimits{MaxEntries: 0, MaxUncompressedSize: 1}))
+		require.Error(t, err)
+
+		limitedService, err := NewService(WithZipLimits(model.ZipLimits{MaxEntries: 3, MaxUncompressedSize: 24}))
+		require.NoError(t, err)
+		limitedSvc := limitedService.CSVProcessor()
+
+		// entries number (non-CSV entries included)
+		entries, err := extractWith(limitedSvc, zipData, model.CSVCompressionZip)
+		require.Error(t, err)
+		require.Empty(t, entries)
+
+		// uncompressed CSV-files size
+		bigZipData := newMockZipArchive(t, [][2]string{
+			{"1.csv", "Product_1;1\n"},
+			{"2.csv", strings.Repeat("Product_2;2\n", 2)},
+		})
+		entries, err = extractWith(limitedSvc, bigZipData, model.CSVCompressionZip)
+		require.Error(t, err)
+		require.Empty(t, entries)
+
+		entries, err = extractWith(limitedSvc, newMockZipArchive(t, [][2]string{
+			{"1.csv", "Product_1;1\n"},
+			{"2.csv", "Product_2;2\n"},
+		}), model.CSVCompressionZip)
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+	}
+
+	// check Extract: gzip / zstd decompressed size limit
+	{
+		_, err := NewService(WithMaxDecompressedSize(0))
+		require.Error(t, err)
+
+		limitedService, err := NewService(WithMaxDecompressedSize(int64(len(mockCSV) - 1)))
+		require.NoError(t, err)
+		limitedSvc := limitedService.CSVProcessor()
+
+		for _, data := range [][]byte{gzipBuf.Bytes(), zstdBuf.Bytes()} {
+			_, err := extractWith(limitedSvc, data, detectCSVCompression(data))
+			require.Error(t, err)
+		}
+
+		exactService, err := NewService(WithMaxDecompressedSize(int64(len(mockCSV))))
+		require.NoError(t, err)
+
+		entries, err := extractWith(exactService.CSVProcessor(), gzipBuf.Bytes(), model.CSVCompressionGzip)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+	}
+
+	// check Extract: corrupted gzip file
+	{
+		_, err := extract([]byte(mockCSV), model.CSVCompressionGzip)
+		require.Error(t, err)
+	}
+}
+
+func (s *ServiceTestSuite) TestService_CSVProcessor_Stream() {
+	t := s.T()
+	ctx := context.Background()
+
+	service, err := NewService()
+	require.NoError(t, err)
+	targetSvc := service.CSVProcessor()
+
+	gzipBuf := &bytes.Buffer{}
+	{
+		writer := gzip.NewWriter(gzipBuf)
+		_, err := writer.Write([]byte(mockCSV))
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+	}
+	zipData := newMockZipArchive(t, [][2]string{{"1.csv", mockCSV}})
+
+	// mock file server (gzip file is served as is, without Content-Encoding)
+	fileServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/prices.csv":
+			w.Header().Set("ETag", `"etag"`)
+			_, _ = w.Write([]byte(mockCSV))
+		case "/prices.csv.gz":
+			_, _ = w.Write(gzipBuf.Bytes())
+		case "/prices.zip":
+			_, _ = w.Write(zipData)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer fileServer.Close()
+
+	// readAll handler saves the entry content
+	var content string
+	readAll := func(entryName string, reader io.Reader) error {
+		data, err := ioutil.ReadAll(reader)
+		content = string(data)
+		return err
+	}
+
+	// check Stream: invalid input
+	{
+		_, err := targetSvc.Stream(ctx, fileServer.URL+"/prices.csv", nil)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check Stream: non-existing
+	{
+		_, err := targetSvc.Stream(ctx, fileServer.URL+"/non-existing.csv", readAll)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+
+	// check Stream: plain file
+	{
+		source, err := targetSvc.Stream(ctx, fileServer.URL+"/prices.csv", readAll)
+		require.NoError(t, err)
+		require.Equal(t, mockCSV, content)
+		require.Equal(t, `"etag"`, source.ETag)
+		require.EqualValues(t, len(mockCSV), source.Size)
+		require.Equal(t, model.CSVCompressionNone, source.Compression)
+
+		hasher := newContentHasher()
+		_, _ = hasher.Write([]byte(mockCSV))
+		require.Equal(t, hasher.Hash(), source.ContentHash)
+	}
+
+	// check Stream: gzip file (hash is calculated for the compressed content)
+	{
+		source, err := targetSvc.Stream(ctx, fileServer.URL+"/prices.csv.gz", readAll)
+		require.NoError(t, err)
+		require.Equal(t, mockCSV, content)
+		require.EqualValues(t, gzipBuf.Len(), source.Size)
+		require.Equal(t, model.CSVCompressionGzip, source.Compression)
+	}
+
+	// check Stream: zip archives are not supported
+	{
+		_, err := targetSvc.Stream(ctx, fileServer.URL+"/prices.zip", readAll)
+		require.Error(t, err)
+	}
+}
+
+func (s *ServiceTestSuite) TestService_CSVProcessor_DownloadRetry() {
+	t := s.T()
+	ctx := context.Background()
+
+	service, err := NewService(
+		WithDownloadRetryPolicy(model.DownloadRetryPolicy{
+			MaxAttempts:    3,
+			InitialBackoff: 1 * time.Millisecond,
+			MaxBackoff:     5 * time.Millisecond,
+			AttemptTimeout: 5 * time.Second,
+		}),
+	)
+	require.NoError(t, err)
+	targetSvc := service.CSVProcessor()
+
+	// mock file server counting requests and saving Range headers per path
+	mu := sync.Mutex{}
+	requests := make(map[string]int)
+	ranges := make(map[string][]string)
+	modTime := time.Now().UTC()
+
+	writeAborted := func(w http.ResponseWriter, acceptRanges bool) {
+		w.Header().Set("Content-Length", strconv.Itoa(len(mockCSV)))
+		if acceptRanges {
+			w.Header().Set("Accept-Ranges", "bytes")
+			w.Header().Set("ETag", `"etag"`)
+		}
+		_, _ = w.Write([]byte(mockCSV[:len(mockCSV)/2]))
+		w.(http.Flusher).Flush()
+		panic(http.ErrAbortHandler)
+	}
+
+	fileServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests[r.URL.Path]++
+		reqNumber := requests[r.URL.Path]
+		ranges[r.URL.Path] = append(ranges[r.URL.Path], r.Header.Get("Range"))
+		mu.Unlock()
+
+		switch r.URL.Path {
+		case "/flaky.csv":
+			// 500, aborted body, resumed
+			switch reqNumber {
+			case 1:
+				w.WriteHeader(http.StatusInternalServerError)
+			case 2:
+				writeAborted(w, true)
+			default:
+				w.Header().Set("ETag", `"etag"`)
+				http.ServeContent(w, r, "flaky.csv", modTime, strings.NewReader(mockCSV))
+			}
+		case "/no-range.csv":
+			// aborted body, Range is not supported
+			if reqNumber == 1 {
+				writeAborted(w, false)
+			}
+			_, _ = w.Write([]byte(mockCSV))
+		case "/down.csv":
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer fileServer.Close()
+
+	readAll := func(entryName string, reader io.Reader) error {
+		_, err := ioutil.ReadAll(reader)
+		return err
+	}
+
+	// check Download: retried and resumed
+	{
+		csvFile, err := targetSvc.Download(ctx, fileServer.URL+"/flaky.csv")
+		require.NoError(t, err)
+		defer os.Remove(csvFile.Path)
+
+		data, err := ioutil.ReadFile(csvFile.Path)
+		require.NoError(t, err)
+		require.Equal(t, mockCSV, string(data))
+		require.EqualValues(t, len(mockCSV), csvFile.Source.Size)
+
+		hasher := newContentHasher()
+		_, _ = hasher.Write([]byte(mockCSV))
+		require.Equal(t, hasher.Hash(), csvFile.Source.ContentHash)
+
+		require.Equal(t, 3, requests["/flaky.csv"])
+		require.Equal(t, fmt.Sprintf("bytes=%d-", len(mockCSV)/2), ranges["/flaky.csv"][2])
+	}
+
+	// check Download: restarted as Range is not supported
+	{
+		csvFile, err := targetSvc.Download(ctx, fileServer.URL+"/no-range.csv")
+		require.NoError(t, err)
+		defer os.Remove(csvFile.Path)
+
+		data, err := ioutil.ReadFile(csvFile.Path)
+		require.NoError(t, err)
+		require.Equal(t, mockCSV, string(data))
+		require.EqualValues(t, len(mockCSV), csvFile.Source.Size)
+		require.Empty(t, ranges["/no-range.csv"][1])
+	}
+
+	// check Download: attempts limit reached (attempts history is included)
+	{
+		_, err := targetSvc.Download(ctx, fileServer.URL+"/down.csv")
+		require.Error(t, err)
+		require.Equal(t, 3, requests["/down.csv"])
+		require.Contains(t, err.Error(), "attempts limit reached")
+		require.Contains(t, err.Error(), "#3: offset 0, status 503")
+	}
+
+	// check Download: not retryable
+	{
+		_, err := targetSvc.Download(ctx, fileServer.URL+"/non-existing.csv")
+		require.True(t, errors.Is(err, common.ErrNotFound))
+		require.Equal(t, 1, requests["/non-existing.csv"])
+	}
+
+	// check Stream: resumed
+	{
+		requests["/flaky.csv"] = 0
+		_, err := targetSvc.Stream(ctx, fileServer.URL+"/flaky.csv", readAll)
+		require.NoError(t, err)
+		require.Equal(t, 3, requests["/flaky.csv"])
+	}
+
+	// check Stream: can't be restarted
+	{
+		requests["/no-range.csv"] = 0
+		_, err := targetSvc.Stream(ctx, fileServer.URL+"/no-range.csv", readAll)
+		require.Error(t, err)
 	}
 }
 
@@ -64,7 +473,10 @@ func (s *ServiceTestSuite) TestService_CSVProcessor_Process() {
 
 	reader := strings.NewReader(mockCSV)
 	timestamp := time.Now()
-	chunkSize := 3
+	params := model.CSVProcessParams{
+		ChunkSize: 3,
+		Dialect:   model.NewDefaultCSVDialect(),
+	}
 
 	// mockChunkWorker save import for later check
 	processedImports := make([]model.CSVImport, 0)
@@ -75,39 +487,346 @@ func (s *ServiceTestSuite) TestService_CSVProcessor_Process() {
 
 	// check Process: nil reader
 	{
-		err := targetSvc.Process(ctx, nil, timestamp, chunkSize, mockChunkWorker)
+		err := targetSvc.Process(ctx, nil, timestamp, params, mockChunkWorker, nil)
 		require.Error(t, err)
 	}
 
 	// check Process: nil worker
 	{
-		err := targetSvc.Process(ctx, reader, timestamp, chunkSize, nil)
+		err := targetSvc.Process(ctx, reader, timestamp, params, nil, nil)
 		require.Error(t, err)
 	}
 
 	// check Process: invalid chunk size
 	{
-		err := targetSvc.Process(ctx, reader, timestamp, 0, mockChunkWorker)
+		err := targetSvc.Process(ctx, reader, timestamp, model.CSVProcessParams{ChunkSize: 0, Dialect: params.Dialect}, mockChunkWorker, nil)
 		require.Error(t, err)
 	}
 
+	// check Process: invalid dialect
+	{
+		err := targetSvc.Process(ctx, reader, timestamp, model.CSVProcessParams{ChunkSize: 3}, mockChunkWorker, nil)
+		require.Error(t, err)
+	}
+
+	// mockChunkReporter saves chunk results for later check
+	reportedResults := make([]model.ImportChunkResult, 0)
+	mockChunkReporter := func(result model.ImportChunkResult) {
+		reportedResults = append(reportedResults, result)
+	}
+
 	// check Process: ok
 	{
-		err := targetSvc.Process(ctx, reader, timestamp, chunkSize, mockChunkWorker)
+		err := targetSvc.Process(ctx, reader, timestamp, params, mockChunkWorker, mockChunkReporter)
 		require.NoError(t, err)
 
+		// check reported results
+		require.Len(t, reportedResults, 4)
+		for i, result := range reportedResults {
+			require.Equal(t, i+1, result.ChunkID)
+			require.False(t, result.IsFailed())
+		}
+
 		// check imports
-		totalEntries := 0
+		totalEntries, entryRows := 0, make(map[int]bool)
 		require.Len(t, processedImports, 4)
 		for _, processedImport := range processedImports {
 			require.True(t, processedImport.Timestamp.Equal(timestamp))
 			require.NotEmpty(t, processedImport.Entries)
 			for _, entry := range processedImport.Entries {
 				require.NotEmpty(t, entry.ProductName)
-				require.Greater(t, entry.Price, 0)
+				require.Equal(t, 1, entry.Price.Cmp(model.MustParseMoney("0", "USD")))
+				require.Equal(t, "USD", entry.Price.Currency)
+				require.Greater(t, entry.Row, 0)
+				entryRows[entry.Row] = true
 				totalEntries++
 			}
 		}
 		require.Equal(t, totalEntries, 10)
+		require.Len(t, entryRows, totalEntries)
 	}
 }
+
+func (s *ServiceTestSuite) TestService_CSVProcessor_ProcessPipeline() {
+	t := s.T()
+	ctx := context.Background()
+
+	service, err := NewService()
+	require.NoError(t, err)
+	targetSvc := service.CSVProcessor()
+
+	timestamp := time.Now()
+	params := model.CSVProcessParams{
+		ChunkSize: 1,
+		Workers:   4,
+		Dialect:   model.NewDefaultCSVDialect(),
+	}
+
+	// check Process: invalid workers
+	{
+		err := targetSvc.Process(ctx, strings.NewReader(mockCSV), timestamp, model.CSVProcessParams{ChunkSize: 1, Workers: -1, Dialect: params.Dialect}, nil, nil)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check Process: concurrent execution, ordered results and errors
+	{
+		// earlier chunks are executed longer, chunks 2 and 5 fail
+		mu := sync.Mutex{}
+		calls, curWorkers, maxWorkers := 0, 0, 0
+		chunkWorker := func(ctx context.Context, csvImport model.CSVImport) error {
+			mu.Lock()
+			calls++
+			delay := time.Duration(20-calls) * time.Millisecond
+			curWorkers++
+			if curWorkers > maxWorkers {
+				maxWorkers = curWorkers
+			}
+			mu.Unlock()
+			defer func() {
+				mu.Lock()
+				curWorkers--
+				mu.Unlock()
+			}()
+
+			entry := csvImport.Entries[0]
+			time.Sleep(delay)
+			if entry.ProductName == "Product_2" && entry.Price.Cmp(model.MustParseMoney("2", "USD")) <= 0 {
+				return fmt.Errorf("%s failed", entry.Price)
+			}
+
+			return nil
+		}
+
+		reportedIDs := make([]int, 0)
+		chunkReporter := func(result model.ImportChunkResult) {
+			reportedIDs = append(reportedIDs, result.ChunkID)
+		}
+
+		err := targetSvc.Process(ctx, strings.NewReader(mockCSV), timestamp, params, chunkWorker, chunkReporter)
+		require.Error(t, err)
+		require.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, reportedIDs)
+		require.Greater(t, maxWorkers, 1)
+		require.LessOrEqual(t, maxWorkers, params.Workers)
+
+		errStr := err.Error()
+		require.Less(t, strings.Index(errStr, "chunkID: 2;"), strings.Index(errStr, "chunkID: 5;"))
+		require.NotContains(t, errStr, "chunkID: 1;")
+	}
+
+	// check Process: a slow chunk limits the number of chunks executed ahead of it (in-flight semaphore)
+	{
+		limitParams := params
+		limitParams.Workers = 2
+
+		mu := sync.Mutex{}
+		maxRowAhead := 0
+		chunkWorker := func(ctx context.Context, csvImport model.CSVImport) error {
+			// ChunkSize is 1 and there is no header: the row number is the chunk ID
+			row := csvImport.Entries[0].Row
+			if row == 1 {
+				time.Sleep(100 * time.Millisecond)
+				return nil
+			}
+
+			mu.Lock()
+			if row > maxRowAhead {
+				maxRowAhead = row
+			}
+			mu.Unlock()
+
+			return nil
+		}
+
+		reportedIDs := make([]int, 0)
+		chunkReporter := func(result model.ImportChunkResult) {
+			if result.ChunkID == 1 {
+				mu.Lock()
+				require.LessOrEqual(t, maxRowAhead, 2*limitParams.Workers)
+				mu.Unlock()
+			}
+			reportedIDs = append(reportedIDs, result.ChunkID)
+		}
+
+		err := targetSvc.Process(ctx, strings.NewReader(mockCSV), timestamp, limitParams, chunkWorker, chunkReporter)
+		require.NoError(t, err)
+		require.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, reportedIDs)
+	}
+
+	// check Process: fail fast stops on the first failed chunk
+	{
+		chunkWorker := func(ctx context.Context, csvImport model.CSVImport) error {
+			if csvImport.Entries[0].ProductName == "Product_2" {
+				return fmt.Errorf("failed")
+			}
+			return nil
+		}
+
+		reportedIDs := make([]int, 0)
+		chunkReporter := func(result model.ImportChunkResult) {
+			reportedIDs = append(reportedIDs, result.ChunkID)
+		}
+
+		failFastParams := params
+		failFastParams.FailFast = true
+		err := targetSvc.Process(ctx, strings.NewReader(mockCSV), timestamp, failFastParams, chunkWorker, chunkReporter)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "stopped on the failed chunk")
+		require.Contains(t, err.Error(), "chunkID: 2;")
+		require.Equal(t, []int{1, 2}, reportedIDs)
+	}
+
+	// check Process: cancellation stops all stages
+	{
+		cancelCtx, cancel := context.WithCancel(ctx)
+		chunkWorker := func(ctx context.Context, csvImport model.CSVImport) error {
+			cancel()
+			<-ctx.Done()
+			return ctx.Err()
+		}
+
+		done := make(chan error)
+		go func() {
+			done <- targetSvc.Process(cancelCtx, strings.NewReader(mockCSV), timestamp, params, chunkWorker, nil)
+		}()
+
+		select {
+		case err := <-done:
+			require.True(t, errors.Is(err, context.Canceled))
+		case <-time.After(5 * time.Second):
+			t.Fatal("Process hasn't stopped on cancel")
+		}
+	}
+}
+
+func (s *ServiceTestSuite) TestService_CSVProcessor_ProcessDialect() {
+	t := s.T()
+	ctx := context.Background()
+
+	service, err := NewService()
+	require.NoError(t, err)
+	targetSvc := service.CSVProcessor()
+
+	timestamp := time.Now()
+
+	// process runs Process collecting entries and parsing errors
+	process := func(data string, dialect model.CSVDialect) ([]model.CSVEntry, []string, error) {
+		entries, parsingErrs := make([]model.CSVEntry, 0), make([]string, 0)
+		chunkWorker := func(ctx context.Context, csvImport model.CSVImport) error {
+			entries = append(entries, csvImport.Entries...)
+			return nil
+		}
+		chunkReporter := func(result model.ImportChunkResult) {
+			if result.IsFailed() {
+				parsingErrs = append(parsingErrs, result.Error.ParsingErrors...)
+			}
+		}
+
+		err := targetSvc.Process(ctx, strings.NewReader(data), timestamp, model.CSVProcessParams{ChunkSize: 2, Dialect: dialect}, chunkWorker, chunkReporter)
+
+		return entries, parsingErrs, err
+	}
+
+	// check Process: header with named columns, comments, custom delimiter and quote
+	{
+		dialect := model.CSVDialect{
+			Delimiter:        ',',
+			Quote:            '\'',
+			Comment:          '#',
+			HasHeader:        true,
+			ProductColumn:    "Name",
+			PriceColumn:      "price",
+			IgnoreColumns:    []string{"sku"},
+			DecimalSeparator: '.',
+			Currency:         "USD",
+		}
+		data := "sku,price,name\n# comment\n1,10,'Product, 1'\n2,20,\"Product_2\"\n3,30,Product_3\n"
+
+		entries, parsingErrs, err := process(data, dialect)
+		require.NoError(t, err)
+		require.Empty(t, parsingErrs)
+		require.Len(t, entries, 3)
+		require.Equal(t, "Product, 1", entries[0].ProductName)
+		require.Equal(t, "10", entries[0].Price.Amount.String())
+		require.Equal(t, `"Product_2"`, entries[1].ProductName)
+		require.Equal(t, "30", entries[2].Price.Amount.String())
+	}
+
+	// check Process: no header with index columns and unexpected row length
+	{
+		dialect := model.NewDefaultCSVDialect()
+		dialect.ProductColumn, dialect.PriceColumn = "1", "2"
+		dialect.IgnoreColumns = []string{"0"}
+		data := "1;Product_1;10\n2;Product_2\n3;Product_3;30;extra\n"
+
+		entries, parsingErrs, err := process(data, dialect)
+		require.Error(t, err)
+		require.Len(t, entries, 1)
+		require.Len(t, parsingErrs, 2)
+	}
+
+	// check Process: all unmapped columns are ignored
+	{
+		dialect := model.NewDefaultCSVDialect()
+		dialect.IgnoreColumns = []string{model.CSVColumnIgnoreAll}
+		data := "Product_1;10;a\nProduct_2;20\n"
+
+		entries, parsingErrs, err := process(data, dialect)
+		require.NoError(t, err)
+		require.Empty(t, parsingErrs)
+		require.Len(t, entries, 2)
+	}
+
+	// check Process: decimal prices with currency column and comma decimal separator
+	{
+		dialect := model.NewDefaultCSVDialect()
+		dialect.DecimalSeparator = ','
+		dialect.Currency = "EUR"
+		dialect.CurrencyColumn = "2"
+		data := "Product_1;12,99;usd\nProduct_2; 0,5 ;\nProduct_3;1.5;USD\nProduct_4;10;US\n"
+
+		entries, parsingErrs, err := process(data, dialect)
+		require.Error(t, err)
+		require.Len(t, parsingErrs, 2)
+		require.Len(t, entries, 2)
+		require.Equal(t, "12.99", entries[0].Price.Amount.String())
+		require.Equal(t, "USD", entries[0].Price.Currency)
+		require.Equal(t, "0.5", entries[1].Price.Amount.String())
+		require.Equal(t, "EUR", entries[1].Price.Currency)
+	}
+
+	// check Process: header column is neither mapped nor ignored
+	{
+		dialect := model.NewDefaultCSVDialect()
+		dialect.HasHeader = true
+		dialect.ProductColumn, dialect.PriceColumn = "name", "price"
+
+		_, _, err := process("name;price;sku\nProduct_1;10;1\n", dialect)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check Process: named columns without header
+	{
+		dialect := model.NewDefaultCSVDialect()
+		dialect.ProductColumn = "name"
+
+		_, _, err := process("Product_1;10\n", dialect)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+}
+
+// newMockZipArchive builds a zip archive with {name, content} files.
+func newMockZipArchive(t *testing.T, files [][2]string) []byte {
+	buf := &bytes.Buffer{}
+	writer := zip.NewWriter(buf)
+	for _, file := range files {
+		fileWriter, err := writer.Create(file[0])
+		require.NoError(t, err)
+		_, err = fileWriter.Write([]byte(file[1]))
+		require.NoError(t, err)
+	}
+	require.NoError(t, writer.Close())
+
+	return buf.Bytes()
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+	"github.com/itiky/mdb-tutorial/pkg/model"
+	"github.com/itiky/mdb-tutorial/pkg/storage"
+)
+
+var _ ImportDiffService = (*importDiffService)(nil)
+
+// importDiffService keeps ImportDiffService dependencies.
+type importDiffService struct {
+	storage storage.Storage
+	logger  *logrus.Logger
+}
+
+// importPrices keeps import product prices by currency.
+type importPrices map[primitive.ObjectID]map[string]model.Money
+
+// Diff implements ImportDiffService interface.
+func (s importDiffService) Diff(ctx context.Context, fromTimestamp, toTimestamp time.Time) (model.ImportDiff, error) {
+	// input check
+	if fromTimestamp.IsZero() || toTimestamp.IsZero() {
+		return model.ImportDiff{}, fmt.Errorf("%w: fromTimestamp / toTimestamp: zero", common.ErrInvalidInput)
+	}
+
+	from, err := resolveImportTimestamp(ctx, s.storage, fromTimestamp)
+	if err != nil {
+		return model.ImportDiff{}, err
+	}
+	to, err := resolveImportTimestamp(ctx, s.storage, toTimestamp)
+	if err != nil {
+		return model.ImportDiff{}, err
+	}
+	if from.Equal(to) {
+		return model.ImportDiff{}, fmt.Errorf("%w: fromTimestamp / toTimestamp: equal (%s)", common.ErrInvalidInput, from)
+	}
+
+	// load imports
+	fromPrices, err := s.loadImportPrices(ctx, from)
+	if err != nil {
+		return model.ImportDiff{}, err
+	}
+	toPrices, err := s.loadImportPrices(ctx, to)
+	if err != nil {
+		return model.ImportDiff{}, err
+	}
+
+	productNames, err := s.loadProductNames(ctx, fromPrices, toPrices)
+	if err != nil {
+		return model.ImportDiff{}, err
+	}
+
+	// compare
+	diff := model.ImportDiff{
+		FromTimestamp: from,
+		ToTimestamp:   to,
+	}
+	for productID, toProductPrices := range toPrices {
+		fromProductPrices, found := fromPrices[productID]
+		if !found {
+			diff.NewProducts = append(diff.NewProducts, newProductPrices(productNames[productID], toProductPrices))
+			continue
+		}
+
+		for currency, toPrice := range toProductPrices {
+			fromPrice, found := fromProductPrices[currency]
+			if !found {
+				continue
+			}
+
+			change, err := newPriceChange(productNames[productID], fromPrice, toPrice)
+			if err != nil {
+				return model.ImportDiff{}, fmt.Errorf("product %s: %w", productNames[productID], err)
+			}
+
+			switch toPrice.Cmp(fromPrice) {
+			case 1:
+				diff.Increased = append(diff.Increased, change)
+			case -1:
+				diff.Decreased = append(diff.Decreased, change)
+			default:
+				diff.UnchangedCount++
+			}
+		}
+	}
+	for productID, fromProductPrices := range fromPrices {
+		if _, found := toPrices[productID]; !found {
+			diff.RemovedProducts = append(diff.RemovedProducts, newProductPrices(productNames[productID], fromProductPrices))
+		}
+	}
+
+	// sort for a stable output
+	for _, products := range [][]model.ProductPrices{diff.NewProducts, diff.RemovedProducts} {
+		sort.Slice(products, func(i, j int) bool { return products[i].Name < products[j].Name })
+	}
+	for _, changes := range [][]model.PriceChange{diff.Increased, diff.Decreased} {
+		sort.Slice(changes, func(i, j int) bool {
+			if changes[i].Name != changes[j].Name {
+				return changes[i].Name < changes[j].Name
+			}
+			return changes[i].To.Currency < changes[j].To.Currency
+		})
+	}
+
+	return diff, nil
+}
+
+// loadImportPrices loads the exact timestamp (milliseconds precision) price imports.
+// The highest CSV-file row price of a currency wins (the last one for equal rows).
+func (s importDiffService) loadImportPrices(ctx context.Context, timestamp time.Time) (importPrices, error) {
+	pricesImports, err := s.storage.PriceImport().GetByTimeRange(ctx, timestamp, timestamp.Add(time.Millisecond))
+	if err != nil {
+		return nil, fmt.Errorf("import %s: loading price imports: %w", timestamp, err)
+	}
+	if len(pricesImports) == 0 {
+		return nil, fmt.Errorf("import %s: %w: no price imports found", timestamp, common.ErrNotFound)
+	}
+
+	prices := make(importPrices, len(pricesImports))
+	for _, pricesImport := range pricesImports {
+		productPrices := make(map[string]model.Money, len(pricesImport.Prices))
+		productRows := make(map[string]int, len(pricesImport.Prices))
+		for _, price := range pricesImport.Prices {
+			if row, found := productRows[price.Currency]; found && price.Row < row {
+				continue
+			}
+			productPrices[price.Currency], productRows[price.Currency] = price.Money(), price.Row
+		}
+		prices[pricesImport.ProductID] = productPrices
+	}
+
+	return prices, nil
+}
+
+// loadProductNames loads names of imports products (missing products are named by ID).
+func (s importDiffService) loadProductNames(ctx context.Context, imports ...importPrices) (map[primitive.ObjectID]string, error) {
+	names := make(map[primitive.ObjectID]string)
+	ids := make([]primitive.ObjectID, 0)
+	for _, prices := range imports {
+		for productID := range prices {
+			if _, found := names[productID]; !found {
+				names[productID] = productID.Hex()
+				ids = append(ids, productID)
+			}
+		}
+	}
+
+	products, err := s.storage.Product().GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("loading products: %w", err)
+	}
+	for _, product := range products {
+		names[product.ID] = product.Name
+	}
+
+	return names, nil
+}
+
+// newProductPrices builds ProductPrices with prices sorted by currency.
+func newProductPrices(name string, prices map[string]model.Money) model.ProductPrices {
+	productPrices := model.ProductPrices{
+		Name:   name,
+		Prices: make([]model.Money, 0, len(prices)),
+	}
+	for _, price := range prices {
+		productPrices.Prices = append(productPrices.Prices, price)
+	}
+	sort.Slice(productPrices.Prices, func(i, j int) bool { return productPrices.Prices[i].Currency < productPrices.Prices[j].Currency })
+
+	return productPrices
+}
+
+// newPriceChange builds PriceChange calculating the absolute and percentage delta.
+func newPriceChange(name string, from, to model.Money) (model.PriceChange, error) {
+	delta, err := to.Sub(from)
+	if err != nil {
+		return model.PriceChange{}, err
+	}
+	deltaPercent, _ := delta.PercentOf(from)
+
+	return model.PriceChange{
+		Name:         name,
+		From:         from,
+		To:           to,
+		Delta:        delta,
+		DeltaPercent: deltaPercent,
+	}, nil
+}
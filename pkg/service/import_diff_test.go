@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+	"github.com/itiky/mdb-tutorial/pkg/model"
+	"github.com/itiky/mdb-tutorial/pkg/storage"
+	"github.com/itiky/mdb-tutorial/pkg/testutils"
+	"github.com/itiky/mdb-tutorial/pkg/testutils/fixtures"
+)
+
+func (s *ServiceTestSuite) TestService_ImportDiff() {
+	t := s.T()
+	ctx := context.Background()
+
+	client := testutils.PrepareMongoDBFixtures(ctx, s.r, fixtures.NewEmptyMongoDBFixtures())
+	svcStorage, err := storage.NewStorage(
+		storage.WithDatabase(testutils.TestMongoDBDatabase),
+		storage.WithMongoDBClient(client),
+	)
+	require.NoError(t, err)
+
+	service, err := NewService(
+		WithStorage(svcStorage),
+	)
+	require.NoError(t, err)
+	targetSvc := service.ImportDiff()
+
+	timestamp1 := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	timestamp2 := timestamp1.Add(time.Hour)
+	// archive entries imports within the same second
+	timestamp3 := timestamp2.Add(time.Hour + 250*time.Millisecond)
+	timestamp3Entry2 := timestamp3.Add(time.Millisecond)
+
+	productIDs, err := svcStorage.Product().BulkUpsertByNames(ctx, []string{"A", "B", "C", "D", "E", "F"})
+	require.NoError(t, err)
+
+	usd := func(amount string) model.Price { return model.NewPrice(model.MustParseMoney(amount, "USD")) }
+	eur := func(amount string) model.Price { return model.NewPrice(model.MustParseMoney(amount, "EUR")) }
+
+	// A: increase, B: decrease (the last USD price is compared), C: unchanged, D: removed, E: new
+	_, err = svcStorage.PriceImport().BulkUpsertByProductIDAndTimestamp(ctx, []model.PricesImport{
+		{ProductID: productIDs["A"], Timestamp: timestamp1, Prices: []model.Price{usd("10")}},
+		{ProductID: productIDs["B"], Timestamp: timestamp1, Prices: []model.Price{usd("5"), usd("7")}},
+		{ProductID: productIDs["C"], Timestamp: timestamp1, Prices: []model.Price{usd("3"), eur("2")}},
+		{ProductID: productIDs["D"], Timestamp: timestamp1, Prices: []model.Price{eur("1")}},
+		{ProductID: productIDs["A"], Timestamp: timestamp2, Prices: []model.Price{usd("12.00")}},
+		{ProductID: productIDs["B"], Timestamp: timestamp2, Prices: []model.Price{usd("6")}},
+		{ProductID: productIDs["C"], Timestamp: timestamp2, Prices: []model.Price{usd("3.0")}},
+		{ProductID: productIDs["E"], Timestamp: timestamp2, Prices: []model.Price{usd("9")}},
+		{ProductID: productIDs["F"], Timestamp: timestamp3, Prices: []model.Price{usd("1")}},
+		{ProductID: productIDs["F"], Timestamp: timestamp3Entry2, Prices: []model.Price{usd("2")}},
+	})
+	require.NoError(t, err)
+
+	// check Diff: invalid input
+	{
+		_, err := targetSvc.Diff(ctx, time.Time{}, timestamp2)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSvc.Diff(ctx, timestamp1, timestamp1.Add(500*time.Microsecond))
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		// ambiguous: many imports within the second
+		_, err = targetSvc.Diff(ctx, timestamp2, timestamp3.Truncate(time.Second))
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check Diff: non-existing import
+	{
+		_, err := targetSvc.Diff(ctx, timestamp1, timestamp2.Add(time.Second))
+		require.True(t, errors.Is(err, common.ErrNotFound))
+
+		// imports are matched by the exact timestamp
+		_, err = targetSvc.Diff(ctx, timestamp1, timestamp2.Add(100*time.Millisecond))
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+
+	// check Diff: ok
+	{
+		diff, err := targetSvc.Diff(ctx, timestamp1, timestamp2)
+		require.NoError(t, err)
+		require.True(t, timestamp1.Equal(diff.FromTimestamp))
+		require.True(t, timestamp2.Equal(diff.ToTimestamp))
+
+		require.Len(t, diff.NewProducts, 1)
+		require.Equal(t, "E", diff.NewProducts[0].Name)
+		require.Len(t, diff.NewProducts[0].Prices, 1)
+
+		require.Len(t, diff.RemovedProducts, 1)
+		require.Equal(t, "D", diff.RemovedProducts[0].Name)
+		require.Equal(t, "EUR", diff.RemovedProducts[0].Prices[0].Currency)
+
+		require.Len(t, diff.Increased, 1)
+		require.Equal(t, "A", diff.Increased[0].Name)
+		require.Zero(t, model.MustParseMoney("2", "USD").Cmp(diff.Increased[0].Delta))
+		require.InDelta(t, 20.0, diff.Increased[0].DeltaPercent, 1e-9)
+
+		require.Len(t, diff.Decreased, 1)
+		require.Equal(t, "B", diff.Decreased[0].Name)
+		require.Zero(t, model.MustParseMoney("7", "USD").Cmp(diff.Decreased[0].From))
+		require.Zero(t, model.MustParseMoney("-1", "USD").Cmp(diff.Decreased[0].Delta))
+		require.InDelta(t, -100.0/7, diff.Decreased[0].DeltaPercent, 1e-9)
+
+		// C EUR price is present in the 1st import only
+		require.Equal(t, 1, diff.UnchangedCount)
+	}
+
+	// check Diff: exact timestamps within the same second
+	{
+		diff, err := targetSvc.Diff(ctx, timestamp3, timestamp3Entry2)
+		require.NoError(t, err)
+		require.True(t, timestamp3.Equal(diff.FromTimestamp))
+		require.True(t, timestamp3Entry2.Equal(diff.ToTimestamp))
+		require.Empty(t, diff.NewProducts)
+		require.Empty(t, diff.RemovedProducts)
+		require.Len(t, diff.Increased, 1)
+		require.Equal(t, "F", diff.Increased[0].Name)
+	}
+}
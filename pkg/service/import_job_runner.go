@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// importJobRunner runs background import jobs with a shared base context, so those could be canceled and waited for on shutdown.
+type importJobRunner struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	lock   sync.Mutex
+	wg     sync.WaitGroup
+	closed bool
+}
+
+// Go starts the job func in background.
+// Returns an error if the runner is shut down.
+func (r *importJobRunner) Go(jobFn func(ctx context.Context)) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.closed {
+		return fmt.Errorf("import jobs runner: shut down")
+	}
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		jobFn(r.ctx)
+	}()
+
+	return nil
+}
+
+// Shutdown cancels running jobs and waits for them to finish (or the ctx to be done).
+func (r *importJobRunner) Shutdown(ctx context.Context) error {
+	r.lock.Lock()
+	r.closed = true
+	r.lock.Unlock()
+
+	r.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		r.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("waiting for import jobs: %w", ctx.Err())
+	}
+}
+
+// newImportJobRunner creates a new importJobRunner.
+func newImportJobRunner() *importJobRunner {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &importJobRunner{
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
@@ -0,0 +1,578 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+	"github.com/itiky/mdb-tutorial/pkg/model"
+	"github.com/itiky/mdb-tutorial/pkg/storage"
+)
+
+const (
+	// UploadURLScheme is an import job URL prefix for uploaded files.
+	UploadURLScheme = "upload://"
+	// ImportJobLeaseTTL is a running import job lease duration: the lease (updated_at) is renewed by heartbeats,
+	// so jobs not updated for longer are considered interrupted whatever the owner is (crashed instance).
+	ImportJobLeaseTTL = time.Minute
+	//
+	importJobHeartbeatInterval = 10 * time.Second
+	// Max number of import timestamp reservation attempts (timestamp is shifted by a millisecond per attempt)
+	importTimestampReserveMaxAttempts = 1000
+)
+
+var _ ImportJobsService = (*importJobsService)(nil)
+
+// importJobsService keeps ImportJobsService dependencies.
+type importJobsService struct {
+	storage     storage.Storage
+	logger      *logrus.Logger
+	processor   CSVProcessorService
+	importer    CSVImporterService
+	importMode  model.ImportMode
+	atomicity   model.ImportAtomicity
+	fileMaxRows int
+	owner       string
+	runner      *importJobRunner
+}
+
+// Enqueue implements ImportJobsService interface.
+func (s importJobsService) Enqueue(ctx context.Context, params model.ImportJobParams) (model.ImportJob, error) {
+	// input check
+	if params.URL == "" {
+		return model.ImportJob{}, fmt.Errorf("%w: url: empty", common.ErrInvalidInput)
+	}
+	if err := params.CSVParams.Validate(); err != nil {
+		return model.ImportJob{}, err
+	}
+
+	// register job
+	jobID, err := s.storage.ImportJob().Create(ctx, model.ImportJob{
+		URL:       params.URL,
+		Force:     params.Force,
+		Dialect:   params.CSVParams.Dialect,
+		Atomicity: s.atomicity,
+		Owner:     s.owner,
+		State:     model.ImportJobStateQueued,
+	})
+	if err != nil {
+		return model.ImportJob{}, fmt.Errorf("creating import job: %w", err)
+	}
+
+	job, err := s.storage.ImportJob().GetByID(ctx, jobID.Hex())
+	if err != nil {
+		return model.ImportJob{}, fmt.Errorf("loading created import job: %w", err)
+	}
+	s.logger.Infof("import job %s: queued: %s", job.ID.Hex(), job.URL)
+
+	// request context is canceled once the RPC is done, so the job uses the runner one
+	err = s.runner.Go(func(jobCtx context.Context) {
+		defer s.keepAlive(jobCtx, job)()
+
+		s.run(jobCtx, job, params)
+		if jobCtx.Err() != nil {
+			s.failCanceled(job)
+		}
+	})
+	if err != nil {
+		s.setState(ctx, job, model.ImportJobStateFailed, err)
+		return model.ImportJob{}, fmt.Errorf("starting import job: %w", err)
+	}
+
+	return job, nil
+}
+
+// Get implements ImportJobsService interface.
+func (s importJobsService) Get(ctx context.Context, id string) (model.ImportJob, error) {
+	return s.storage.ImportJob().GetByID(ctx, id)
+}
+
+// List implements ImportJobsService interface.
+func (s importJobsService) List(ctx context.Context, paginationOpt common.PaginationOption) ([]model.ImportJob, error) {
+	return s.storage.ImportJob().GetAll(ctx, paginationOpt)
+}
+
+// FailInterrupted implements ImportJobsService interface.
+func (s importJobsService) FailInterrupted(ctx context.Context) (int64, error) {
+	failed, err := s.storage.ImportJob().FailUnfinished(ctx, s.owner, time.Now().UTC().Add(-ImportJobLeaseTTL), "interrupted by the server restart (or the job lease has expired)")
+	if err != nil {
+		return 0, fmt.Errorf("failing unfinished import jobs: %w", err)
+	}
+	if failed > 0 {
+		s.logger.Warnf("import jobs: %d unfinished jobs of the previous run (or with expired leases) are failed", failed)
+	}
+
+	return failed, nil
+}
+
+// FailExpired implements ImportJobsService interface.
+func (s importJobsService) FailExpired(ctx context.Context) (int64, error) {
+	failed, err := s.storage.ImportJob().FailUnfinished(ctx, "", time.Now().UTC().Add(-ImportJobLeaseTTL), "interrupted: the job lease has expired")
+	if err != nil {
+		return 0, fmt.Errorf("failing expired import jobs: %w", err)
+	}
+	if failed > 0 {
+		s.logger.Warnf("import jobs: %d unfinished jobs with expired leases are failed", failed)
+	}
+
+	return failed, nil
+}
+
+// Shutdown implements ImportJobsService interface.
+func (s importJobsService) Shutdown(ctx context.Context) error {
+	return s.runner.Shutdown(ctx)
+}
+
+// Upload implements ImportJobsService interface.
+func (s importJobsService) Upload(ctx context.Context, fileName string, reader io.Reader, params model.CSVProcessParams, force bool) (model.ImportJob, error) {
+	// input check
+	if reader == nil {
+		return model.ImportJob{}, fmt.Errorf("%w: reader is nil", common.ErrInvalidInput)
+	}
+	if err := params.Validate(); err != nil {
+		return model.ImportJob{}, err
+	}
+	if fileName == "" {
+		fileName = "unnamed.csv"
+	}
+
+	// register job
+	jobStorage := s.storage.ImportJob()
+	jobID, err := jobStorage.Create(ctx, model.ImportJob{
+		URL:       UploadURLScheme + fileName,
+		Dialect:   params.Dialect,
+		Force:     force,
+		Atomicity: s.atomicity,
+		Owner:     s.owner,
+		State:     model.ImportJobStateQueued,
+	})
+	if err != nil {
+		return model.ImportJob{}, fmt.Errorf("creating import job: %w", err)
+	}
+
+	job, err := jobStorage.GetByID(ctx, jobID.Hex())
+	if err != nil {
+		return model.ImportJob{}, fmt.Errorf("loading created import job: %w", err)
+	}
+	s.logger.Infof("import job %s: upload started: %s", job.ID.Hex(), job.URL)
+	s.runUpload(ctx, job, reader, params, force)
+
+	// return the final job state
+	job, err = jobStorage.GetByID(ctx, jobID.Hex())
+	if err != nil {
+		return model.ImportJob{}, fmt.Errorf("loading finished import job: %w", err)
+	}
+
+	return job, nil
+}
+
+// runUpload processes the uploaded CSV-file stream calculating the content hash on the fly updating the job state along the way.
+func (s importJobsService) runUpload(ctx context.Context, job model.ImportJob, reader io.Reader, params model.CSVProcessParams, force bool) {
+	defer s.keepAlive(ctx, job)()
+
+	importTimestamp, err := s.reserveImportTimestamp(ctx, job, time.Now())
+	if err != nil {
+		s.setState(ctx, job, model.ImportJobStateFailed, err)
+		return
+	}
+	jobStorage := s.storage.ImportJob()
+	if err := jobStorage.SetImportSource(ctx, job.ID, importTimestamp, model.ImportSource{URL: job.URL}); err != nil {
+		s.logger.Errorf("import job %s: import source update: %v", job.ID.Hex(), err)
+	}
+
+	s.setState(ctx, job, model.ImportJobStateProcessing, nil)
+	hasher := newContentHasher()
+	err = s.importAtomic(ctx, job, params, func(chunkWorker csvChunkWorker, params model.CSVProcessParams) error {
+		return s.process(ctx, job, "", io.TeeReader(reader, hasher), importTimestamp, params, chunkWorker)
+	})
+	if err != nil {
+		s.setState(ctx, job, model.ImportJobStateFailed, fmt.Errorf("processing: %w", err))
+		return
+	}
+
+	source := model.ImportSource{
+		URL:         job.URL,
+		Size:        hasher.Size(),
+		ContentHash: hasher.Hash(),
+	}
+	if err := jobStorage.SetImportSource(ctx, job.ID, importTimestamp, source); err != nil {
+		s.logger.Errorf("import job %s: import source update: %v", job.ID.Hex(), err)
+	}
+	if s.claimProcessed(ctx, job, model.ImportLedgerEntry{Source: source, Timestamp: importTimestamp, JobID: job.ID, Forced: force}) {
+		s.finish(ctx, job, importTimestamp)
+	}
+}
+
+// run downloads and processes CSV-file updating the job state along the way.
+func (s importJobsService) run(ctx context.Context, job model.ImportJob, params model.ImportJobParams) {
+	if s.importMode == model.ImportModeStream {
+		s.runStream(ctx, job, params)
+		return
+	}
+
+	// download file
+	s.setState(ctx, job, model.ImportJobStateDownloading, nil)
+	csvFile, err := s.processor.Download(ctx, job.URL)
+	if err != nil {
+		s.setState(ctx, job, model.ImportJobStateFailed, fmt.Errorf("downloading: %w", err))
+		return
+	}
+	defer func() {
+		os.Remove(csvFile.Path)
+	}()
+
+	csvFile.Timestamp, err = s.reserveImportTimestamp(ctx, job, csvFile.Timestamp)
+	if err != nil {
+		s.setState(ctx, job, model.ImportJobStateFailed, err)
+		return
+	}
+	if err := s.storage.ImportJob().SetImportSource(ctx, job.ID, csvFile.Timestamp, csvFile.Source); err != nil {
+		s.logger.Errorf("import job %s: import source update: %v", job.ID.Hex(), err)
+	}
+
+	// claim the content, so the already imported (or being imported concurrently) one is skipped
+	claimID, err := s.importer.ClaimSource(ctx, model.ImportLedgerEntry{
+		Source:    csvFile.Source,
+		Timestamp: csvFile.Timestamp,
+		JobID:     job.ID,
+		Forced:    params.Force,
+		Pending:   true,
+	})
+	if err != nil {
+		if errors.Is(err, common.ErrAlreadyExists) {
+			s.setState(ctx, job, model.ImportJobStateSkipped, err)
+			return
+		}
+		s.setState(ctx, job, model.ImportJobStateFailed, fmt.Errorf("claiming source: %w", err))
+		return
+	}
+
+	s.setState(ctx, job, model.ImportJobStateProcessing, nil)
+	err = s.importAtomic(ctx, job, params.CSVParams, func(chunkWorker csvChunkWorker, csvParams model.CSVProcessParams) error {
+		return s.processEntries(ctx, job, csvParams, csvFile.Timestamp, chunkWorker, func(entryHandler csvEntryHandler) error {
+			return s.processor.Extract(csvFile, entryHandler)
+		})
+	})
+	if err != nil {
+		// claim of the job canceled by shutdown is released by the next import of the content
+		if releaseErr := s.importer.ReleaseSource(ctx, claimID); releaseErr != nil {
+			s.logger.Errorf("import job %s: %v", job.ID.Hex(), releaseErr)
+		}
+		s.setState(ctx, job, model.ImportJobStateFailed, err)
+		return
+	}
+
+	if err := s.importer.CompleteSource(ctx, claimID); err != nil {
+		s.logger.Errorf("import job %s: %v", job.ID.Hex(), err)
+	}
+	s.finish(ctx, job, csvFile.Timestamp)
+}
+
+// runStream processes CSV-file while it is being downloaded updating the job state along the way.
+// Content hash is known only once the file is processed, so the already imported content is rolled back afterwards.
+func (s importJobsService) runStream(ctx context.Context, job model.ImportJob, params model.ImportJobParams) {
+	importTimestamp, err := s.reserveImportTimestamp(ctx, job, time.Now())
+	if err != nil {
+		s.setState(ctx, job, model.ImportJobStateFailed, err)
+		return
+	}
+	jobStorage := s.storage.ImportJob()
+	if err := jobStorage.SetImportSource(ctx, job.ID, importTimestamp, model.ImportSource{URL: job.URL}); err != nil {
+		s.logger.Errorf("import job %s: import source update: %v", job.ID.Hex(), err)
+	}
+
+	s.setState(ctx, job, model.ImportJobStateProcessing, nil)
+	var source model.ImportSource
+	err = s.importAtomic(ctx, job, params.CSVParams, func(chunkWorker csvChunkWorker, csvParams model.CSVProcessParams) error {
+		return s.processEntries(ctx, job, csvParams, importTimestamp, chunkWorker, func(entryHandler csvEntryHandler) error {
+			var err error
+			source, err = s.processor.Stream(ctx, job.URL, entryHandler)
+			return err
+		})
+	})
+	if err != nil {
+		s.setState(ctx, job, model.ImportJobStateFailed, err)
+		return
+	}
+
+	if err := jobStorage.SetImportSource(ctx, job.ID, importTimestamp, source); err != nil {
+		s.logger.Errorf("import job %s: import source update: %v", job.ID.Hex(), err)
+	}
+	if s.claimProcessed(ctx, job, model.ImportLedgerEntry{Source: source, Timestamp: importTimestamp, JobID: job.ID, Forced: params.Force}) {
+		s.finish(ctx, job, importTimestamp)
+	}
+}
+
+// processEntries processes every CSV-file provided by the extract func (archives could contain multiple ones)
+// with its own import timestamp (the first entry uses the job reserved importTimestamp, the next ones reserve the following free ones,
+// timestamps are recorded to the job archive entries), so archive entries prices for the same product are kept separately
+// and every entry is matched by its exact timestamp.
+// The rest entries are skipped on the first failed one if params.FailFast is set.
+// Returns accumulated entries errors.
+func (s importJobsService) processEntries(ctx context.Context, job model.ImportJob, params model.CSVProcessParams, importTimestamp time.Time,
+	chunkWorker csvChunkWorker, extract func(entryHandler csvEntryHandler) error,
+) error {
+
+	entryIdx, entryErrs := 0, make([]string, 0)
+	entryTimestamp := importTimestamp
+	extractErr := extract(func(entryName string, reader io.Reader) error {
+		if params.FailFast && len(entryErrs) > 0 {
+			return nil
+		}
+
+		if entryIdx > 0 {
+			timestamp, err := s.reserveImportTimestamp(ctx, job, entryTimestamp.Add(time.Millisecond))
+			if err != nil {
+				entryErrs = append(entryErrs, fmt.Sprintf("%s: %v", entryName, err))
+				return nil
+			}
+			entryTimestamp = timestamp
+		}
+		entryIdx++
+
+		if entryName != "" {
+			archiveEntry := model.ImportArchiveEntry{Name: entryName, ImportTimestamp: entryTimestamp}
+			if err := s.storage.ImportJob().AddArchiveEntry(ctx, job.ID, archiveEntry); err != nil {
+				s.logger.Errorf("import job %s: archive entry update: %v", job.ID.Hex(), err)
+			}
+		}
+
+		if err := s.process(ctx, job, entryName, reader, entryTimestamp, params, chunkWorker); err != nil {
+			if entryName != "" {
+				err = fmt.Errorf("%s: %w", entryName, err)
+			}
+			entryErrs = append(entryErrs, err.Error())
+		}
+
+		return nil
+	})
+	if extractErr != nil {
+		entryErrs = append(entryErrs, fmt.Sprintf("reading source: %v", extractErr))
+	}
+	if len(entryErrs) > 0 {
+		return fmt.Errorf("processing: %s", strings.Join(entryErrs, "; "))
+	}
+
+	return nil
+}
+
+// process parses and imports CSV-data with the chunkWorker reporting progress per chunk.
+// Chunk errors are marked with the archive entryName (if any).
+func (s importJobsService) process(ctx context.Context, job model.ImportJob, entryName string, reader io.Reader, importTimestamp time.Time,
+	params model.CSVProcessParams, chunkWorker csvChunkWorker,
+) error {
+
+	chunkReporter := func(result model.ImportChunkResult) {
+		if result.Error != nil {
+			result.Error.ArchiveEntry = entryName
+		}
+		if err := s.storage.ImportJob().AddChunkResult(ctx, job.ID, result); err != nil {
+			s.logger.Errorf("import job %s: chunk %d result update: %v", job.ID.Hex(), result.ChunkID, err)
+		}
+	}
+
+	return s.processor.Process(ctx, reader, importTimestamp, params, chunkWorker, chunkReporter)
+}
+
+// importAtomic runs the import func with the CSVImporter service chunk worker and processing params configured for the atomicity mode.
+// Job progress is reported out of transactions, so it is visible while the import is running.
+func (s importJobsService) importAtomic(ctx context.Context, job model.ImportJob, params model.CSVProcessParams,
+	importFn func(chunkWorker csvChunkWorker, params model.CSVProcessParams) error,
+) error {
+
+	switch s.atomicity {
+	case model.ImportAtomicityChunk:
+		// concurrent chunk transactions updating the same products / price imports fail with write conflicts
+		if params.GetWorkers() > 1 {
+			s.logger.Warnf("import job %s: chunk workers are limited to 1 for the chunk atomicity", job.ID.Hex())
+		}
+		params.Workers = 1
+
+		return importFn(func(chunkCtx context.Context, csvImport model.CSVImport) error {
+			return s.storage.WithTransaction(chunkCtx, func(txCtx context.Context) error {
+				return s.importer.ImportPrices(txCtx, csvImport)
+			})
+		}, params)
+	case model.ImportAtomicityFile:
+		// transaction operations can't be run concurrently
+		if params.GetWorkers() > 1 {
+			s.logger.Warnf("import job %s: chunk workers are limited to 1 for the file atomicity", job.ID.Hex())
+		}
+		// a single failed chunk aborts the whole transaction (all archive entries included)
+		if !params.FailFast {
+			s.logger.Warnf("import job %s: fail fast is enabled for the file atomicity, the whole file (all archive entries) is imported within a single transaction", job.ID.Hex())
+		}
+		params.Workers, params.FailFast = 1, true
+
+		tx, err := s.storage.StartTransaction(ctx)
+		if err != nil {
+			return fmt.Errorf("file transaction: %w", err)
+		}
+
+		// transaction lifetime is limited: large files are failed instead of being aborted by the server at the commit
+		// (chunks are executed by a single worker)
+		rowsImported := 0
+		err = importFn(func(chunkCtx context.Context, csvImport model.CSVImport) error {
+			rowsImported += len(csvImport.Entries)
+			if rowsImported > s.fileMaxRows {
+				return fmt.Errorf("file atomicity max rows limit (%d) exceeded: use the chunk atomicity for large files", s.fileMaxRows)
+			}
+
+			return s.importer.ImportPrices(tx.Context(chunkCtx), csvImport)
+		}, params)
+		if err != nil {
+			if abortErr := tx.Abort(ctx); abortErr != nil {
+				s.logger.Errorf("import job %s: file transaction: %v", job.ID.Hex(), abortErr)
+			}
+			return fmt.Errorf("%w (file transaction aborted, nothing is imported)", err)
+		}
+
+		if err := tx.Commit(ctx); err != nil {
+			return fmt.Errorf("file transaction: %w (nothing is imported)", err)
+		}
+
+		return nil
+	default:
+		return importFn(s.importer.ImportPrices, params)
+	}
+}
+
+// claimProcessed records the processed source to the import ledger (content hash is known only once the file is read).
+// If the same content has already been imported (or is being imported), the job prices are rolled back and the job is skipped.
+// Returns false if the job has been finished.
+func (s importJobsService) claimProcessed(ctx context.Context, job model.ImportJob, entry model.ImportLedgerEntry) bool {
+	_, err := s.importer.ClaimSource(ctx, entry)
+	if err == nil {
+		return true
+	}
+	if !errors.Is(err, common.ErrAlreadyExists) {
+		s.logger.Errorf("import job %s: %v", job.ID.Hex(), err)
+		return true
+	}
+
+	if rollbackErr := s.rollbackDuplicate(ctx, job, entry.Timestamp); rollbackErr != nil {
+		s.setState(ctx, job, model.ImportJobStateFailed, fmt.Errorf("%v: rolling back the duplicate import: %w", err, rollbackErr))
+		return false
+	}
+	s.setState(ctx, job, model.ImportJobStateSkipped, fmt.Errorf("%v (imported prices are rolled back)", err))
+
+	return false
+}
+
+// rollbackDuplicate deletes the job price imports (products are kept as those are referenced by the original import).
+func (s importJobsService) rollbackDuplicate(ctx context.Context, job model.ImportJob, importTimestamp time.Time) error {
+	curJob, err := s.storage.ImportJob().GetByID(ctx, job.ID.Hex())
+	if err != nil {
+		return fmt.Errorf("loading import job: %w", err)
+	}
+	curJob.ImportTimestamp = importTimestamp
+
+	// stored DateTimes have milliseconds precision
+	for _, timestamp := range curJob.ImportTimestamps() {
+		from := timestamp.Truncate(time.Millisecond)
+		if _, _, err := s.storage.PriceImport().DeleteByTimeRange(ctx, from, from.Add(time.Millisecond)); err != nil {
+			return fmt.Errorf("deleting price imports %s: %w", from, err)
+		}
+	}
+
+	return nil
+}
+
+// reserveImportTimestamp reserves a unique import timestamp for the job starting from the from one (truncated to milliseconds).
+// Timestamp is shifted by a millisecond while it is reserved by another import, so prices of different imports are never merged.
+func (s importJobsService) reserveImportTimestamp(ctx context.Context, job model.ImportJob, from time.Time) (time.Time, error) {
+	timestamp := from.UTC().Truncate(time.Millisecond)
+	for attempt := 0; attempt < importTimestampReserveMaxAttempts; attempt++ {
+		err := s.storage.ImportTimestamp().Reserve(ctx, model.ImportTimestamp{Timestamp: timestamp, JobID: job.ID})
+		if err == nil {
+			return timestamp, nil
+		}
+		if !errors.Is(err, common.ErrAlreadyExists) {
+			return time.Time{}, fmt.Errorf("reserving import timestamp: %w", err)
+		}
+		timestamp = timestamp.Add(time.Millisecond)
+	}
+
+	return time.Time{}, fmt.Errorf("reserving import timestamp: no free timestamp found within %d attempts", importTimestampReserveMaxAttempts)
+}
+
+// finish evaluates price alerts of the imported (and recorded to the import ledger) prices and sets the job final state.
+// Only fully processed files are recorded, so failed imports could be retried.
+func (s importJobsService) finish(ctx context.Context, job model.ImportJob, importTimestamp time.Time) {
+	if _, err := s.importer.EvaluateAlerts(ctx, importTimestamp); err != nil {
+		s.logger.Errorf("import job %s: %v", job.ID.Hex(), err)
+	}
+
+	s.setState(ctx, job, model.ImportJobStateDone, nil)
+}
+
+// keepAlive renews the job lease every heartbeat interval until the returned stop func is called (or the ctx is done).
+func (s importJobsService) keepAlive(ctx context.Context, job model.ImportJob) (stop func()) {
+	heartbeatCtx, heartbeatCancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(importJobHeartbeatInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := s.storage.ImportJob().Heartbeat(heartbeatCtx, job.ID); err != nil && heartbeatCtx.Err() == nil {
+					s.logger.Errorf("import job %s: heartbeat: %v", job.ID.Hex(), err)
+				}
+			case <-heartbeatCtx.Done():
+				return
+			}
+		}
+	}()
+
+	return func() {
+		heartbeatCancel()
+		<-done
+	}
+}
+
+// failCanceled sets the failed state for the job canceled by shutdown unless it has already finished.
+// The job context is canceled, so state is updated with a new one.
+func (s importJobsService) failCanceled(job model.ImportJob) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	curJob, err := s.storage.ImportJob().GetByID(ctx, job.ID.Hex())
+	if err != nil {
+		s.logger.Errorf("import job %s: loading canceled job: %v", job.ID.Hex(), err)
+		return
+	}
+	if curJob.State.IsFinal() {
+		return
+	}
+
+	s.setState(ctx, job, model.ImportJobStateFailed, fmt.Errorf("interrupted by the server shutdown"))
+}
+
+// setState updates job state logging update failures (job should not be stopped by those).
+func (s importJobsService) setState(ctx context.Context, job model.ImportJob, state model.ImportJobState, jobErr error) {
+	errMsg := ""
+	if jobErr != nil {
+		errMsg = jobErr.Error()
+		if state == model.ImportJobStateFailed {
+			s.logger.Errorf("import job %s: %s: %s", job.ID.Hex(), state, errMsg)
+		} else {
+			s.logger.Warnf("import job %s: %s: %s", job.ID.Hex(), state, errMsg)
+		}
+	} else {
+		s.logger.Infof("import job %s: %s", job.ID.Hex(), state)
+	}
+
+	if err := s.storage.ImportJob().SetState(ctx, job.ID, state, errMsg); err != nil {
+		s.logger.Errorf("import job %s: state update (%s): %v", job.ID.Hex(), state, err)
+	}
+}
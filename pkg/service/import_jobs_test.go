@@ -0,0 +1,486 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+	"github.com/itiky/mdb-tutorial/pkg/model"
+	"github.com/itiky/mdb-tutorial/pkg/storage"
+	"github.com/itiky/mdb-tutorial/pkg/testutils"
+	"github.com/itiky/mdb-tutorial/pkg/testutils/fixtures"
+)
+
+func (s *ServiceTestSuite) TestService_ImportJobs() {
+	t := s.T()
+	ctx := context.Background()
+
+	client := testutils.PrepareMongoDBFixtures(ctx, s.r, fixtures.NewEmptyMongoDBFixtures())
+	svcStorage, err := storage.NewStorage(
+		storage.WithDatabase(testutils.TestMongoDBDatabase),
+		storage.WithMongoDBClient(client),
+	)
+	require.NoError(t, err)
+
+	// import ledger content hash claims rely on the unique index
+	require.NoError(t, client.Database(testutils.TestMongoDBDatabase).Collection(storage.MigrationsCollection).Drop(ctx))
+	_, err = svcStorage.Migration().Up(ctx, 0)
+	require.NoError(t, err)
+
+	service, err := NewService(
+		WithStorage(svcStorage),
+	)
+	require.NoError(t, err)
+	targetSvc := service.ImportJobs()
+
+	// mock file server
+	mockZip := newMockZipArchive(t, [][2]string{
+		{"prices/1.csv", "Product_1;1\nProduct_2;2\n"},
+		{"prices/readme.txt", "not a CSV-file"},
+		{"prices/2.csv", "Product_1;3\nProduct_3;abc\n"},
+	})
+	fileServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/prices.csv":
+			_, _ = w.Write([]byte(mockCSV))
+		case "/prices.zip":
+			_, _ = w.Write(mockZip)
+		case "/prices_concurrent.csv":
+			_, _ = w.Write([]byte("Product_4;1\nProduct_5;2\n"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer fileServer.Close()
+
+	csvParams := model.CSVProcessParams{
+		ChunkSize: 3,
+		Dialect:   model.NewDefaultCSVDialect(),
+	}
+
+	// waitForJob polls the job until it reaches a final state
+	waitForJob := func(id string) model.ImportJob {
+		for i := 0; i < 50; i++ {
+			job, err := targetSvc.Get(ctx, id)
+			require.NoError(t, err)
+			if job.State.IsFinal() {
+				return job
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
+		t.Fatalf("job %s: not finished in time", id)
+
+		return model.ImportJob{}
+	}
+
+	// check Enqueue: invalid input
+	{
+		_, err := targetSvc.Enqueue(ctx, model.ImportJobParams{URL: "", CSVParams: csvParams})
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSvc.Enqueue(ctx, model.ImportJobParams{URL: fileServer.URL + "/prices.csv", CSVParams: model.CSVProcessParams{ChunkSize: 0, Dialect: model.NewDefaultCSVDialect()}})
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check Get: non-existing
+	{
+		_, err := targetSvc.Get(ctx, primitive.NewObjectID().Hex())
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+
+	// check Enqueue: ok
+	{
+		job, err := targetSvc.Enqueue(ctx, model.ImportJobParams{URL: fileServer.URL + "/prices.csv", CSVParams: csvParams})
+		require.NoError(t, err)
+		require.False(t, job.ID.IsZero())
+		require.Equal(t, model.ImportJobStateQueued, job.State)
+
+		job = waitForJob(job.ID.Hex())
+		require.Equal(t, model.ImportJobStateDone, job.State)
+		require.Empty(t, job.Error)
+		require.False(t, job.ImportTimestamp.IsZero())
+		require.Equal(t, 4, job.Progress.ChunksProcessed)
+		require.Equal(t, 0, job.Progress.ChunksFailed)
+		require.Equal(t, 10, job.Progress.EntriesProcessed)
+		require.NotEmpty(t, job.Source.ContentHash)
+		require.EqualValues(t, len(mockCSV), job.Source.Size)
+	}
+
+	// check Enqueue: the same content is skipped
+	{
+		job, err := targetSvc.Enqueue(ctx, model.ImportJobParams{URL: fileServer.URL + "/prices.csv", CSVParams: csvParams})
+		require.NoError(t, err)
+
+		job = waitForJob(job.ID.Hex())
+		require.Equal(t, model.ImportJobStateSkipped, job.State)
+		require.NotEmpty(t, job.Error)
+		require.Equal(t, 0, job.Progress.ChunksProcessed)
+	}
+
+	// check Enqueue: the same content is imported again (forced)
+	{
+		job, err := targetSvc.Enqueue(ctx, model.ImportJobParams{URL: fileServer.URL + "/prices.csv", CSVParams: csvParams, Force: true})
+		require.NoError(t, err)
+
+		job = waitForJob(job.ID.Hex())
+		require.Equal(t, model.ImportJobStateDone, job.State)
+		require.Equal(t, 4, job.Progress.ChunksProcessed)
+	}
+
+	// check Enqueue: concurrent imports of the same content (only one is done)
+	{
+		jobIDs := make([]string, 0, 2)
+		for i := 0; i < 2; i++ {
+			job, err := targetSvc.Enqueue(ctx, model.ImportJobParams{URL: fileServer.URL + "/prices_concurrent.csv", CSVParams: csvParams})
+			require.NoError(t, err)
+			jobIDs = append(jobIDs, job.ID.Hex())
+		}
+
+		states := make([]model.ImportJobState, 0, len(jobIDs))
+		for _, id := range jobIDs {
+			states = append(states, waitForJob(id).State)
+		}
+		require.ElementsMatch(t, []model.ImportJobState{model.ImportJobStateDone, model.ImportJobStateSkipped}, states)
+	}
+
+	// check Enqueue: download failure
+	{
+		job, err := targetSvc.Enqueue(ctx, model.ImportJobParams{URL: fileServer.URL + "/non-existing.csv", CSVParams: csvParams})
+		require.NoError(t, err)
+
+		job = waitForJob(job.ID.Hex())
+		require.Equal(t, model.ImportJobStateFailed, job.State)
+		require.NotEmpty(t, job.Error)
+	}
+
+	// check Enqueue: zip archive entries are imported separately
+	{
+		job, err := targetSvc.Enqueue(ctx, model.ImportJobParams{URL: fileServer.URL + "/prices.zip", CSVParams: csvParams})
+		require.NoError(t, err)
+
+		job = waitForJob(job.ID.Hex())
+		require.Equal(t, model.ImportJobStateFailed, job.State)
+		require.Equal(t, model.CSVCompressionZip, job.Source.Compression)
+		require.Len(t, job.ArchiveEntries, 2)
+		require.Equal(t, "prices/1.csv", job.ArchiveEntries[0].Name)
+		require.Equal(t, "prices/2.csv", job.ArchiveEntries[1].Name)
+		require.True(t, job.ArchiveEntries[0].ImportTimestamp.Before(job.ArchiveEntries[1].ImportTimestamp))
+		require.Equal(t, 2, job.Progress.ChunksProcessed)
+		require.Equal(t, 1, job.Progress.ChunksFailed)
+		require.Len(t, job.ChunkErrors, 1)
+		require.Equal(t, "prices/2.csv", job.ChunkErrors[0].ArchiveEntry)
+
+		// every entry timestamp is reserved by the job
+		for _, entry := range job.ArchiveEntries {
+			reservation, err := svcStorage.ImportTimestamp().GetByTimestamp(ctx, entry.ImportTimestamp)
+			require.NoError(t, err)
+			require.Equal(t, job.ID, reservation.JobID)
+		}
+	}
+
+	// check import timestamp reservation: timestamps reserved by other imports are skipped
+	{
+		jobsSvc := targetSvc.(importJobsService)
+		otherJobID, job := primitive.NewObjectID(), model.ImportJob{ID: primitive.NewObjectID()}
+		timestamp := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		require.NoError(t, svcStorage.ImportTimestamp().Reserve(ctx, model.ImportTimestamp{Timestamp: timestamp, JobID: otherJobID}))
+
+		reserved, err := jobsSvc.reserveImportTimestamp(ctx, job, timestamp.Add(500*time.Microsecond))
+		require.NoError(t, err)
+		require.True(t, timestamp.Add(time.Millisecond).Equal(reserved))
+
+		reserved, err = jobsSvc.reserveImportTimestamp(ctx, job, timestamp)
+		require.NoError(t, err)
+		require.True(t, timestamp.Add(2*time.Millisecond).Equal(reserved))
+	}
+
+	// check Enqueue: stream import mode (the same content is rolled back as it can't be checked before processing)
+	{
+		streamService, err := NewService(
+			WithStorage(svcStorage),
+			WithImportMode(model.ImportModeStream),
+		)
+		require.NoError(t, err)
+
+		job, err := streamService.ImportJobs().Enqueue(ctx, model.ImportJobParams{URL: fileServer.URL + "/prices.csv", CSVParams: csvParams})
+		require.NoError(t, err)
+
+		job = waitForJob(job.ID.Hex())
+		require.Equal(t, model.ImportJobStateSkipped, job.State)
+		require.NotEmpty(t, job.Error)
+		require.Equal(t, 4, job.Progress.ChunksProcessed)
+
+		priceImports, err := svcStorage.PriceImport().GetAll(ctx, job.ImportTimestamp, "")
+		require.NoError(t, err)
+		require.Empty(t, priceImports)
+
+		job, err = streamService.ImportJobs().Enqueue(ctx, model.ImportJobParams{URL: fileServer.URL + "/prices.csv", CSVParams: csvParams, Force: true})
+		require.NoError(t, err)
+
+		job = waitForJob(job.ID.Hex())
+		require.Equal(t, model.ImportJobStateDone, job.State)
+		require.Equal(t, 4, job.Progress.ChunksProcessed)
+		require.Equal(t, 10, job.Progress.EntriesProcessed)
+		require.NotEmpty(t, job.Source.ContentHash)
+		require.EqualValues(t, len(mockCSV), job.Source.Size)
+
+		job, err = streamService.ImportJobs().Enqueue(ctx, model.ImportJobParams{URL: fileServer.URL + "/prices.zip", CSVParams: csvParams})
+		require.NoError(t, err)
+
+		job = waitForJob(job.ID.Hex())
+		require.Equal(t, model.ImportJobStateFailed, job.State)
+	}
+
+	// check List
+	{
+		jobs, err := targetSvc.List(ctx, common.NewPaginationOption(0, 10))
+		require.NoError(t, err)
+		require.Len(t, jobs, 10)
+	}
+
+	// check Upload: invalid input
+	{
+		_, err := targetSvc.Upload(ctx, "prices.csv", nil, csvParams, false)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSvc.Upload(ctx, "prices.csv", strings.NewReader(mockCSV), model.CSVProcessParams{ChunkSize: 0, Dialect: model.NewDefaultCSVDialect()}, false)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check Upload: ok
+	uploadCSV := mockCSV + "Product_4;5\n"
+	{
+		job, err := targetSvc.Upload(ctx, "prices.csv", strings.NewReader(uploadCSV), csvParams, false)
+		require.NoError(t, err)
+		require.Equal(t, UploadURLScheme+"prices.csv", job.URL)
+		require.Equal(t, model.ImportJobStateDone, job.State)
+		require.Equal(t, 4, job.Progress.ChunksProcessed)
+		require.Equal(t, 11, job.Progress.EntriesProcessed)
+		require.EqualValues(t, len(uploadCSV), job.Source.Size)
+		require.NotEmpty(t, job.Source.ContentHash)
+	}
+
+	// check Upload: the same content is rolled back
+	{
+		job, err := targetSvc.Upload(ctx, "prices.csv", strings.NewReader(uploadCSV), csvParams, false)
+		require.NoError(t, err)
+		require.Equal(t, model.ImportJobStateSkipped, job.State)
+
+		priceImports, err := svcStorage.PriceImport().GetAll(ctx, job.ImportTimestamp, "")
+		require.NoError(t, err)
+		require.Empty(t, priceImports)
+	}
+
+	// check Upload: the same content is imported again if forced
+	{
+		job, err := targetSvc.Upload(ctx, "prices.csv", strings.NewReader(uploadCSV), csvParams, true)
+		require.NoError(t, err)
+		require.Equal(t, model.ImportJobStateDone, job.State)
+		require.True(t, job.Force)
+
+		priceImports, err := svcStorage.PriceImport().GetAll(ctx, job.ImportTimestamp, "")
+		require.NoError(t, err)
+		require.NotEmpty(t, priceImports)
+	}
+
+	// check Upload: partially invalid
+	{
+		job, err := targetSvc.Upload(ctx, "", strings.NewReader("Product_1;1\nProduct_2;abc\n"), csvParams, false)
+		require.NoError(t, err)
+		require.Equal(t, model.ImportJobStateFailed, job.State)
+		require.Equal(t, 1, job.Progress.ChunksFailed)
+		require.Len(t, job.ChunkErrors, 1)
+	}
+}
+
+func (s *ServiceTestSuite) TestService_ImportJobs_Atomicity() {
+	t := s.T()
+	ctx := context.Background()
+
+	client := testutils.PrepareMongoDBFixtures(ctx, s.r, fixtures.NewEmptyMongoDBFixtures())
+	svcStorage, err := storage.NewStorage(
+		storage.WithDatabase(testutils.TestMongoDBDatabase),
+		storage.WithMongoDBClient(client),
+	)
+	require.NoError(t, err)
+
+	newImportJobsService := func(atomicity model.ImportAtomicity, options ...Option) ImportJobsService {
+		service, err := NewService(append([]Option{
+			WithStorage(svcStorage),
+			WithImportAtomicity(atomicity),
+		}, options...)...)
+		require.NoError(t, err)
+
+		return service.ImportJobs()
+	}
+
+	csvParams := model.CSVProcessParams{
+		ChunkSize: 1,
+		Workers:   4,
+		Dialect:   model.NewDefaultCSVDialect(),
+	}
+	partiallyInvalidCSV := "Product_A;1\nProduct_B;2\nProduct_C;abc\nProduct_D;4\n"
+
+	// check option: invalid
+	{
+		_, err := NewService(WithImportAtomicity("unknown"))
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = NewService(WithFileAtomicityMaxRows(0))
+		require.Error(t, err)
+	}
+
+	// check file atomicity: failed import leaves no data
+	{
+		job, err := newImportJobsService(model.ImportAtomicityFile).Upload(ctx, "", strings.NewReader(partiallyInvalidCSV), csvParams, false)
+		require.NoError(t, err)
+		require.Equal(t, model.ImportAtomicityFile, job.Atomicity)
+		require.Equal(t, model.ImportJobStateFailed, job.State)
+		require.Contains(t, job.Error, "nothing is imported")
+		require.Equal(t, 3, job.Progress.ChunksProcessed)
+		require.Equal(t, 1, job.Progress.ChunksFailed)
+
+		imports, err := svcStorage.PriceImport().GetAll(ctx, job.ImportTimestamp, "")
+		require.NoError(t, err)
+		require.Empty(t, imports)
+
+		products, err := svcStorage.Product().GetAll(ctx)
+		require.NoError(t, err)
+		require.Empty(t, products)
+	}
+
+	// check file atomicity: max rows limit exceeded, nothing is imported
+	{
+		job, err := newImportJobsService(model.ImportAtomicityFile, WithFileAtomicityMaxRows(5)).Upload(ctx, "", strings.NewReader(mockCSV), csvParams, false)
+		require.NoError(t, err)
+		require.Equal(t, model.ImportJobStateFailed, job.State)
+		require.Contains(t, job.Error, "max rows limit (5) exceeded")
+		require.Contains(t, job.Error, "nothing is imported")
+		require.Equal(t, 6, job.Progress.ChunksProcessed)
+		require.Equal(t, 1, job.Progress.ChunksFailed)
+
+		imports, err := svcStorage.PriceImport().GetAll(ctx, job.ImportTimestamp, "")
+		require.NoError(t, err)
+		require.Empty(t, imports)
+	}
+
+	// check file atomicity: ok
+	{
+		job, err := newImportJobsService(model.ImportAtomicityFile).Upload(ctx, "", strings.NewReader(mockCSV), csvParams, false)
+		require.NoError(t, err)
+		require.Equal(t, model.ImportJobStateDone, job.State)
+		require.Equal(t, 10, job.Progress.EntriesProcessed)
+
+		imports, err := svcStorage.PriceImport().GetAll(ctx, job.ImportTimestamp, "")
+		require.NoError(t, err)
+		require.Len(t, imports, 3)
+	}
+
+	// check chunk atomicity: valid chunks are imported
+	{
+		job, err := newImportJobsService(model.ImportAtomicityChunk).Upload(ctx, "", strings.NewReader(partiallyInvalidCSV), csvParams, false)
+		require.NoError(t, err)
+		require.Equal(t, model.ImportAtomicityChunk, job.Atomicity)
+		require.Equal(t, model.ImportJobStateFailed, job.State)
+		require.Equal(t, 3, job.Progress.ChunksProcessed)
+		require.Equal(t, 1, job.Progress.ChunksFailed)
+
+		imports, err := svcStorage.PriceImport().GetAll(ctx, job.ImportTimestamp, "")
+		require.NoError(t, err)
+		require.Len(t, imports, 3)
+	}
+}
+
+func (s *ServiceTestSuite) TestService_ImportJobs_Shutdown() {
+	t := s.T()
+	ctx := context.Background()
+
+	client := testutils.PrepareMongoDBFixtures(ctx, s.r, fixtures.NewEmptyMongoDBFixtures())
+	svcStorage, err := storage.NewStorage(
+		storage.WithDatabase(testutils.TestMongoDBDatabase),
+		storage.WithMongoDBClient(client),
+	)
+	require.NoError(t, err)
+
+	service, err := NewService(
+		WithStorage(svcStorage),
+		WithInstanceID("server_1"),
+	)
+	require.NoError(t, err)
+	targetSvc := service.ImportJobs()
+
+	// mock file server: response body is never finished
+	requestReceived := make(chan struct{}, 1)
+	fileServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("Product_1;1\n"))
+		w.(http.Flusher).Flush()
+		requestReceived <- struct{}{}
+		<-r.Context().Done()
+	}))
+	defer fileServer.Close()
+
+	csvParams := model.CSVProcessParams{
+		ChunkSize: 3,
+		Dialect:   model.NewDefaultCSVDialect(),
+	}
+
+	// check FailInterrupted: jobs of the previous run are failed
+	{
+		jobID, err := svcStorage.ImportJob().Create(ctx, model.ImportJob{URL: "http://localhost/1.csv", Owner: "server_1", State: model.ImportJobStateProcessing})
+		require.NoError(t, err)
+
+		failed, err := targetSvc.FailInterrupted(ctx)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, failed)
+
+		job, err := targetSvc.Get(ctx, jobID.Hex())
+		require.NoError(t, err)
+		require.Equal(t, model.ImportJobStateFailed, job.State)
+	}
+
+	// check FailInterrupted / FailExpired: running jobs of other owners are kept until their leases expire
+	{
+		jobID, err := svcStorage.ImportJob().Create(ctx, model.ImportJob{URL: "http://localhost/2.csv", Owner: "server_2", State: model.ImportJobStateProcessing})
+		require.NoError(t, err)
+
+		failed, err := targetSvc.FailInterrupted(ctx)
+		require.NoError(t, err)
+		require.Zero(t, failed)
+
+		failed, err = targetSvc.FailExpired(ctx)
+		require.NoError(t, err)
+		require.Zero(t, failed)
+
+		require.NoError(t, svcStorage.ImportJob().SetState(ctx, jobID, model.ImportJobStateDone, ""))
+	}
+
+	// check Shutdown: running job is canceled and failed
+	{
+		job, err := targetSvc.Enqueue(ctx, model.ImportJobParams{URL: fileServer.URL + "/prices.csv", CSVParams: csvParams})
+		require.NoError(t, err)
+		require.Equal(t, "server_1", job.Owner)
+		<-requestReceived
+
+		shutdownCtx, shutdownCancel := context.WithTimeout(ctx, 5*time.Second)
+		defer shutdownCancel()
+		require.NoError(t, targetSvc.Shutdown(shutdownCtx))
+
+		job, err = targetSvc.Get(ctx, job.ID.Hex())
+		require.NoError(t, err)
+		require.Equal(t, model.ImportJobStateFailed, job.State)
+		require.Contains(t, job.Error, "shutdown")
+	}
+
+	// check Enqueue: rejected after shutdown
+	{
+		_, err := service.ImportJobs().Enqueue(ctx, model.ImportJobParams{URL: fileServer.URL + "/prices.csv", CSVParams: csvParams})
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+	"github.com/itiky/mdb-tutorial/pkg/model"
+	"github.com/itiky/mdb-tutorial/pkg/storage"
+)
+
+var _ ImportRollbackService = (*importRollbackService)(nil)
+
+// importRollbackService keeps ImportRollbackService dependencies.
+type importRollbackService struct {
+	storage   storage.Storage
+	logger    *logrus.Logger
+	atomicity model.ImportAtomicity
+}
+
+// importTimeRange defines prices import DateTime [from, to) range.
+type importTimeRange struct {
+	from, to time.Time
+}
+
+// resolveImportTimestamp returns the exact import timestamp.
+// Stored DateTimes have milliseconds precision, a whole second timestamp (UNIX-time [s] input) matches the only import within the second.
+func resolveImportTimestamp(ctx context.Context, st storage.Storage, timestamp time.Time) (time.Time, error) {
+	exact := timestamp.UTC().Truncate(time.Millisecond)
+	if !exact.Equal(exact.Truncate(time.Second)) {
+		return exact, nil
+	}
+
+	timestamps, err := st.PriceImport().GetImportTimestamps(ctx, exact, exact.Add(time.Second))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("import %s: timestamps lookup: %w", exact, err)
+	}
+	if len(timestamps) > 1 {
+		return time.Time{}, fmt.Errorf("%w: import %s: %d imports within the second %v: milliseconds timestamp should be used",
+			common.ErrInvalidInput, exact, len(timestamps), timestamps)
+	}
+	if len(timestamps) == 1 {
+		exact = timestamps[0]
+	}
+
+	return exact, nil
+}
+
+// DeleteImport implements ImportRollbackService interface.
+func (s importRollbackService) DeleteImport(ctx context.Context, timestamp time.Time, params model.ImportRollbackParams) (model.ImportAuditEntry, error) {
+	// input check
+	if timestamp.IsZero() {
+		return model.ImportAuditEntry{}, fmt.Errorf("%w: timestamp: zero", common.ErrInvalidInput)
+	}
+	if err := params.Validate(); err != nil {
+		return model.ImportAuditEntry{}, err
+	}
+
+	from, err := resolveImportTimestamp(ctx, s.storage, timestamp)
+	if err != nil {
+		return model.ImportAuditEntry{}, err
+	}
+
+	entry, err := s.delete(ctx, "", []importTimeRange{{from: from, to: from.Add(time.Millisecond)}}, params)
+	if err != nil {
+		return model.ImportAuditEntry{}, fmt.Errorf("import %s: %w", from, err)
+	}
+
+	return entry, nil
+}
+
+// DeleteImportByJobID implements ImportRollbackService interface.
+func (s importRollbackService) DeleteImportByJobID(ctx context.Context, jobID string, params model.ImportRollbackParams) (model.ImportAuditEntry, error) {
+	// input check
+	if err := params.Validate(); err != nil {
+		return model.ImportAuditEntry{}, err
+	}
+
+	job, err := s.storage.ImportJob().GetByID(ctx, jobID)
+	if err != nil {
+		return model.ImportAuditEntry{}, err
+	}
+	if job.State != model.ImportJobStateDone && job.State != model.ImportJobStateFailed {
+		return model.ImportAuditEntry{}, fmt.Errorf("%w: import job %s: state %s: only done / failed jobs can be rolled back", common.ErrInvalidInput, jobID, job.State)
+	}
+	if job.ImportTimestamp.IsZero() {
+		return model.ImportAuditEntry{}, fmt.Errorf("%w: import job %s: import timestamp is not set", common.ErrInvalidInput, jobID)
+	}
+
+	// archive entries are imported with their own timestamps, stored DateTimes have milliseconds precision
+	timestamps := job.ImportTimestamps()
+	ranges := make([]importTimeRange, 0, len(timestamps))
+	for _, timestamp := range timestamps {
+		ranges = append(ranges, importTimeRange{from: timestamp, to: timestamp.Add(time.Millisecond)})
+	}
+
+	entry, err := s.delete(ctx, jobID, ranges, params)
+	if err != nil {
+		return model.ImportAuditEntry{}, fmt.Errorf("import job %s: %w", jobID, err)
+	}
+
+	// job error is kept as is
+	if err := s.storage.ImportJob().SetState(ctx, job.ID, model.ImportJobStateRolledBack, job.Error); err != nil {
+		s.logger.Errorf("import job %s: state update (%s): %v", jobID, model.ImportJobStateRolledBack, err)
+	}
+
+	return entry, nil
+}
+
+// ListAudit implements ImportRollbackService interface.
+func (s importRollbackService) ListAudit(ctx context.Context, paginationOpt common.PaginationOption) ([]model.ImportAuditEntry, error) {
+	return s.storage.ImportAudit().GetAll(ctx, paginationOpt)
+}
+
+// delete removes price imports (and import ledger entries, so the content could be imported again) of the import DateTime ranges,
+// optionally removes orphan products and records the audit entry.
+// Operations are run within a transaction unless the import atomicity is disabled (transactions might not be supported)
+// and orphan products are kept: their check and deletion must be atomic, so those always require a transaction.
+func (s importRollbackService) delete(ctx context.Context, jobID string, ranges []importTimeRange, params model.ImportRollbackParams) (model.ImportAuditEntry, error) {
+	var entry model.ImportAuditEntry
+	deleteFn := func(ctx context.Context) error {
+		// func could be retried, so the entry is built from scratch
+		entry = model.ImportAuditEntry{
+			Action:     model.ImportAuditActionDelete,
+			Actor:      params.Actor,
+			ClientAddr: params.ClientAddr,
+			Reason:     params.Reason,
+			JobID:      jobID,
+			Timestamps: make([]time.Time, 0, len(ranges)),
+		}
+
+		productIDs := make([]primitive.ObjectID, 0)
+		for _, r := range ranges {
+			deletedCnt, rangeProductIDs, err := s.storage.PriceImport().DeleteByTimeRange(ctx, r.from, r.to)
+			if err != nil {
+				return fmt.Errorf("deleting price imports: %w", err)
+			}
+			if _, err := s.storage.ImportLedger().DeleteByTimeRange(ctx, r.from, r.to); err != nil {
+				return fmt.Errorf("deleting import ledger entries: %w", err)
+			}
+
+			entry.Timestamps = append(entry.Timestamps, r.from)
+			entry.DeletedPriceImports += deletedCnt
+			productIDs = append(productIDs, rangeProductIDs...)
+		}
+		if entry.DeletedPriceImports == 0 {
+			return fmt.Errorf("%w: no price imports found", common.ErrNotFound)
+		}
+
+		if params.DeleteOrphanProducts {
+			deletedCnt, err := s.storage.Product().DeleteOrphans(ctx, productIDs)
+			if err != nil {
+				return fmt.Errorf("deleting orphan products: %w", err)
+			}
+			entry.DeletedProducts = deletedCnt
+		}
+
+		entry.CreatedAt = time.Now().UTC()
+		createdID, err := s.storage.ImportAudit().Create(ctx, entry)
+		if err != nil {
+			return fmt.Errorf("recording audit entry: %w", err)
+		}
+		entry.ID = createdID
+
+		return nil
+	}
+
+	var err error
+	if s.atomicity == model.ImportAtomicityNone && !params.DeleteOrphanProducts {
+		err = deleteFn(ctx)
+	} else {
+		err = s.storage.WithTransaction(ctx, deleteFn)
+	}
+	if err != nil {
+		if params.DeleteOrphanProducts && s.atomicity == model.ImportAtomicityNone {
+			return model.ImportAuditEntry{}, fmt.Errorf("%w (orphan products deletion requires transactions support)", err)
+		}
+		return model.ImportAuditEntry{}, err
+	}
+
+	s.logger.Infof("import rollback by %s: %d price imports, %d orphan products deleted: %v", entry.Actor, entry.DeletedPriceImports, entry.DeletedProducts, entry.Timestamps)
+
+	return entry, nil
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+	"github.com/itiky/mdb-tutorial/pkg/model"
+	"github.com/itiky/mdb-tutorial/pkg/storage"
+	"github.com/itiky/mdb-tutorial/pkg/testutils"
+	"github.com/itiky/mdb-tutorial/pkg/testutils/fixtures"
+)
+
+func (s *ServiceTestSuite) TestService_ImportRollback() {
+	t := s.T()
+	ctx := context.Background()
+
+	client := testutils.PrepareMongoDBFixtures(ctx, s.r, fixtures.NewEmptyMongoDBFixtures())
+	svcStorage, err := storage.NewStorage(
+		storage.WithDatabase(testutils.TestMongoDBDatabase),
+		storage.WithMongoDBClient(client),
+	)
+	require.NoError(t, err)
+
+	service, err := NewService(
+		WithStorage(svcStorage),
+	)
+	require.NoError(t, err)
+	targetSvc := service.ImportRollback()
+
+	csvParams := model.CSVProcessParams{
+		ChunkSize: 10,
+		Dialect:   model.NewDefaultCSVDialect(),
+	}
+	params := model.ImportRollbackParams{
+		Actor:                "user",
+		ClientAddr:           "127.0.0.1:1234",
+		Reason:               "bad data",
+		DeleteOrphanProducts: true,
+	}
+
+	// two imports: Product_2 prices are imported by the 1st one only
+	job1, err := service.ImportJobs().Upload(ctx, "1.csv", strings.NewReader("Product_1;1\nProduct_2;2\n"), csvParams, false)
+	require.NoError(t, err)
+	require.Equal(t, model.ImportJobStateDone, job1.State)
+
+	job2, err := service.ImportJobs().Upload(ctx, "2.csv", strings.NewReader("Product_1;3\n"), csvParams, false)
+	require.NoError(t, err)
+	require.Equal(t, model.ImportJobStateDone, job2.State)
+
+	// check DeleteImport / DeleteImportByJobID: invalid input
+	{
+		_, err := targetSvc.DeleteImport(ctx, time.Time{}, params)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSvc.DeleteImport(ctx, job1.ImportTimestamp, model.ImportRollbackParams{})
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSvc.DeleteImportByJobID(ctx, job1.ID.Hex(), model.ImportRollbackParams{Actor: "user", Reason: strings.Repeat("a", 2000)})
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSvc.DeleteImportByJobID(ctx, primitive.NewObjectID().Hex(), params)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+
+	// check DeleteImportByJobID: ok
+	{
+		entry, err := targetSvc.DeleteImportByJobID(ctx, job1.ID.Hex(), params)
+		require.NoError(t, err)
+		require.False(t, entry.ID.IsZero())
+		require.Equal(t, model.ImportAuditActionDelete, entry.Action)
+		require.Equal(t, params.Actor, entry.Actor)
+		require.Equal(t, params.ClientAddr, entry.ClientAddr)
+		require.Equal(t, job1.ID.Hex(), entry.JobID)
+		require.Len(t, entry.Timestamps, 1)
+		require.EqualValues(t, 2, entry.DeletedPriceImports)
+		require.EqualValues(t, 1, entry.DeletedProducts)
+
+		job, err := service.ImportJobs().Get(ctx, job1.ID.Hex())
+		require.NoError(t, err)
+		require.Equal(t, model.ImportJobStateRolledBack, job.State)
+
+		products, err := svcStorage.Product().GetAll(ctx)
+		require.NoError(t, err)
+		require.Len(t, products, 1)
+		require.Equal(t, "Product_1", products[0].Name)
+
+		imports, err := svcStorage.PriceImport().GetAll(ctx, time.Time{}, "")
+		require.NoError(t, err)
+		require.Len(t, imports, 1)
+	}
+
+	// check DeleteImportByJobID: already rolled back
+	{
+		_, err := targetSvc.DeleteImportByJobID(ctx, job1.ID.Hex(), params)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check DeleteImport: ok (the job state is not changed)
+	{
+		entry, err := targetSvc.DeleteImport(ctx, job2.ImportTimestamp, model.ImportRollbackParams{Actor: "user"})
+		require.NoError(t, err)
+		require.Empty(t, entry.JobID)
+		require.EqualValues(t, 1, entry.DeletedPriceImports)
+		require.Zero(t, entry.DeletedProducts)
+
+		products, err := svcStorage.Product().GetAll(ctx)
+		require.NoError(t, err)
+		require.Len(t, products, 1)
+
+		_, err = targetSvc.DeleteImport(ctx, job2.ImportTimestamp, model.ImportRollbackParams{Actor: "user"})
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+
+	// check ListAudit
+	{
+		entries, err := targetSvc.ListAudit(ctx, common.NewPaginationOption(0, 10))
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		require.Empty(t, entries[0].JobID)
+		require.Equal(t, job1.ID.Hex(), entries[1].JobID)
+		require.Equal(t, params.Reason, entries[1].Reason)
+	}
+
+	// check DeleteImport: imports within the same second are deleted by the exact timestamp only
+	{
+		productIDs, err := svcStorage.Product().BulkUpsertByNames(ctx, []string{"Product_1"})
+		require.NoError(t, err)
+
+		second := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		timestamp1, timestamp2 := second.Add(100*time.Millisecond), second.Add(200*time.Millisecond)
+		_, err = svcStorage.PriceImport().BulkUpsertByProductIDAndTimestamp(ctx, []model.PricesImport{
+			{ProductID: productIDs["Product_1"], Timestamp: timestamp1, Prices: []model.Price{model.NewPrice(model.MustParseMoney("1", "USD"))}},
+			{ProductID: productIDs["Product_1"], Timestamp: timestamp2, Prices: []model.Price{model.NewPrice(model.MustParseMoney("2", "USD"))}},
+		})
+		require.NoError(t, err)
+
+		_, err = targetSvc.DeleteImport(ctx, second, model.ImportRollbackParams{Actor: "user"})
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		entry, err := targetSvc.DeleteImport(ctx, timestamp1, model.ImportRollbackParams{Actor: "user"})
+		require.NoError(t, err)
+		require.EqualValues(t, 1, entry.DeletedPriceImports)
+
+		imports, err := svcStorage.PriceImport().GetAll(ctx, timestamp2, "")
+		require.NoError(t, err)
+		require.Len(t, imports, 1)
+
+		// the only import within the second
+		entry, err = targetSvc.DeleteImport(ctx, second, model.ImportRollbackParams{Actor: "user"})
+		require.NoError(t, err)
+		require.EqualValues(t, 1, entry.DeletedPriceImports)
+		require.True(t, timestamp2.Equal(entry.Timestamps[0]))
+	}
+}
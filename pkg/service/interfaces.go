@@ -3,8 +3,11 @@ package service
 import (
 	"context"
 	"io"
+	"net/url"
 	"time"
 
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
 	"github.com/itiky/mdb-tutorial/pkg/common"
 	"github.com/itiky/mdb-tutorial/pkg/model"
 )
@@ -16,25 +19,164 @@ type Service interface {
 	CSVProcessor() CSVProcessorService
 	// PriceEntries returns configured PriceEntries service.
 	PriceEntries() PriceEntriesService
+	// ImportJobs returns configured ImportJobs service.
+	ImportJobs() ImportJobsService
+	// ImportRollback returns configured ImportRollback service.
+	ImportRollback() ImportRollbackService
+	// ImportDiff returns configured ImportDiff service.
+	ImportDiff() ImportDiffService
+	// PriceAlerts returns configured PriceAlerts service.
+	PriceAlerts() PriceAlertsService
 }
 
 // CSVImporterService processes product-price data CSV-file import.
 type CSVImporterService interface {
 	// ImportPrices imports CSV file data containing price changes per product.
-	// If import already exists, its data would be overwritten.
+	// If import already exists, prices are appended to it (chunks of the same import are written concurrently).
 	ImportPrices(ctx context.Context, csvImport model.CSVImport) error
+	// CheckSource checks if CSV-file with the same content has already been imported.
+	// Returns common.ErrAlreadyExists if so, unless force is set.
+	CheckSource(ctx context.Context, source model.ImportSource, force bool) error
+	// ClaimSource records CSV-file to the import ledger (pending entries are recorded before the import).
+	// Not forced entries claim the content hash uniquely (stale claims of failed jobs are released),
+	// so the same content is imported only once even by concurrent imports.
+	// Returns created entry ID, common.ErrAlreadyExists if the content has already been imported (or is being imported).
+	ClaimSource(ctx context.Context, entry model.ImportLedgerEntry) (primitive.ObjectID, error)
+	// CompleteSource marks the pending import ledger entry as successfully imported.
+	CompleteSource(ctx context.Context, entryID primitive.ObjectID) error
+	// ReleaseSource deletes the pending import ledger entry of the failed import, so the content could be imported again.
+	ReleaseSource(ctx context.Context, entryID primitive.ObjectID) error
+	// EvaluateAlerts checks enabled price alert rules against prices imported since the import timestamp
+	// sending notifications for triggered ones.
+	// Returns the number of triggered rules.
+	EvaluateAlerts(ctx context.Context, importTimestamp time.Time) (int, error)
 }
 
 // CSVProcessorService downloads and parses product-price data CSV-file.
 type CSVProcessorService interface {
-	// Download download a CSV-file to temp dir and returns filePath and download timestamp.
-	Download(inputPath string) (string, time.Time, error)
-	// Process processed downloaded CSV-file sequentially in chunks.
-	Process(ctx context.Context, reader io.Reader, importTimestamp time.Time, chunkSize int, chunkWorker csvChunkWorker) error
+	// Download download a CSV-file to temp dir and returns its path, download timestamp and source metadata.
+	// Source is fetched by the SourceFetcher registered for the URL scheme.
+	// HTTP(S) failed attempts are retried and interrupted downloads are resumed (Range requests) according to the retry policy.
+	Download(ctx context.Context, inputPath string) (model.CSVFile, error)
+	// Stream downloads a CSV-file passing decompressed (gzip, zstd) response body to the entryHandler without buffering it to disk.
+	// Interrupted body is resumed with Range requests (stream can't be restarted from scratch).
+	// Returns source metadata (content hash is calculated for the whole body).
+	Stream(ctx context.Context, inputPath string, entryHandler csvEntryHandler) (model.ImportSource, error)
+	// Extract opens downloaded CSV-file decompressing it (gzip, zstd, zip) if needed.
+	// Every zip archive CSV-file is passed to the entryHandler separately.
+	Extract(csvFile model.CSVFile, entryHandler csvEntryHandler) error
+	// Process processed downloaded CSV-file of params.Dialect format in chunks.
+	// Chunks are read ahead and executed by params.Workers concurrent workers.
+	// Every chunk result is passed to the optional chunkReporter in the chunks order.
+	// Processing is stopped on the first failed chunk if params.FailFast is set.
+	Process(ctx context.Context, reader io.Reader, importTimestamp time.Time, params model.CSVProcessParams, chunkWorker csvChunkWorker, chunkReporter csvChunkReporter) error
+}
+
+// SourceFetcher opens CSV-file sources of specific URL schemes.
+type SourceFetcher interface {
+	// Schemes returns supported URL schemes (lowercase).
+	Schemes() []string
+	// Fetch opens the source content for reading.
+	// restart is called if the content is re-read from the beginning (nil if restart is not allowed).
+	Fetch(ctx context.Context, sourceURL *url.URL, restart func() error) (SourceReader, error)
+}
+
+// SourceReader reads the fetched source content.
+type SourceReader interface {
+	io.ReadCloser
+	// Meta returns source metadata (used as compression hints and for the import ledger).
+	Meta() SourceMeta
 }
 
 // PriceEntriesService provides product-price entries operations.
 type PriceEntriesService interface {
-	// List queries price entries with pagination and sorting options.
-	List(ctx context.Context, paginationOpt common.PaginationOption, sortOpts common.SortOptions) (model.PriceEntries, error)
+	// List queries price entries with filter, pagination (skip or keyset cursor) and sorting options.
+	// Total number of filtered entries is calculated if countTotal is set.
+	List(ctx context.Context, filter model.PriceEntriesFilter, paginationOpt common.PaginationOption, sortOpts common.SortOptions, countTotal bool) (model.PriceEntriesPage, error)
+	// Stream iterates over all price entries matching the filter (with optional sort and limit) emitting the handler for every entry.
+	Stream(ctx context.Context, filter model.PriceEntriesFilter, sortOpts common.SortOptions, limit int, handler func(entry model.PriceEntry) error) error
+	// Latest queries the most recent price import prices per product (sorted by product name) with filter and pagination options.
+	Latest(ctx context.Context, filter model.LatestPricesFilter, paginationOpt common.PaginationOption) ([]model.LatestPrices, error)
+	// History queries the product prices series (chronological order) with optional downsampling.
+	// Returns common.ErrNotFound if product doesn't exist.
+	History(ctx context.Context, productName string, params model.PriceHistoryParams) ([]model.PriceHistoryPoint, error)
+	// Candles queries the product OHLC candles per interval bucket and currency (ordered by bucket start).
+	// Returns common.ErrNotFound if product doesn't exist.
+	Candles(ctx context.Context, productName string, params model.CandlesParams) ([]model.Candle, error)
+	// TopMovers queries products with the largest absolute or relative price change (in the params direction)
+	// between two points in time or the last two imports.
+	// Returns common.ErrNotFound if there are less than two imports to compare.
+	TopMovers(ctx context.Context, params model.TopMoversParams) (model.TopMovers, error)
+	// Stats queries per product and currency prices statistics (sorted by product name) with filter and pagination options.
+	Stats(ctx context.Context, filter model.PriceEntriesFilter, paginationOpt common.PaginationOption) ([]model.PriceStats, error)
+}
+
+// ImportJobsService manages asynchronous CSV-file import jobs.
+type ImportJobsService interface {
+	// Enqueue registers a new import job and starts it in background.
+	Enqueue(ctx context.Context, params model.ImportJobParams) (model.ImportJob, error)
+	// Upload registers a new import job and processes the uploaded CSV-data synchronously.
+	// Content hash is recorded to the import ledger once the stream is processed (without buffering),
+	// so a duplicate upload is rolled back unless force is set.
+	// Returns the job in its final state.
+	Upload(ctx context.Context, fileName string, reader io.Reader, params model.CSVProcessParams, force bool) (model.ImportJob, error)
+	// Get returns import job by ID.
+	Get(ctx context.Context, id string) (model.ImportJob, error)
+	// List queries import jobs (newest first) with pagination option.
+	List(ctx context.Context, paginationOpt common.PaginationOption) ([]model.ImportJob, error)
+	// FailInterrupted sets the failed state for jobs left unfinished by the previous run of the service instance (crash, restart)
+	// and unfinished jobs of any owner with expired leases (instance IDs might change across restarts, e.g. container hostnames).
+	// Should be called on start before new jobs are enqueued.
+	// Returns the number of failed jobs.
+	FailInterrupted(ctx context.Context) (int64, error)
+	// FailExpired sets the failed state for unfinished jobs of any owner with expired leases (running jobs renew leases by heartbeats).
+	// Should be called periodically, as jobs of a crashed instance are not failed by FailInterrupted until it is restarted.
+	// Returns the number of failed jobs.
+	FailExpired(ctx context.Context) (int64, error)
+	// Shutdown cancels background jobs and waits for them to finish (canceled jobs are failed), new jobs are rejected.
+	Shutdown(ctx context.Context) error
+}
+
+// ImportDiffService compares prices imports.
+type ImportDiffService interface {
+	// Diff compares price imports of two exact import timestamps (milliseconds precision,
+	// a whole second timestamp matches the only import within the second):
+	// new / removed products and price increases / decreases of the "to" import relative to the "from" one.
+	// Returns common.ErrNotFound if any of imports doesn't exist.
+	Diff(ctx context.Context, fromTimestamp, toTimestamp time.Time) (model.ImportDiff, error)
+}
+
+// PriceAlertsService manages price alert rules.
+type PriceAlertsService interface {
+	// Create registers a new price alert rule.
+	Create(ctx context.Context, rule model.PriceAlertRule) (model.PriceAlertRule, error)
+	// Get returns price alert rule by ID.
+	Get(ctx context.Context, id string) (model.PriceAlertRule, error)
+	// List queries price alert rules (newest first) with pagination option.
+	List(ctx context.Context, paginationOpt common.PaginationOption) ([]model.PriceAlertRule, error)
+	// Update updates price alert rule user defined fields (rule is replaced).
+	// Returns common.ErrNotFound if rule doesn't exist.
+	Update(ctx context.Context, rule model.PriceAlertRule) (model.PriceAlertRule, error)
+	// Delete deletes price alert rule by ID.
+	// Returns common.ErrNotFound if rule doesn't exist.
+	Delete(ctx context.Context, id string) error
+}
+
+// PriceAlertNotifier delivers triggered price alert rules notifications.
+type PriceAlertNotifier interface {
+	// Notify sends the notification.
+	Notify(ctx context.Context, notification model.PriceAlertNotification) error
+}
+
+// ImportRollbackService deletes imported prices recording every operation to the audit trail.
+type ImportRollbackService interface {
+	// DeleteImport deletes all price imports of the exact import timestamp (milliseconds precision),
+	// a whole second timestamp matches the only import within the second (common.ErrInvalidInput if there are many).
+	// Returns common.ErrNotFound if there is nothing to delete.
+	DeleteImport(ctx context.Context, timestamp time.Time, params model.ImportRollbackParams) (model.ImportAuditEntry, error)
+	// DeleteImportByJobID deletes all price imports of the finished import job (all archive entries) and marks the job as rolled back.
+	// Returns common.ErrNotFound if there is nothing to delete.
+	DeleteImportByJobID(ctx context.Context, jobID string, params model.ImportRollbackParams) (model.ImportAuditEntry, error)
+	// ListAudit queries the audit trail (newest first) with pagination option.
+	ListAudit(ctx context.Context, paginationOpt common.PaginationOption) ([]model.ImportAuditEntry, error)
 }
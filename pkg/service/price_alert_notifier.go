@@ -0,0 +1,150 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/itiky/mdb-tutorial/pkg/model"
+)
+
+const (
+	webhookNotifierDefaultTimeout = 10 * time.Second
+	webhookNotifierMaxRespBody    = 1024
+)
+
+var _ PriceAlertNotifier = (*logPriceAlertNotifier)(nil)
+var _ PriceAlertNotifier = (*webhookPriceAlertNotifier)(nil)
+
+// WebhookNotifierConfig keeps price alert webhook notifier parameters.
+type WebhookNotifierConfig struct {
+	// HTTP(S) endpoint notifications are POSTed to as JSON objects
+	URL string
+	// Request timeout (default is used if zero)
+	Timeout time.Duration
+}
+
+// logPriceAlertNotifier implements PriceAlertNotifier interface writing notifications to the log.
+type logPriceAlertNotifier struct {
+	logger *logrus.Logger
+}
+
+// Notify implements PriceAlertNotifier interface.
+func (n logPriceAlertNotifier) Notify(ctx context.Context, notification model.PriceAlertNotification) error {
+	n.logger.Warnf("%s", notification.Message())
+
+	return nil
+}
+
+// webhookPriceAlertNotifier implements PriceAlertNotifier interface POSTing notifications to the HTTP endpoint.
+type webhookPriceAlertNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// webhookPriceAlertPayload is a webhook notification request body.
+type webhookPriceAlertPayload struct {
+	RuleID          string  `json:"rule_id"`
+	RuleName        string  `json:"rule_name,omitempty"`
+	ProductName     string  `json:"product_name"`
+	Condition       string  `json:"condition"`
+	Currency        string  `json:"currency"`
+	Threshold       string  `json:"threshold,omitempty"`
+	ChangePercent   float64 `json:"change_percent,omitempty"`
+	ImportTimestamp string  `json:"import_timestamp"`
+	Price           string  `json:"price"`
+	PreviousPrice   string  `json:"previous_price,omitempty"`
+	DeltaPercent    float64 `json:"delta_percent"`
+	Message         string  `json:"message"`
+}
+
+// Notify implements PriceAlertNotifier interface.
+func (n webhookPriceAlertNotifier) Notify(ctx context.Context, notification model.PriceAlertNotification) error {
+	rule := notification.Rule
+	payload := webhookPriceAlertPayload{
+		RuleID:          rule.ID.Hex(),
+		RuleName:        rule.Name,
+		ProductName:     rule.ProductName,
+		Condition:       string(rule.Condition),
+		Currency:        rule.Currency,
+		ImportTimestamp: notification.ImportTimestamp.UTC().Format(time.RFC3339),
+		Price:           notification.Price.Amount.String(),
+		DeltaPercent:    notification.DeltaPercent,
+		Message:         notification.Message(),
+	}
+	if rule.Condition == model.PriceAlertConditionChange {
+		payload.ChangePercent = rule.ChangePercent
+	} else {
+		payload.Threshold = rule.Threshold.Amount.String()
+	}
+	if notification.HasPreviousPrice {
+		payload.PreviousPrice = notification.PreviousPrice.Amount.String()
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("webhook: payload marshal: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("webhook: request build failed: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook: POST failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		respBody, _ := ioutil.ReadAll(io.LimitReader(resp.Body, webhookNotifierMaxRespBody))
+		return fmt.Errorf("webhook: POST failed: %s: %s", resp.Status, respBody)
+	}
+
+	return nil
+}
+
+// NewLogPriceAlertNotifier creates a new PriceAlertNotifier writing notifications to the log.
+func NewLogPriceAlertNotifier(logger *logrus.Logger) (PriceAlertNotifier, error) {
+	if logger == nil {
+		return nil, fmt.Errorf("log notifier: logger: nil")
+	}
+
+	return logPriceAlertNotifier{
+		logger: logger,
+	}, nil
+}
+
+// NewWebhookPriceAlertNotifier creates a new PriceAlertNotifier POSTing notifications to the HTTP(S) endpoint.
+func NewWebhookPriceAlertNotifier(config WebhookNotifierConfig) (PriceAlertNotifier, error) {
+	webhookURL, err := url.Parse(config.URL)
+	if err != nil {
+		return nil, fmt.Errorf("webhook notifier: url: %v", err)
+	}
+	if webhookURL.Scheme != "http" && webhookURL.Scheme != "https" {
+		return nil, fmt.Errorf("webhook notifier: url: HTTP(S) scheme expected (%s)", config.URL)
+	}
+	if config.Timeout < 0 {
+		return nil, fmt.Errorf("webhook notifier: timeout: should be GTE 0")
+	}
+
+	timeout := config.Timeout
+	if timeout == 0 {
+		timeout = webhookNotifierDefaultTimeout
+	}
+
+	return webhookPriceAlertNotifier{
+		url:    webhookURL.String(),
+		client: &http.Client{Timeout: timeout},
+	}, nil
+}
@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+	"github.com/itiky/mdb-tutorial/pkg/model"
+	"github.com/itiky/mdb-tutorial/pkg/storage"
+)
+
+var _ PriceAlertsService = (*priceAlertsService)(nil)
+
+// priceAlertsService keeps PriceAlertsService dependencies.
+type priceAlertsService struct {
+	storage   storage.Storage
+	logger    *logrus.Logger
+	notifiers []PriceAlertNotifier
+}
+
+// Create implements PriceAlertsService interface.
+func (s priceAlertsService) Create(ctx context.Context, rule model.PriceAlertRule) (model.PriceAlertRule, error) {
+	if err := rule.Validate(); err != nil {
+		return model.PriceAlertRule{}, err
+	}
+
+	id, err := s.storage.PriceAlert().Create(ctx, rule)
+	if err != nil {
+		return model.PriceAlertRule{}, fmt.Errorf("creating price alert rule: %w", err)
+	}
+
+	return s.storage.PriceAlert().GetByID(ctx, id.Hex())
+}
+
+// Get implements PriceAlertsService interface.
+func (s priceAlertsService) Get(ctx context.Context, id string) (model.PriceAlertRule, error) {
+	return s.storage.PriceAlert().GetByID(ctx, id)
+}
+
+// List implements PriceAlertsService interface.
+func (s priceAlertsService) List(ctx context.Context, paginationOpt common.PaginationOption) ([]model.PriceAlertRule, error) {
+	return s.storage.PriceAlert().GetAll(ctx, paginationOpt)
+}
+
+// Update implements PriceAlertsService interface.
+func (s priceAlertsService) Update(ctx context.Context, rule model.PriceAlertRule) (model.PriceAlertRule, error) {
+	if rule.ID.IsZero() {
+		return model.PriceAlertRule{}, fmt.Errorf("%w: id: empty", common.ErrInvalidInput)
+	}
+	if err := rule.Validate(); err != nil {
+		return model.PriceAlertRule{}, err
+	}
+
+	if err := s.storage.PriceAlert().Update(ctx, rule); err != nil {
+		return model.PriceAlertRule{}, fmt.Errorf("price alert rule %s: %w", rule.ID.Hex(), err)
+	}
+
+	return s.storage.PriceAlert().GetByID(ctx, rule.ID.Hex())
+}
+
+// Delete implements PriceAlertsService interface.
+func (s priceAlertsService) Delete(ctx context.Context, id string) error {
+	if err := s.storage.PriceAlert().Delete(ctx, id); err != nil {
+		return fmt.Errorf("price alert rule %s: %w", id, err)
+	}
+
+	return nil
+}
+
+// evaluate checks enabled price alert rules against prices imported since the import DateTime
+// (archive entries are imported with later timestamps) comparing those to the latest prices before the import.
+// Triggered rules notifications are sent by every notifier, delivery failures are logged only.
+// Returns sent notifications.
+func (s priceAlertsService) evaluate(ctx context.Context, importTimestamp time.Time) ([]model.PriceAlertNotification, error) {
+	if importTimestamp.IsZero() {
+		return nil, fmt.Errorf("%w: importTimestamp: zero", common.ErrInvalidInput)
+	}
+	// stored DateTimes have milliseconds precision
+	from := importTimestamp.UTC().Truncate(time.Millisecond)
+
+	rules, err := s.storage.PriceAlert().GetEnabled(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading enabled price alert rules: %w", err)
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	// load rules products import and previous prices
+	productNames := make([]string, 0, len(rules))
+	for i, rule := range rules {
+		// rules are sorted by product name
+		if i == 0 || rules[i-1].ProductName != rule.ProductName {
+			productNames = append(productNames, rule.ProductName)
+		}
+	}
+
+	importPrices, err := s.loadLatestPrices(ctx, productNames, time.Time{})
+	if err != nil {
+		return nil, fmt.Errorf("loading import prices: %w", err)
+	}
+	previousPrices, err := s.loadLatestPrices(ctx, productNames, from.Add(-time.Millisecond))
+	if err != nil {
+		return nil, fmt.Errorf("loading previous prices: %w", err)
+	}
+
+	// check rules
+	notifications := make([]model.PriceAlertNotification, 0)
+	for _, rule := range rules {
+		productPrices, found := importPrices[rule.ProductName]
+		if !found || productPrices.Timestamp.Before(from) {
+			continue
+		}
+
+		notification := model.PriceAlertNotification{
+			Rule:            rule,
+			ImportTimestamp: productPrices.Timestamp,
+		}
+		notification.Price, found = lastCurrencyPrice(productPrices.Prices, rule.Currency)
+		if !found {
+			continue
+		}
+		if previousProductPrices, found := previousPrices[rule.ProductName]; found {
+			notification.PreviousPrice, notification.HasPreviousPrice = lastCurrencyPrice(previousProductPrices.Prices, rule.Currency)
+		}
+		if notification.HasPreviousPrice {
+			delta, err := notification.Price.Sub(notification.PreviousPrice)
+			if err != nil {
+				return nil, fmt.Errorf("price alert rule %s: %w", rule.ID.Hex(), err)
+			}
+			notification.DeltaPercent, _ = delta.PercentOf(notification.PreviousPrice)
+		}
+
+		if !isPriceAlertTriggered(notification) {
+			continue
+		}
+
+		if s.notify(ctx, notification) {
+			if err := s.storage.PriceAlert().SetTriggered(ctx, rule.ID, time.Now().UTC()); err != nil {
+				s.logger.Errorf("price alert rule %s: last triggered update: %v", rule.ID.Hex(), err)
+			}
+		}
+		notifications = append(notifications, notification)
+	}
+
+	return notifications, nil
+}
+
+// notify sends the notification by every notifier.
+// Returns true if the notification was delivered at least by one notifier.
+func (s priceAlertsService) notify(ctx context.Context, notification model.PriceAlertNotification) bool {
+	delivered := false
+	for i, notifier := range s.notifiers {
+		if err := notifier.Notify(ctx, notification); err != nil {
+			s.logger.Errorf("price alert rule %s: notifier [%d]: %v", notification.Rule.ID.Hex(), i, err)
+			continue
+		}
+		delivered = true
+	}
+
+	return delivered
+}
+
+// loadLatestPrices loads products latest prices (as of the DateTime if set) by product name.
+func (s priceAlertsService) loadLatestPrices(ctx context.Context, productNames []string, asOf time.Time) (map[string]model.LatestPrices, error) {
+	prices := make(map[string]model.LatestPrices, len(productNames))
+	for start := 0; start < len(productNames); start += common.MaxLimit {
+		end := start + common.MaxLimit
+		if end > len(productNames) {
+			end = len(productNames)
+		}
+
+		filter := model.LatestPricesFilter{
+			ProductNames: productNames[start:end],
+			AsOf:         asOf,
+		}
+		page, err := s.storage.PriceImport().GetLatestPrices(ctx, filter, common.NewPaginationOption(0, end-start))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, latestPrices := range page {
+			prices[latestPrices.Name] = latestPrices
+		}
+	}
+
+	return prices, nil
+}
+
+// lastCurrencyPrice returns the last price of the currency (prices are stored sorted by the CSV-file row, so it's the latest row one).
+func lastCurrencyPrice(prices []model.Money, currency string) (retPrice model.Money, retFound bool) {
+	for _, price := range prices {
+		if price.Currency == currency {
+			retPrice, retFound = price, true
+		}
+	}
+
+	return
+}
+
+// isPriceAlertTriggered checks if the notification rule condition is met:
+//   - above / below: the import price is beyond the threshold and the previous one is not (or there is no previous price);
+//   - change: the absolute price change percentage is GT the rule one (previous price is required and should not be zero);
+func isPriceAlertTriggered(notification model.PriceAlertNotification) bool {
+	rule := notification.Rule
+	switch rule.Condition {
+	case model.PriceAlertConditionAbove:
+		return notification.Price.Cmp(rule.Threshold) > 0 &&
+			(!notification.HasPreviousPrice || notification.PreviousPrice.Cmp(rule.Threshold) <= 0)
+	case model.PriceAlertConditionBelow:
+		return notification.Price.Cmp(rule.Threshold) < 0 &&
+			(!notification.HasPreviousPrice || notification.PreviousPrice.Cmp(rule.Threshold) >= 0)
+	case model.PriceAlertConditionChange:
+		return notification.HasPreviousPrice && notification.PreviousPrice.Sign() != 0 &&
+			math.Abs(notification.DeltaPercent) > rule.ChangePercent
+	default:
+		return false
+	}
+}
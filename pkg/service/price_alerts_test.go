@@ -0,0 +1,267 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+	"github.com/itiky/mdb-tutorial/pkg/model"
+	"github.com/itiky/mdb-tutorial/pkg/storage"
+	"github.com/itiky/mdb-tutorial/pkg/testutils"
+	"github.com/itiky/mdb-tutorial/pkg/testutils/fixtures"
+)
+
+// mockPriceAlertNotifier collects notifications (fails if err is set).
+type mockPriceAlertNotifier struct {
+	notifications *[]model.PriceAlertNotification
+	err           error
+}
+
+// Notify implements PriceAlertNotifier interface.
+func (n mockPriceAlertNotifier) Notify(ctx context.Context, notification model.PriceAlertNotification) error {
+	if n.err != nil {
+		return n.err
+	}
+	*n.notifications = append(*n.notifications, notification)
+
+	return nil
+}
+
+func (s *ServiceTestSuite) TestService_PriceAlerts() {
+	t := s.T()
+	ctx := context.Background()
+
+	client := testutils.PrepareMongoDBFixtures(ctx, s.r, fixtures.NewEmptyMongoDBFixtures())
+	svcStorage, err := storage.NewStorage(
+		storage.WithDatabase(testutils.TestMongoDBDatabase),
+		storage.WithMongoDBClient(client),
+	)
+	require.NoError(t, err)
+
+	notifications := make([]model.PriceAlertNotification, 0)
+	service, err := NewService(
+		WithStorage(svcStorage),
+		WithPriceAlertNotifier(mockPriceAlertNotifier{notifications: &notifications}),
+		WithPriceAlertNotifier(mockPriceAlertNotifier{err: fmt.Errorf("delivery failed")}),
+	)
+	require.NoError(t, err)
+	targetSvc := service.PriceAlerts()
+	importerSvc := service.CSVImporter()
+
+	timestamp1 := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	timestamp2 := timestamp1.Add(time.Hour)
+
+	productIDs, err := svcStorage.Product().BulkUpsertByNames(ctx, []string{"A", "B", "C"})
+	require.NoError(t, err)
+
+	usd := func(amount string) model.Price { return model.NewPrice(model.MustParseMoney(amount, "USD")) }
+
+	// A: 10 -> 12 (+20%), B: 5 -> 4 (-20%), C: 100 -> 101 (+1%)
+	_, err = svcStorage.PriceImport().BulkUpsertByProductIDAndTimestamp(ctx, []model.PricesImport{
+		{ProductID: productIDs["A"], Timestamp: timestamp1, Prices: []model.Price{usd("10")}},
+		{ProductID: productIDs["B"], Timestamp: timestamp1, Prices: []model.Price{usd("5")}},
+		{ProductID: productIDs["C"], Timestamp: timestamp1, Prices: []model.Price{usd("100")}},
+		{ProductID: productIDs["A"], Timestamp: timestamp2, Prices: []model.Price{usd("12")}},
+		{ProductID: productIDs["B"], Timestamp: timestamp2, Prices: []model.Price{usd("4")}},
+		{ProductID: productIDs["C"], Timestamp: timestamp2, Prices: []model.Price{usd("101")}},
+	})
+	require.NoError(t, err)
+
+	newRule := func(name, productName string, condition model.PriceAlertCondition, threshold string, changePercent float64) model.PriceAlertRule {
+		rule := model.PriceAlertRule{
+			Name:          name,
+			ProductName:   productName,
+			Currency:      "USD",
+			Condition:     condition,
+			ChangePercent: changePercent,
+			Enabled:       true,
+		}
+		if threshold != "" {
+			rule.Threshold = model.MustParseMoney(threshold, "USD")
+		}
+
+		return rule
+	}
+
+	// check Create: invalid input
+	{
+		_, err := targetSvc.Create(ctx, newRule("invalid", "A", model.PriceAlertConditionChange, "", 0))
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSvc.Create(ctx, newRule("invalid", "", model.PriceAlertConditionAbove, "1", 0))
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check Create / Get / List
+	rules := make(map[string]model.PriceAlertRule)
+	{
+		for _, rule := range []model.PriceAlertRule{
+			newRule("A above 11", "A", model.PriceAlertConditionAbove, "11", 0),
+			newRule("A above 9", "A", model.PriceAlertConditionAbove, "9", 0),
+			newRule("B below 4.5", "B", model.PriceAlertConditionBelow, "4.5", 0),
+			newRule("B change 10%", "B", model.PriceAlertConditionChange, "", 10),
+			newRule("C change 10%", "C", model.PriceAlertConditionChange, "", 10),
+			newRule("C above 100 disabled", "C", model.PriceAlertConditionAbove, "100", 0),
+		} {
+			createdRule, err := targetSvc.Create(ctx, rule)
+			require.NoError(t, err)
+			require.False(t, createdRule.ID.IsZero())
+			rules[rule.Name] = createdRule
+		}
+
+		rule, err := targetSvc.Get(ctx, rules["A above 11"].ID.Hex())
+		require.NoError(t, err)
+		require.Equal(t, "A above 11", rule.Name)
+
+		listedRules, err := targetSvc.List(ctx, common.NewPaginationOption(0, 10))
+		require.NoError(t, err)
+		require.Len(t, listedRules, len(rules))
+	}
+
+	// check Update
+	{
+		rule := rules["C above 100 disabled"]
+		rule.Enabled = false
+		updatedRule, err := targetSvc.Update(ctx, rule)
+		require.NoError(t, err)
+		require.False(t, updatedRule.Enabled)
+
+		rule.ID = primitive.NewObjectID()
+		_, err = targetSvc.Update(ctx, rule)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+
+	// check EvaluateAlerts: invalid input
+	{
+		_, err := importerSvc.EvaluateAlerts(ctx, time.Time{})
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check EvaluateAlerts: crossed thresholds and changes only
+	{
+		triggered, err := importerSvc.EvaluateAlerts(ctx, timestamp2)
+		require.NoError(t, err)
+		require.Equal(t, 3, triggered)
+		require.Len(t, notifications, 3)
+
+		triggeredNames := make([]string, 0, len(notifications))
+		for _, notification := range notifications {
+			triggeredNames = append(triggeredNames, notification.Rule.Name)
+		}
+		require.ElementsMatch(t, []string{"A above 11", "B below 4.5", "B change 10%"}, triggeredNames)
+
+		for _, notification := range notifications {
+			require.True(t, timestamp2.Equal(notification.ImportTimestamp))
+			require.True(t, notification.HasPreviousPrice)
+			require.NotEmpty(t, notification.Message())
+			if notification.Rule.Name == "B change 10%" {
+				require.InDelta(t, -20.0, notification.DeltaPercent, 1e-9)
+			}
+		}
+
+		rule, err := targetSvc.Get(ctx, rules["A above 11"].ID.Hex())
+		require.NoError(t, err)
+		require.False(t, rule.LastTriggeredAt.IsZero())
+
+		rule, err = targetSvc.Get(ctx, rules["A above 9"].ID.Hex())
+		require.NoError(t, err)
+		require.True(t, rule.LastTriggeredAt.IsZero())
+	}
+
+	// check EvaluateAlerts: no prices since the import timestamp
+	{
+		notifications = notifications[:0]
+		triggered, err := importerSvc.EvaluateAlerts(ctx, timestamp2.Add(time.Hour))
+		require.NoError(t, err)
+		require.Zero(t, triggered)
+		require.Empty(t, notifications)
+	}
+
+	// check Delete
+	{
+		require.NoError(t, targetSvc.Delete(ctx, rules["A above 11"].ID.Hex()))
+
+		err := targetSvc.Delete(ctx, rules["A above 11"].ID.Hex())
+		require.True(t, errors.Is(err, common.ErrNotFound))
+
+		_, err = targetSvc.Get(ctx, rules["A above 11"].ID.Hex())
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+}
+
+func (s *ServiceTestSuite) TestService_PriceAlertNotifier_Webhook() {
+	t := s.T()
+	ctx := context.Background()
+
+	// mock webhook endpoint
+	payloads := make([]webhookPriceAlertPayload, 0)
+	failRequests := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failRequests {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+
+		var payload webhookPriceAlertPayload
+		if r.Method != http.MethodPost || json.NewDecoder(r.Body).Decode(&payload) != nil {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		payloads = append(payloads, payload)
+	}))
+	defer server.Close()
+
+	// check NewWebhookPriceAlertNotifier: invalid config
+	{
+		_, err := NewWebhookPriceAlertNotifier(WebhookNotifierConfig{URL: "ftp://host/path"})
+		require.Error(t, err)
+
+		_, err = NewWebhookPriceAlertNotifier(WebhookNotifierConfig{URL: server.URL, Timeout: -time.Second})
+		require.Error(t, err)
+	}
+
+	notifier, err := NewWebhookPriceAlertNotifier(WebhookNotifierConfig{URL: server.URL})
+	require.NoError(t, err)
+
+	notification := model.PriceAlertNotification{
+		Rule: model.PriceAlertRule{
+			Name:        "rule",
+			ProductName: "A",
+			Currency:    "USD",
+			Condition:   model.PriceAlertConditionAbove,
+			Threshold:   model.MustParseMoney("11", "USD"),
+		},
+		ImportTimestamp:  time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+		Price:            model.MustParseMoney("12", "USD"),
+		PreviousPrice:    model.MustParseMoney("10", "USD"),
+		HasPreviousPrice: true,
+		DeltaPercent:     20,
+	}
+
+	// check Notify: ok
+	{
+		require.NoError(t, notifier.Notify(ctx, notification))
+		require.Len(t, payloads, 1)
+		require.Equal(t, "A", payloads[0].ProductName)
+		require.Equal(t, string(model.PriceAlertConditionAbove), payloads[0].Condition)
+		require.Equal(t, "2000-01-01T00:00:00Z", payloads[0].ImportTimestamp)
+		require.NotEmpty(t, payloads[0].Threshold)
+		require.NotEmpty(t, payloads[0].PreviousPrice)
+		require.EqualValues(t, 20, payloads[0].DeltaPercent)
+		require.Equal(t, notification.Message(), payloads[0].Message)
+	}
+
+	// check Notify: non 2xx response
+	{
+		failRequests = true
+		require.Error(t, notifier.Notify(ctx, notification))
+	}
+}
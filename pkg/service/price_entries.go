@@ -2,6 +2,10 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
@@ -18,6 +22,208 @@ type priceEntriesService struct {
 }
 
 // List implements PriceEntriesService interface.
-func (s priceEntriesService) List(ctx context.Context, paginationOpt common.PaginationOption, sortOpts common.SortOptions) (model.PriceEntries, error) {
-	return s.storage.PriceImport().GetPriceEntries(ctx, sortOpts, paginationOpt)
+func (s priceEntriesService) List(ctx context.Context, filter model.PriceEntriesFilter, paginationOpt common.PaginationOption, sortOpts common.SortOptions, countTotal bool) (model.PriceEntriesPage, error) {
+	return s.storage.PriceImport().GetPriceEntries(ctx, filter, sortOpts, paginationOpt, countTotal)
+}
+
+// Stream implements PriceEntriesService interface.
+func (s priceEntriesService) Stream(ctx context.Context, filter model.PriceEntriesFilter, sortOpts common.SortOptions, limit int, handler func(entry model.PriceEntry) error) error {
+	return s.storage.PriceImport().StreamPriceEntries(ctx, filter, sortOpts, limit, handler)
+}
+
+// Latest implements PriceEntriesService interface.
+func (s priceEntriesService) Latest(ctx context.Context, filter model.LatestPricesFilter, paginationOpt common.PaginationOption) ([]model.LatestPrices, error) {
+	return s.storage.PriceImport().GetLatestPrices(ctx, filter, paginationOpt)
+}
+
+// TopMovers implements PriceEntriesService interface.
+func (s priceEntriesService) TopMovers(ctx context.Context, params model.TopMoversParams) (model.TopMovers, error) {
+	if err := params.Validate(); err != nil {
+		return model.TopMovers{}, err
+	}
+
+	// resolve compared DateTimes
+	from, to := params.TimestampFrom, params.TimestampTo
+	pricesFilter := model.TopMoversPricesFilter{AsOfFrom: from, AsOfTo: to}
+	if from.IsZero() {
+		toGroup, err := s.lastImportGroup(ctx, nil)
+		if err != nil {
+			return model.TopMovers{}, err
+		}
+		fromGroup, err := s.lastImportGroup(ctx, toGroup)
+		if err != nil {
+			return model.TopMovers{}, err
+		}
+		if len(toGroup) == 0 || len(fromGroup) == 0 {
+			return model.TopMovers{}, fmt.Errorf("%w: at least two imports are required", common.ErrNotFound)
+		}
+
+		pricesFilter = model.TopMoversPricesFilter{ImportFrom: fromGroup, ImportTo: toGroup}
+		from, to = fromGroup[0], toGroup[0]
+	}
+
+	// compare: products missing at one of the points are skipped
+	// (for the last two imports their older prices aren't the import ones, so exact timestamps are matched)
+	movers := make([]model.PriceChange, 0)
+	err := s.storage.PriceImport().StreamTopMoversPrices(ctx, pricesFilter, func(prices model.TopMoversPrices) error {
+		fromProductPrices := lastCurrencyPrices(prices.FromPrices, params.Currency)
+		for currency, toPrice := range lastCurrencyPrices(prices.ToPrices, params.Currency) {
+			fromPrice, found := fromProductPrices[currency]
+			if !found {
+				continue
+			}
+
+			change, err := newPriceChange(prices.Name, fromPrice, toPrice)
+			if err != nil {
+				return fmt.Errorf("product %s: %w", prices.Name, err)
+			}
+
+			sign := change.Delta.Sign()
+			if params.Direction == model.TopMoversDirectionGainers && sign <= 0 || params.Direction == model.TopMoversDirectionLosers && sign >= 0 {
+				continue
+			}
+			if params.Relative && fromPrice.Sign() == 0 {
+				continue
+			}
+
+			movers = append(movers, change)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return model.TopMovers{}, fmt.Errorf("comparing prices %s -> %s: %w", from, to, err)
+	}
+
+	// rank: the largest change first (ties are sorted by name and currency for a stable output)
+	sort.Slice(movers, func(i, j int) bool {
+		cmp := movers[i].Delta.Cmp(movers[j].Delta)
+		if params.Relative {
+			cmp = compareFloats(movers[i].DeltaPercent, movers[j].DeltaPercent)
+		}
+		if params.Direction == model.TopMoversDirectionLosers {
+			cmp = -cmp
+		}
+		if cmp != 0 {
+			return cmp > 0
+		}
+
+		if movers[i].Name != movers[j].Name {
+			return movers[i].Name < movers[j].Name
+		}
+		return movers[i].To.Currency < movers[j].To.Currency
+	})
+	if len(movers) > params.Limit {
+		movers = movers[:params.Limit]
+	}
+
+	return model.TopMovers{
+		FromTimestamp: from,
+		ToTimestamp:   to,
+		Movers:        movers,
+	}, nil
+}
+
+// lastImportGroup returns import timestamps of the latest import skipping the excluded ones (nil if there are none).
+// All the import job timestamps (zip archive entries) are grouped as a single import (the first one is the job import timestamp),
+// imports without a timestamp reservation or job (e.g. deleted) are single timestamp ones.
+func (s priceEntriesService) lastImportGroup(ctx context.Context, excluded []time.Time) ([]time.Time, error) {
+	timestamps, err := s.storage.PriceImport().GetLastImportTimestamps(ctx, 1, excluded)
+	if err != nil {
+		return nil, fmt.Errorf("loading last import timestamp: %w", err)
+	}
+	if len(timestamps) == 0 {
+		return nil, nil
+	}
+	lastTimestamp := timestamps[0]
+
+	reservation, err := s.storage.ImportTimestamp().GetByTimestamp(ctx, lastTimestamp)
+	if err != nil {
+		if errors.Is(err, common.ErrNotFound) {
+			return []time.Time{lastTimestamp}, nil
+		}
+		return nil, fmt.Errorf("loading import timestamp %s reservation: %w", lastTimestamp, err)
+	}
+
+	job, err := s.storage.ImportJob().GetByID(ctx, reservation.JobID.Hex())
+	if err != nil {
+		if errors.Is(err, common.ErrNotFound) {
+			return []time.Time{lastTimestamp}, nil
+		}
+		return nil, fmt.Errorf("loading import job %s: %w", reservation.JobID.Hex(), err)
+	}
+
+	group := job.ImportTimestamps()
+	for _, timestamp := range group {
+		if timestamp.Equal(lastTimestamp) {
+			return group, nil
+		}
+	}
+
+	return []time.Time{lastTimestamp}, nil
+}
+
+// lastCurrencyPrices returns the last price per currency (optionally filtered by currency).
+// Prices are stored sorted by the CSV-file row, so it's the latest row one.
+func lastCurrencyPrices(prices []model.Money, currency string) map[string]model.Money {
+	currencyPrices := make(map[string]model.Money, len(prices))
+	for _, price := range prices {
+		if currency != "" && price.Currency != currency {
+			continue
+		}
+		currencyPrices[price.Currency] = price
+	}
+
+	return currencyPrices
+}
+
+// compareFloats compares float values: -1 if LT, 0 if EQ, +1 if GT.
+func compareFloats(v1, v2 float64) int {
+	switch {
+	case v1 < v2:
+		return -1
+	case v1 > v2:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// History implements PriceEntriesService interface.
+func (s priceEntriesService) History(ctx context.Context, productName string, params model.PriceHistoryParams) ([]model.PriceHistoryPoint, error) {
+	if productName == "" {
+		return nil, fmt.Errorf("%w: productName: empty", common.ErrInvalidInput)
+	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	product, err := s.storage.Product().GetByName(ctx, productName)
+	if err != nil {
+		return nil, fmt.Errorf("product %q: %w", productName, err)
+	}
+
+	return s.storage.PriceImport().GetProductHistory(ctx, product.ID, params)
+}
+
+// Stats implements PriceEntriesService interface.
+func (s priceEntriesService) Stats(ctx context.Context, filter model.PriceEntriesFilter, paginationOpt common.PaginationOption) ([]model.PriceStats, error) {
+	return s.storage.PriceImport().GetPriceStats(ctx, filter, paginationOpt)
+}
+
+// Candles implements PriceEntriesService interface.
+func (s priceEntriesService) Candles(ctx context.Context, productName string, params model.CandlesParams) ([]model.Candle, error) {
+	if productName == "" {
+		return nil, fmt.Errorf("%w: productName: empty", common.ErrInvalidInput)
+	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	product, err := s.storage.Product().GetByName(ctx, productName)
+	if err != nil {
+		return nil, fmt.Errorf("product %q: %w", productName, err)
+	}
+
+	return s.storage.PriceImport().GetCandles(ctx, product.ID, params)
 }
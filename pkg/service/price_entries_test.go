@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+	"github.com/itiky/mdb-tutorial/pkg/model"
+	"github.com/itiky/mdb-tutorial/pkg/storage"
+	"github.com/itiky/mdb-tutorial/pkg/testutils"
+	"github.com/itiky/mdb-tutorial/pkg/testutils/fixtures"
+)
+
+func (s *ServiceTestSuite) TestService_PriceEntriesTopMovers() {
+	t := s.T()
+	ctx := context.Background()
+
+	client := testutils.PrepareMongoDBFixtures(ctx, s.r, fixtures.NewEmptyMongoDBFixtures())
+	svcStorage, err := storage.NewStorage(
+		storage.WithDatabase(testutils.TestMongoDBDatabase),
+		storage.WithMongoDBClient(client),
+	)
+	require.NoError(t, err)
+
+	service, err := NewService(
+		WithStorage(svcStorage),
+	)
+	require.NoError(t, err)
+	targetSvc := service.PriceEntries()
+
+	timestamp1 := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	timestamp2 := timestamp1.Add(time.Hour)
+	timestamp3 := timestamp2.Add(time.Hour)
+
+	productIDs, err := svcStorage.Product().BulkUpsertByNames(ctx, []string{"A", "B", "C", "D", "E", "F", "G"})
+	require.NoError(t, err)
+
+	usd := func(amount string) model.Price { return model.NewPrice(model.MustParseMoney(amount, "USD")) }
+	eur := func(amount string) model.Price { return model.NewPrice(model.MustParseMoney(amount, "EUR")) }
+
+	// A: +2 (+20%), B: +1 (+50%), C: -3 (-10%), D: -1 (-50%) EUR, E: new at timestamp3,
+	// F: +1 (+20%) and G: -1 (-10%) are present in both of the last two imports
+	_, err = svcStorage.PriceImport().BulkUpsertByProductIDAndTimestamp(ctx, []model.PricesImport{
+		{ProductID: productIDs["A"], Timestamp: timestamp1, Prices: []model.Price{usd("10")}},
+		{ProductID: productIDs["B"], Timestamp: timestamp1, Prices: []model.Price{usd("2")}},
+		{ProductID: productIDs["C"], Timestamp: timestamp1, Prices: []model.Price{usd("30")}},
+		{ProductID: productIDs["D"], Timestamp: timestamp1, Prices: []model.Price{eur("2")}},
+		{ProductID: productIDs["A"], Timestamp: timestamp2, Prices: []model.Price{usd("12")}},
+		{ProductID: productIDs["C"], Timestamp: timestamp2, Prices: []model.Price{usd("27")}},
+		{ProductID: productIDs["F"], Timestamp: timestamp2, Prices: []model.Price{usd("5")}},
+		{ProductID: productIDs["G"], Timestamp: timestamp2, Prices: []model.Price{usd("10")}},
+		{ProductID: productIDs["B"], Timestamp: timestamp3, Prices: []model.Price{usd("3")}},
+		{ProductID: productIDs["D"], Timestamp: timestamp3, Prices: []model.Price{eur("1")}},
+		{ProductID: productIDs["E"], Timestamp: timestamp3, Prices: []model.Price{usd("100")}},
+		{ProductID: productIDs["F"], Timestamp: timestamp3, Prices: []model.Price{usd("6")}},
+		{ProductID: productIDs["G"], Timestamp: timestamp3, Prices: []model.Price{usd("9")}},
+	})
+	require.NoError(t, err)
+
+	checkMovers := func(name string, expNames []string, rcvMovers []model.PriceChange) {
+		rcvNames := make([]string, 0, len(rcvMovers))
+		for _, mover := range rcvMovers {
+			rcvNames = append(rcvNames, mover.Name)
+		}
+		require.Equal(t, expNames, rcvNames, name)
+	}
+
+	// check TopMovers: invalid input
+	{
+		_, err := targetSvc.TopMovers(ctx, model.TopMoversParams{Direction: model.TopMoversDirectionGainers})
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSvc.TopMovers(ctx, model.TopMoversParams{Limit: 10})
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSvc.TopMovers(ctx, model.TopMoversParams{TimestampFrom: timestamp1, Direction: model.TopMoversDirectionGainers, Limit: 10})
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSvc.TopMovers(ctx, model.TopMoversParams{Currency: "usd", Direction: model.TopMoversDirectionGainers, Limit: 10})
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		// absolute deltas of different currencies aren't comparable
+		_, err = targetSvc.TopMovers(ctx, model.TopMoversParams{Direction: model.TopMoversDirectionGainers, Limit: 10})
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check TopMovers: time range, absolute
+	{
+		params := model.TopMoversParams{
+			TimestampFrom: timestamp1,
+			TimestampTo:   timestamp3,
+			Direction:     model.TopMoversDirectionGainers,
+			Currency:      "USD",
+			Limit:         10,
+		}
+		movers, err := targetSvc.TopMovers(ctx, params)
+		require.NoError(t, err)
+		require.True(t, timestamp1.Equal(movers.FromTimestamp))
+		require.True(t, timestamp3.Equal(movers.ToTimestamp))
+		checkMovers("gainers", []string{"A", "B"}, movers.Movers)
+		require.Zero(t, movers.Movers[0].Delta.Cmp(model.MustParseMoney("2", "USD")))
+		require.InDelta(t, 20.0, movers.Movers[0].DeltaPercent, 1e-9)
+
+		params.Direction = model.TopMoversDirectionLosers
+		movers, err = targetSvc.TopMovers(ctx, params)
+		require.NoError(t, err)
+		checkMovers("losers", []string{"C"}, movers.Movers)
+
+		params.Currency = "EUR"
+		movers, err = targetSvc.TopMovers(ctx, params)
+		require.NoError(t, err)
+		checkMovers("losers: EUR", []string{"D"}, movers.Movers)
+
+		params.Currency, params.Direction, params.Limit = "USD", model.TopMoversDirectionGainers, 1
+		movers, err = targetSvc.TopMovers(ctx, params)
+		require.NoError(t, err)
+		checkMovers("gainers: limit", []string{"A"}, movers.Movers)
+	}
+
+	// check TopMovers: time range, relative
+	{
+		params := model.TopMoversParams{
+			TimestampFrom: timestamp1,
+			TimestampTo:   timestamp3,
+			Direction:     model.TopMoversDirectionGainers,
+			Relative:      true,
+			Limit:         10,
+		}
+		movers, err := targetSvc.TopMovers(ctx, params)
+		require.NoError(t, err)
+		checkMovers("gainers", []string{"B", "A"}, movers.Movers)
+
+		params.Direction = model.TopMoversDirectionLosers
+		movers, err = targetSvc.TopMovers(ctx, params)
+		require.NoError(t, err)
+		checkMovers("losers", []string{"D", "C"}, movers.Movers)
+
+		params.Currency = "USD"
+		movers, err = targetSvc.TopMovers(ctx, params)
+		require.NoError(t, err)
+		checkMovers("losers: currency", []string{"C"}, movers.Movers)
+	}
+
+	// check TopMovers: the last two imports (B and D are missing in the timestamp2 import)
+	{
+		movers, err := targetSvc.TopMovers(ctx, model.TopMoversParams{Direction: model.TopMoversDirectionGainers, Currency: "USD", Limit: 10})
+		require.NoError(t, err)
+		require.True(t, timestamp2.Equal(movers.FromTimestamp))
+		require.True(t, timestamp3.Equal(movers.ToTimestamp))
+		checkMovers("gainers", []string{"F"}, movers.Movers)
+
+		movers, err = targetSvc.TopMovers(ctx, model.TopMoversParams{Direction: model.TopMoversDirectionLosers, Relative: true, Limit: 10})
+		require.NoError(t, err)
+		checkMovers("losers", []string{"G"}, movers.Movers)
+	}
+
+	// check TopMovers: the last two imports, zip archive entries of a job are a single import
+	{
+		timestamp3Entry2 := timestamp3.Add(time.Millisecond)
+		_, err := svcStorage.PriceImport().BulkUpsertByProductIDAndTimestamp(ctx, []model.PricesImport{
+			{ProductID: productIDs["A"], Timestamp: timestamp3Entry2, Prices: []model.Price{usd("15")}},
+			{ProductID: productIDs["F"], Timestamp: timestamp3Entry2, Prices: []model.Price{usd("7")}},
+		})
+		require.NoError(t, err)
+
+		jobID, err := svcStorage.ImportJob().Create(ctx, model.ImportJob{URL: "http://localhost/prices.zip"})
+		require.NoError(t, err)
+		for i, timestamp := range []time.Time{timestamp3, timestamp3Entry2} {
+			require.NoError(t, svcStorage.ImportJob().AddArchiveEntry(ctx, jobID, model.ImportArchiveEntry{Name: fmt.Sprintf("%d.csv", i), ImportTimestamp: timestamp}))
+			require.NoError(t, svcStorage.ImportTimestamp().Reserve(ctx, model.ImportTimestamp{Timestamp: timestamp, JobID: jobID}))
+		}
+
+		// A: +3 (the 2nd entry), F: +2 (the 2nd entry price is the latest one)
+		movers, err := targetSvc.TopMovers(ctx, model.TopMoversParams{Direction: model.TopMoversDirectionGainers, Currency: "USD", Limit: 10})
+		require.NoError(t, err)
+		require.True(t, timestamp2.Equal(movers.FromTimestamp))
+		require.True(t, timestamp3.Equal(movers.ToTimestamp))
+		checkMovers("gainers", []string{"A", "F"}, movers.Movers)
+	}
+
+	// check TopMovers: not enough imports (the rest zip archive entries are a single import)
+	{
+		_, _, err := svcStorage.PriceImport().DeleteByTimeRange(ctx, timestamp1, timestamp3)
+		require.NoError(t, err)
+
+		_, err = targetSvc.TopMovers(ctx, model.TopMoversParams{Direction: model.TopMoversDirectionGainers, Relative: true, Limit: 10})
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+}
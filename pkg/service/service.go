@@ -3,9 +3,11 @@ package service
 import (
 	"fmt"
 	"io/ioutil"
+	"os"
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/itiky/mdb-tutorial/pkg/model"
 	"github.com/itiky/mdb-tutorial/pkg/storage"
 )
 
@@ -13,8 +15,18 @@ var _ Service = (*service)(nil)
 
 // service implements Service interface.
 type service struct {
-	storage storage.Storage
-	logger  *logrus.Logger
+	storage        storage.Storage
+	logger         *logrus.Logger
+	importMode     model.ImportMode
+	atomicity      model.ImportAtomicity
+	downloadPolicy model.DownloadRetryPolicy
+	zipLimits      model.ZipLimits
+	maxDecompSize  int64
+	fileMaxRows    int
+	fetchers       []SourceFetcher
+	notifiers      []PriceAlertNotifier
+	instanceID     string
+	jobRunner      *importJobRunner
 }
 
 // CSVImporterService implements Service interface.
@@ -23,14 +35,27 @@ func (s service) CSVImporter() CSVImporterService {
 	return csvImporterService{
 		storage: s.storage,
 		logger:  s.logger,
+		alerts:  s.priceAlerts(),
 	}
 }
 
 // CSVProcessor implements Service interface.
 // nolint:gosimple
 func (s service) CSVProcessor() CSVProcessorService {
-	return csvProcessorService{
+	fetchers := make(sourceFetchers)
+	fetchers.register(httpSourceFetcher{
+		policy: s.downloadPolicy,
 		logger: s.logger,
+	})
+	for _, fetcher := range s.fetchers {
+		fetchers.register(fetcher)
+	}
+
+	return csvProcessorService{
+		logger:            s.logger,
+		fetchers:          fetchers,
+		zipLimits:         s.zipLimits,
+		maxDecompressSize: s.maxDecompSize,
 	}
 }
 
@@ -43,6 +68,61 @@ func (s service) PriceEntries() PriceEntriesService {
 	}
 }
 
+// ImportJobs implements Service interface.
+// nolint:gosimple
+func (s service) ImportJobs() ImportJobsService {
+	return importJobsService{
+		storage:     s.storage,
+		logger:      s.logger,
+		processor:   s.CSVProcessor(),
+		importer:    s.CSVImporter(),
+		importMode:  s.importMode,
+		atomicity:   s.atomicity,
+		fileMaxRows: s.fileMaxRows,
+		owner:       s.instanceID,
+		runner:      s.jobRunner,
+	}
+}
+
+// ImportRollback implements Service interface.
+// nolint:gosimple
+func (s service) ImportRollback() ImportRollbackService {
+	return importRollbackService{
+		storage:   s.storage,
+		logger:    s.logger,
+		atomicity: s.atomicity,
+	}
+}
+
+// ImportDiff implements Service interface.
+// nolint:gosimple
+func (s service) ImportDiff() ImportDiffService {
+	return importDiffService{
+		storage: s.storage,
+		logger:  s.logger,
+	}
+}
+
+// PriceAlerts implements Service interface.
+// nolint:gosimple
+func (s service) PriceAlerts() PriceAlertsService {
+	return s.priceAlerts()
+}
+
+// priceAlerts builds priceAlertsService (log notifier is used if none is set).
+func (s service) priceAlerts() priceAlertsService {
+	notifiers := s.notifiers
+	if len(notifiers) == 0 {
+		notifiers = []PriceAlertNotifier{logPriceAlertNotifier{logger: s.logger}}
+	}
+
+	return priceAlertsService{
+		storage:   s.storage,
+		logger:    s.logger,
+		notifiers: notifiers,
+	}
+}
+
 // Option specifies functional argument used by NewService function.
 type Option func(service *service) error
 
@@ -70,9 +150,129 @@ func WithLogger(logger *logrus.Logger) Option {
 	}
 }
 
+// WithImportMode sets import jobs download processing mode for service.
+func WithImportMode(mode model.ImportMode) Option {
+	return func(service *service) error {
+		if err := mode.Validate(); err != nil {
+			return fmt.Errorf("importMode option: %w", err)
+		}
+		service.importMode = mode
+
+		return nil
+	}
+}
+
+// WithImportAtomicity sets import jobs data commit mode for service (chunk / file modes require MongoDB transactions support).
+func WithImportAtomicity(atomicity model.ImportAtomicity) Option {
+	return func(service *service) error {
+		if err := atomicity.Validate(); err != nil {
+			return fmt.Errorf("importAtomicity option: %w", err)
+		}
+		service.atomicity = atomicity
+
+		return nil
+	}
+}
+
+// WithFileAtomicityMaxRows sets max number of CSV-file rows imported within a single file atomicity transaction for service.
+func WithFileAtomicityMaxRows(rows int) Option {
+	return func(service *service) error {
+		if rows <= 0 {
+			return fmt.Errorf("fileAtomicityMaxRows option: should be GT 0")
+		}
+		service.fileMaxRows = rows
+
+		return nil
+	}
+}
+
+// WithDownloadRetryPolicy sets CSV-file download retry policy for service.
+func WithDownloadRetryPolicy(policy model.DownloadRetryPolicy) Option {
+	return func(service *service) error {
+		if err := policy.Validate(); err != nil {
+			return fmt.Errorf("downloadRetryPolicy option: %w", err)
+		}
+		service.downloadPolicy = policy
+
+		return nil
+	}
+}
+
+// WithZipLimits sets zip archive extraction limits for service.
+func WithZipLimits(limits model.ZipLimits) Option {
+	return func(service *service) error {
+		if err := limits.Validate(); err != nil {
+			return fmt.Errorf("zipLimits option: %w", err)
+		}
+		service.zipLimits = limits
+
+		return nil
+	}
+}
+
+// WithMaxDecompressedSize sets gzip / zstd CSV-file max decompressed size [bytes] for service.
+func WithMaxDecompressedSize(size int64) Option {
+	return func(service *service) error {
+		if size <= 0 {
+			return fmt.Errorf("maxDecompressedSize option: should be GT 0")
+		}
+		service.maxDecompSize = size
+
+		return nil
+	}
+}
+
+// WithSourceFetcher registers CSV-file source fetcher for its URL schemes (overrides the default HTTP(S) one if set).
+func WithSourceFetcher(fetcher SourceFetcher) Option {
+	return func(service *service) error {
+		if fetcher == nil {
+			return fmt.Errorf("sourceFetcher option: nil")
+		}
+		if len(fetcher.Schemes()) == 0 {
+			return fmt.Errorf("sourceFetcher option: no URL schemes")
+		}
+		service.fetchers = append(service.fetchers, fetcher)
+
+		return nil
+	}
+}
+
+// WithPriceAlertNotifier registers price alert notifications notifier (overrides the default log one if set).
+func WithPriceAlertNotifier(notifier PriceAlertNotifier) Option {
+	return func(service *service) error {
+		if notifier == nil {
+			return fmt.Errorf("priceAlertNotifier option: nil")
+		}
+		service.notifiers = append(service.notifiers, notifier)
+
+		return nil
+	}
+}
+
+// WithInstanceID sets service instance ID import jobs are owned by (hostname is used by default).
+// IDs should be unique across services sharing the same DB and stable across the instance restarts.
+func WithInstanceID(id string) Option {
+	return func(service *service) error {
+		if id == "" {
+			return fmt.Errorf("instanceID option: empty")
+		}
+		service.instanceID = id
+
+		return nil
+	}
+}
+
 // NewService creates a new configured Service object.
 func NewService(options ...Option) (Service, error) {
-	s := &service{}
+	s := &service{
+		importMode:     model.ImportModeTmpFile,
+		atomicity:      model.ImportAtomicityNone,
+		downloadPolicy: model.NewDefaultDownloadRetryPolicy(),
+		zipLimits:      model.NewDefaultZipLimits(),
+		maxDecompSize:  model.DefaultCSVMaxDecompressedSize,
+		fileMaxRows:    model.DefaultImportFileAtomicityMaxRows,
+		jobRunner:      newImportJobRunner(),
+	}
 	for _, option := range options {
 		if err := option(s); err != nil {
 			return nil, err
@@ -85,5 +285,13 @@ func NewService(options ...Option) (Service, error) {
 		s.logger = logger
 	}
 
+	if s.instanceID == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, fmt.Errorf("instanceID: hostname: %w", err)
+		}
+		s.instanceID = hostname
+	}
+
 	return s, nil
 }
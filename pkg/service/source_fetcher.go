@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+	"github.com/itiky/mdb-tutorial/pkg/model"
+)
+
+var _ SourceFetcher = (*httpSourceFetcher)(nil)
+
+// SourceMeta keeps fetched source metadata.
+type SourceMeta struct {
+	// Content version identifier (if provided by source)
+	ETag string
+	// Declared content MIME type (compression hint)
+	ContentType string
+	// Declared content encoding (compression hint)
+	ContentEncoding string
+}
+
+// sourceFetchers is a SourceFetcher registry (URL scheme -> fetcher).
+type sourceFetchers map[string]SourceFetcher
+
+// register adds fetcher for all its schemes (the previously registered ones are overwritten).
+func (r sourceFetchers) register(fetcher SourceFetcher) {
+	for _, scheme := range fetcher.Schemes() {
+		r[strings.ToLower(scheme)] = fetcher
+	}
+}
+
+// fetch opens the source using a fetcher registered for the URL scheme.
+func (r sourceFetchers) fetch(ctx context.Context, inputPath string, restart func() error) (SourceReader, error) {
+	sourceURL, err := url.Parse(inputPath)
+	if err != nil {
+		return nil, fmt.Errorf("%w: path invalid: %v", common.ErrInvalidInput, err)
+	}
+
+	fetcher, found := r[strings.ToLower(sourceURL.Scheme)]
+	if !found {
+		return nil, fmt.Errorf("%w: path invalid: unsupported URL scheme (%s)", common.ErrInvalidInput, sourceURL.Scheme)
+	}
+
+	return fetcher.Fetch(ctx, sourceURL, restart)
+}
+
+// httpSourceFetcher implements SourceFetcher interface for HTTP(S) sources.
+// Failed requests are retried and interrupted downloads are resumed according to the retry policy.
+type httpSourceFetcher struct {
+	policy model.DownloadRetryPolicy
+	logger *logrus.Logger
+}
+
+// Schemes implements SourceFetcher interface.
+func (f httpSourceFetcher) Schemes() []string {
+	return []string{"http", "https"}
+}
+
+// Fetch implements SourceFetcher interface.
+func (f httpSourceFetcher) Fetch(ctx context.Context, sourceURL *url.URL, restart func() error) (SourceReader, error) {
+	download := newCSVDownload(ctx, sourceURL.String(), f.policy, f.logger)
+	download.restart = restart
+	if err := download.Open(); err != nil {
+		return nil, err
+	}
+
+	return download, nil
+}
+
+// checkSourceURLCredentials checks that URL doesn't contain credentials (those should be configured).
+func checkSourceURLCredentials(sourceURL *url.URL) error {
+	if sourceURL.User != nil {
+		return fmt.Errorf("%w: path invalid: URL credentials are not allowed (configure them instead)", common.ErrInvalidInput)
+	}
+
+	return nil
+}
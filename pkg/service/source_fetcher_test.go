@@ -0,0 +1,370 @@
+package service
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pkg/sftp"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+)
+
+func (s *ServiceTestSuite) TestService_SourceFetcher_File() {
+	t := s.T()
+	ctx := context.Background()
+
+	// mock files: root dir with a file, outside file and a symlink escaping the root
+	tmpDir, err := ioutil.TempDir("", "source_fetcher_file")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	rootDir, outsideDir := filepath.Join(tmpDir, "root"), filepath.Join(tmpDir, "outside")
+	require.NoError(t, os.Mkdir(rootDir, 0700))
+	require.NoError(t, os.Mkdir(outsideDir, 0700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(rootDir, "prices.csv"), []byte(mockCSV), 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(outsideDir, "prices.csv"), []byte(mockCSV), 0600))
+	require.NoError(t, os.Symlink(filepath.Join(outsideDir, "prices.csv"), filepath.Join(rootDir, "link.csv")))
+
+	fetcher, err := NewFileSourceFetcher([]string{rootDir})
+	require.NoError(t, err)
+
+	service, err := NewService(WithSourceFetcher(fetcher))
+	require.NoError(t, err)
+	targetSvc := service.CSVProcessor()
+
+	// check NewFileSourceFetcher: invalid roots
+	{
+		_, err := NewFileSourceFetcher(nil)
+		require.Error(t, err)
+
+		_, err = NewFileSourceFetcher([]string{"relative/path"})
+		require.Error(t, err)
+
+		_, err = NewFileSourceFetcher([]string{filepath.Join(rootDir, "prices.csv")})
+		require.Error(t, err)
+	}
+
+	// check Download: ok
+	{
+		csvFile, err := targetSvc.Download(ctx, "file://"+filepath.Join(rootDir, "prices.csv"))
+		require.NoError(t, err)
+		defer os.Remove(csvFile.Path)
+
+		data, err := ioutil.ReadFile(csvFile.Path)
+		require.NoError(t, err)
+		require.Equal(t, mockCSV, string(data))
+		require.EqualValues(t, len(mockCSV), csvFile.Source.Size)
+	}
+
+	// check Download: outside of roots
+	{
+		_, err := targetSvc.Download(ctx, "file://"+filepath.Join(outsideDir, "prices.csv"))
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSvc.Download(ctx, "file://"+rootDir+"/../outside/prices.csv")
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check Download: symlink escaping the root
+	{
+		_, err := targetSvc.Download(ctx, "file://"+filepath.Join(rootDir, "link.csv"))
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check Download: non-existing
+	{
+		_, err := targetSvc.Download(ctx, "file://"+filepath.Join(rootDir, "non-existing.csv"))
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+
+	// check Download: directory and remote host
+	{
+		_, err := targetSvc.Download(ctx, "file://"+rootDir)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSvc.Download(ctx, "file://remote"+filepath.Join(rootDir, "prices.csv"))
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check Download: unsupported scheme (file fetcher is not registered by default)
+	{
+		defaultService, err := NewService()
+		require.NoError(t, err)
+
+		_, err = defaultService.CSVProcessor().Download(ctx, "file://"+filepath.Join(rootDir, "prices.csv"))
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSvc.Download(ctx, "ftp://localhost/prices.csv")
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+}
+
+func (s *ServiceTestSuite) TestService_SourceFetcher_S3() {
+	t := s.T()
+	ctx := context.Background()
+
+	// mock S3-compatible storage (path-style, request signature is only checked to be made with the configured key)
+	storageServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.Header.Get("Authorization"), "Credential=test-key/") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/bucket/dir/prices.csv":
+			w.Header().Set("ETag", `"etag"`)
+			w.Header().Set("Content-Type", "text/csv")
+			_, _ = w.Write([]byte(mockCSV))
+		default:
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`))
+		}
+	}))
+	defer storageServer.Close()
+
+	fetcher, err := NewS3SourceFetcher(S3FetcherConfig{
+		Endpoint:        storageServer.URL,
+		Region:          "us-east-1",
+		AccessKeyID:     "test-key",
+		SecretAccessKey: "test-secret",
+		PathStyle:       true,
+	})
+	require.NoError(t, err)
+
+	service, err := NewService(WithSourceFetcher(fetcher))
+	require.NoError(t, err)
+	targetSvc := service.CSVProcessor()
+
+	// check NewS3SourceFetcher: invalid config
+	{
+		_, err := NewS3SourceFetcher(S3FetcherConfig{})
+		require.Error(t, err)
+
+		_, err = NewS3SourceFetcher(S3FetcherConfig{Region: "us-east-1", AccessKeyID: "test-key"})
+		require.Error(t, err)
+	}
+
+	// check Download: ok
+	{
+		csvFile, err := targetSvc.Download(ctx, "s3://bucket/dir/prices.csv")
+		require.NoError(t, err)
+		defer os.Remove(csvFile.Path)
+
+		data, err := ioutil.ReadFile(csvFile.Path)
+		require.NoError(t, err)
+		require.Equal(t, mockCSV, string(data))
+		require.Equal(t, `"etag"`, csvFile.Source.ETag)
+	}
+
+	// check Stream: ok
+	{
+		var content string
+		source, err := targetSvc.Stream(ctx, "s3://bucket/dir/prices.csv", func(entryName string, reader io.Reader) error {
+			data, err := ioutil.ReadAll(reader)
+			content = string(data)
+			return err
+		})
+		require.NoError(t, err)
+		require.Equal(t, mockCSV, content)
+		require.Equal(t, `"etag"`, source.ETag)
+	}
+
+	// check Download: non-existing
+	{
+		_, err := targetSvc.Download(ctx, "s3://bucket/non-existing.csv")
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+
+	// check Download: invalid URL
+	{
+		_, err := targetSvc.Download(ctx, "s3://bucket/")
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSvc.Download(ctx, "s3://key:secret@bucket/dir/prices.csv")
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+}
+
+func (s *ServiceTestSuite) TestService_SourceFetcher_SFTP() {
+	t := s.T()
+	ctx := context.Background()
+
+	tmpDir, err := ioutil.TempDir("", "source_fetcher_sftp")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	filePath := filepath.Join(tmpDir, "prices.csv")
+	require.NoError(t, ioutil.WriteFile(filePath, []byte(mockCSV), 0600))
+
+	// mock SFTP server
+	serverAddr, hostKey := newMockSFTPServer(t, "user", "password")
+
+	knownHostsPath := filepath.Join(tmpDir, "known_hosts")
+	require.NoError(t, ioutil.WriteFile(knownHostsPath, []byte(knownhosts.Line([]string{serverAddr}, hostKey)+"\n"), 0600))
+
+	fetcher, err := NewSFTPSourceFetcher(SFTPFetcherConfig{
+		User:           "user",
+		Password:       "password",
+		KnownHostsPath: knownHostsPath,
+	})
+	require.NoError(t, err)
+
+	service, err := NewService(WithSourceFetcher(fetcher))
+	require.NoError(t, err)
+	targetSvc := service.CSVProcessor()
+
+	// check NewSFTPSourceFetcher: invalid config
+	{
+		_, err := NewSFTPSourceFetcher(SFTPFetcherConfig{User: "user"})
+		require.Error(t, err)
+
+		_, err = NewSFTPSourceFetcher(SFTPFetcherConfig{User: "user", Password: "password"})
+		require.Error(t, err)
+	}
+
+	// check Download: ok
+	{
+		csvFile, err := targetSvc.Download(ctx, "sftp://"+serverAddr+filePath)
+		require.NoError(t, err)
+		defer os.Remove(csvFile.Path)
+
+		data, err := ioutil.ReadFile(csvFile.Path)
+		require.NoError(t, err)
+		require.Equal(t, mockCSV, string(data))
+	}
+
+	// check Download: non-existing
+	{
+		_, err := targetSvc.Download(ctx, "sftp://"+serverAddr+filepath.Join(tmpDir, "non-existing.csv"))
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+
+	// check Download: URL credentials
+	{
+		_, err := targetSvc.Download(ctx, "sftp://user:password@"+serverAddr+filePath)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check Download: wrong password
+	{
+		wrongFetcher, err := NewSFTPSourceFetcher(SFTPFetcherConfig{
+			User:           "user",
+			Password:       "wrong",
+			KnownHostsPath: knownHostsPath,
+		})
+		require.NoError(t, err)
+
+		wrongService, err := NewService(WithSourceFetcher(wrongFetcher))
+		require.NoError(t, err)
+
+		_, err = wrongService.CSVProcessor().Download(ctx, "sftp://"+serverAddr+filePath)
+		require.Error(t, err)
+	}
+
+	// check Download: unknown host key
+	{
+		otherKnownHostsPath := filepath.Join(tmpDir, "other_known_hosts")
+		require.NoError(t, ioutil.WriteFile(otherKnownHostsPath, []byte{}, 0600))
+
+		otherFetcher, err := NewSFTPSourceFetcher(SFTPFetcherConfig{
+			User:           "user",
+			Password:       "password",
+			KnownHostsPath: otherKnownHostsPath,
+		})
+		require.NoError(t, err)
+
+		otherService, err := NewService(WithSourceFetcher(otherFetcher))
+		require.NoError(t, err)
+
+		_, err = otherService.CSVProcessor().Download(ctx, "sftp://"+serverAddr+filePath)
+		require.Error(t, err)
+	}
+}
+
+// newMockSFTPServer starts an in-process SFTP server with password auth serving the local FS.
+// Returns server address and host public key.
+func newMockSFTPServer(t *testing.T, user, password string) (string, ssh.PublicKey) {
+	_, hostPrivKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	hostSigner, err := ssh.NewSignerFromKey(hostPrivKey)
+	require.NoError(t, err)
+
+	serverConfig := &ssh.ServerConfig{
+		PasswordCallback: func(conn ssh.ConnMetadata, pass []byte) (*ssh.Permissions, error) {
+			if conn.User() == user && string(pass) == password {
+				return nil, nil
+			}
+			return nil, errors.New("access denied")
+		},
+	}
+	serverConfig.AddHostKey(hostSigner)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	serveChannel := func(newChannel ssh.NewChannel) {
+		if newChannel.ChannelType() != "session" {
+			_ = newChannel.Reject(ssh.UnknownChannelType, "unknown channel type")
+			return
+		}
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			return
+		}
+		defer channel.Close()
+
+		for req := range requests {
+			isSFTP := req.Type == "subsystem" && len(req.Payload) > 4 && string(req.Payload[4:]) == "sftp"
+			_ = req.Reply(isSFTP, nil)
+			if !isSFTP {
+				continue
+			}
+
+			server, err := sftp.NewServer(channel, sftp.ReadOnly())
+			if err != nil {
+				return
+			}
+			_ = server.Serve()
+			return
+		}
+	}
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				_, channels, requests, err := ssh.NewServerConn(conn, serverConfig)
+				if err != nil {
+					conn.Close()
+					return
+				}
+				go ssh.DiscardRequests(requests)
+				for newChannel := range channels {
+					go serveChannel(newChannel)
+				}
+			}()
+		}
+	}()
+
+	return listener.Addr().String(), hostSigner.PublicKey()
+}
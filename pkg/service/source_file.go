@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+)
+
+var _ SourceFetcher = (*fileSourceFetcher)(nil)
+
+// fileSourceFetcher implements SourceFetcher interface for local files (file:///path).
+// Only files within the configured root directories are allowed (symlinks are resolved before the check).
+type fileSourceFetcher struct {
+	roots []string
+}
+
+// fileSourceReader implements SourceReader interface for local files.
+type fileSourceReader struct {
+	*os.File
+}
+
+// Meta implements SourceReader interface.
+func (r fileSourceReader) Meta() SourceMeta {
+	return SourceMeta{}
+}
+
+// Schemes implements SourceFetcher interface.
+func (f fileSourceFetcher) Schemes() []string {
+	return []string{"file"}
+}
+
+// Fetch implements SourceFetcher interface.
+func (f fileSourceFetcher) Fetch(ctx context.Context, sourceURL *url.URL, restart func() error) (SourceReader, error) {
+	if sourceURL.Host != "" && sourceURL.Host != "localhost" {
+		return nil, fmt.Errorf("%w: path invalid: remote file host (%s)", common.ErrInvalidInput, sourceURL.Host)
+	}
+
+	filePath := filepath.Clean(filepath.FromSlash(sourceURL.Path))
+	if !filepath.IsAbs(filePath) {
+		return nil, fmt.Errorf("%w: path invalid: absolute file path required (%s)", common.ErrInvalidInput, sourceURL.Path)
+	}
+
+	// check the path itself first not to reveal files existence outside of roots, then the resolved one
+	if !f.isAllowed(filePath) {
+		return nil, fmt.Errorf("%w: path invalid: file is outside of the allowed roots (%s)", common.ErrInvalidInput, filePath)
+	}
+
+	resolvedPath, err := filepath.EvalSymlinks(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%w: file %s", common.ErrNotFound, filePath)
+		}
+		return nil, fmt.Errorf("resolving file path %s: %v", filePath, err)
+	}
+	if !f.isAllowed(resolvedPath) {
+		return nil, fmt.Errorf("%w: path invalid: file is outside of the allowed roots (%s)", common.ErrInvalidInput, filePath)
+	}
+
+	file, err := os.Open(resolvedPath)
+	if err != nil {
+		return nil, fmt.Errorf("file open failed: %v", err)
+	}
+
+	fileInfo, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("file stat failed: %v", err)
+	}
+	if !fileInfo.Mode().IsRegular() {
+		file.Close()
+		return nil, fmt.Errorf("%w: path invalid: not a regular file (%s)", common.ErrInvalidInput, filePath)
+	}
+
+	return fileSourceReader{File: file}, nil
+}
+
+// isAllowed checks if path is within one of the roots.
+func (f fileSourceFetcher) isAllowed(filePath string) bool {
+	for _, root := range f.roots {
+		relPath, err := filepath.Rel(root, filePath)
+		if err != nil {
+			continue
+		}
+		if relPath != ".." && !strings.HasPrefix(relPath, ".."+string(filepath.Separator)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NewFileSourceFetcher creates a new local files SourceFetcher restricted to root directories.
+func NewFileSourceFetcher(roots []string) (SourceFetcher, error) {
+	if len(roots) == 0 {
+		return nil, fmt.Errorf("file fetcher: roots: empty")
+	}
+
+	f := fileSourceFetcher{
+		roots: make([]string, 0, len(roots)),
+	}
+	for _, root := range roots {
+		if !filepath.IsAbs(root) {
+			return nil, fmt.Errorf("file fetcher: root %s: absolute path required", root)
+		}
+
+		resolvedRoot, err := filepath.EvalSymlinks(root)
+		if err != nil {
+			return nil, fmt.Errorf("file fetcher: root %s: %v", root, err)
+		}
+
+		rootInfo, err := os.Stat(resolvedRoot)
+		if err != nil {
+			return nil, fmt.Errorf("file fetcher: root %s: %v", root, err)
+		}
+		if !rootInfo.IsDir() {
+			return nil, fmt.Errorf("file fetcher: root %s: not a directory", root)
+		}
+
+		// both forms are kept, as the requested path is checked before and after symlinks resolution
+		f.roots = append(f.roots, resolvedRoot)
+		if cleanRoot := filepath.Clean(root); cleanRoot != resolvedRoot {
+			f.roots = append(f.roots, cleanRoot)
+		}
+	}
+
+	return f, nil
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+)
+
+var _ SourceFetcher = (*s3SourceFetcher)(nil)
+
+// S3FetcherConfig keeps S3-compatible object storage connection parameters.
+type S3FetcherConfig struct {
+	// Custom endpoint URL for S3-compatible storages (empty for AWS S3)
+	Endpoint string
+	// Storage region
+	Region string
+	// Static credentials (anonymous access if empty)
+	AccessKeyID     string
+	SecretAccessKey string
+	// Use path-style addressing ({endpoint}/{bucket}/{key}) instead of the virtual-hosted one
+	PathStyle bool
+}
+
+// s3SourceFetcher implements SourceFetcher interface for S3-compatible object storage objects (s3://bucket/key).
+type s3SourceFetcher struct {
+	client *s3.S3
+}
+
+// s3SourceReader implements SourceReader interface for S3 objects.
+type s3SourceReader struct {
+	io.ReadCloser
+	meta SourceMeta
+}
+
+// Meta implements SourceReader interface.
+func (r s3SourceReader) Meta() SourceMeta {
+	return r.meta
+}
+
+// Schemes implements SourceFetcher interface.
+func (f s3SourceFetcher) Schemes() []string {
+	return []string{"s3"}
+}
+
+// Fetch implements SourceFetcher interface.
+func (f s3SourceFetcher) Fetch(ctx context.Context, sourceURL *url.URL, restart func() error) (SourceReader, error) {
+	if err := checkSourceURLCredentials(sourceURL); err != nil {
+		return nil, err
+	}
+
+	bucket, key := sourceURL.Host, strings.TrimPrefix(sourceURL.Path, "/")
+	if bucket == "" {
+		return nil, fmt.Errorf("%w: path invalid: bucket: empty", common.ErrInvalidInput)
+	}
+	if key == "" {
+		return nil, fmt.Errorf("%w: path invalid: object key: empty", common.ErrInvalidInput)
+	}
+
+	output, err := f.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		if isS3NotFoundError(err) {
+			return nil, fmt.Errorf("%w: S3 object %s/%s: %v", common.ErrNotFound, bucket, key, err)
+		}
+		return nil, fmt.Errorf("S3 GetObject failed: %v", err)
+	}
+
+	return s3SourceReader{
+		ReadCloser: output.Body,
+		meta: SourceMeta{
+			ETag:            aws.StringValue(output.ETag),
+			ContentType:     aws.StringValue(output.ContentType),
+			ContentEncoding: aws.StringValue(output.ContentEncoding),
+		},
+	}, nil
+}
+
+// isS3NotFoundError checks if S3 request error is caused by a missing bucket / object.
+func isS3NotFoundError(err error) bool {
+	if reqErr, ok := err.(awserr.RequestFailure); ok && reqErr.StatusCode() == http.StatusNotFound {
+		return true
+	}
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch awsErr.Code() {
+		case s3.ErrCodeNoSuchBucket, s3.ErrCodeNoSuchKey:
+			return true
+		}
+	}
+
+	return false
+}
+
+// NewS3SourceFetcher creates a new S3-compatible object storage SourceFetcher.
+func NewS3SourceFetcher(config S3FetcherConfig) (SourceFetcher, error) {
+	if config.Region == "" {
+		return nil, fmt.Errorf("S3 fetcher: region: empty")
+	}
+	if (config.AccessKeyID == "") != (config.SecretAccessKey == "") {
+		return nil, fmt.Errorf("S3 fetcher: accessKeyID and secretAccessKey should be set together")
+	}
+
+	awsConfig := aws.NewConfig().
+		WithRegion(config.Region).
+		WithS3ForcePathStyle(config.PathStyle).
+		WithCredentials(credentials.AnonymousCredentials)
+	if config.Endpoint != "" {
+		if _, err := url.Parse(config.Endpoint); err != nil {
+			return nil, fmt.Errorf("S3 fetcher: endpoint: %v", err)
+		}
+		awsConfig = awsConfig.WithEndpoint(config.Endpoint)
+	}
+	if config.AccessKeyID != "" {
+		awsConfig = awsConfig.WithCredentials(credentials.NewStaticCredentials(config.AccessKeyID, config.SecretAccessKey, ""))
+	}
+
+	awsSession, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, fmt.Errorf("S3 fetcher: session: %v", err)
+	}
+
+	return s3SourceFetcher{
+		client: s3.New(awsSession),
+	}, nil
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+)
+
+const (
+	sftpDefaultPort = "22"
+)
+
+var _ SourceFetcher = (*sftpSourceFetcher)(nil)
+
+// SFTPFetcherConfig keeps SFTP servers connection parameters.
+type SFTPFetcherConfig struct {
+	// SSH user
+	User string
+	// Password auth (optional if PrivateKeyPath is set)
+	Password string
+	// Private key auth: PEM file path (optional if Password is set)
+	PrivateKeyPath string
+	// OpenSSH known_hosts file path used to verify server host keys
+	KnownHostsPath string
+	// Skip server host key verification (KnownHostsPath is ignored), for testing purposes only
+	InsecureIgnoreHostKey bool
+	// Connection establishment timeout (0 - no limit)
+	ConnectTimeout time.Duration
+}
+
+// sftpSourceFetcher implements SourceFetcher interface for SFTP servers files (sftp://host[:port]/path).
+type sftpSourceFetcher struct {
+	clientConfig   *ssh.ClientConfig
+	connectTimeout time.Duration
+}
+
+// sftpSourceReader implements SourceReader interface for SFTP files.
+// Connection is closed with the reader (or on context cancel).
+type sftpSourceReader struct {
+	*sftp.File
+	sftpClient *sftp.Client
+	sshClient  *ssh.Client
+	stopCh     chan struct{}
+	stopOnce   sync.Once
+}
+
+// Meta implements SourceReader interface.
+func (r *sftpSourceReader) Meta() SourceMeta {
+	return SourceMeta{}
+}
+
+// Close implements io.Closer interface.
+func (r *sftpSourceReader) Close() error {
+	r.stopOnce.Do(func() { close(r.stopCh) })
+	r.File.Close()
+	r.sftpClient.Close()
+
+	return r.sshClient.Close()
+}
+
+// Schemes implements SourceFetcher interface.
+func (f sftpSourceFetcher) Schemes() []string {
+	return []string{"sftp"}
+}
+
+// Fetch implements SourceFetcher interface.
+func (f sftpSourceFetcher) Fetch(ctx context.Context, sourceURL *url.URL, restart func() error) (SourceReader, error) {
+	if err := checkSourceURLCredentials(sourceURL); err != nil {
+		return nil, err
+	}
+	if sourceURL.Hostname() == "" {
+		return nil, fmt.Errorf("%w: path invalid: host: empty", common.ErrInvalidInput)
+	}
+	if sourceURL.Path == "" || sourceURL.Path == "/" {
+		return nil, fmt.Errorf("%w: path invalid: file path: empty", common.ErrInvalidInput)
+	}
+
+	port := sourceURL.Port()
+	if port == "" {
+		port = sftpDefaultPort
+	}
+	addr := net.JoinHostPort(sourceURL.Hostname(), port)
+
+	// connect
+	dialer := net.Dialer{Timeout: f.connectTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("SFTP %s: dial failed: %v", addr, err)
+	}
+	if f.connectTimeout > 0 {
+		_ = conn.SetDeadline(time.Now().Add(f.connectTimeout))
+	}
+
+	sshConn, sshChans, sshReqs, err := ssh.NewClientConn(conn, addr, f.clientConfig)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("SFTP %s: SSH handshake failed: %v", addr, err)
+	}
+	_ = conn.SetDeadline(time.Time{})
+	sshClient := ssh.NewClient(sshConn, sshChans, sshReqs)
+
+	sftpClient, err := sftp.NewClient(sshClient)
+	if err != nil {
+		sshClient.Close()
+		return nil, fmt.Errorf("SFTP %s: session failed: %v", addr, err)
+	}
+
+	// open file
+	file, err := sftpClient.Open(sourceURL.Path)
+	if err != nil {
+		sftpClient.Close()
+		sshClient.Close()
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%w: SFTP %s: file %s", common.ErrNotFound, addr, sourceURL.Path)
+		}
+		return nil, fmt.Errorf("SFTP %s: file %s open failed: %v", addr, sourceURL.Path, err)
+	}
+
+	// close connection on context cancel (SFTP reads don't support context)
+	reader := &sftpSourceReader{
+		File:       file,
+		sftpClient: sftpClient,
+		sshClient:  sshClient,
+		stopCh:     make(chan struct{}),
+	}
+	go func() {
+		select {
+		case <-ctx.Done():
+			sshClient.Close()
+		case <-reader.stopCh:
+		}
+	}()
+
+	return reader, nil
+}
+
+// NewSFTPSourceFetcher creates a new SFTP servers SourceFetcher.
+func NewSFTPSourceFetcher(config SFTPFetcherConfig) (SourceFetcher, error) {
+	if config.User == "" {
+		return nil, fmt.Errorf("SFTP fetcher: user: empty")
+	}
+	if config.Password == "" && config.PrivateKeyPath == "" {
+		return nil, fmt.Errorf("SFTP fetcher: password or privateKeyPath should be set")
+	}
+	if config.KnownHostsPath == "" && !config.InsecureIgnoreHostKey {
+		return nil, fmt.Errorf("SFTP fetcher: knownHostsPath: empty (host key verification can't be skipped implicitly)")
+	}
+	if config.ConnectTimeout < 0 {
+		return nil, fmt.Errorf("SFTP fetcher: connectTimeout: should be GTE 0")
+	}
+
+	clientConfig := &ssh.ClientConfig{
+		User:    config.User,
+		Timeout: config.ConnectTimeout,
+	}
+
+	if config.PrivateKeyPath != "" {
+		keyBytes, err := ioutil.ReadFile(config.PrivateKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("SFTP fetcher: reading private key: %v", err)
+		}
+		signer, err := ssh.ParsePrivateKey(keyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("SFTP fetcher: parsing private key: %v", err)
+		}
+		clientConfig.Auth = append(clientConfig.Auth, ssh.PublicKeys(signer))
+	}
+	if config.Password != "" {
+		clientConfig.Auth = append(clientConfig.Auth, ssh.Password(config.Password))
+	}
+
+	if config.InsecureIgnoreHostKey {
+		clientConfig.HostKeyCallback = ssh.InsecureIgnoreHostKey() // nolint:gosec
+	} else {
+		hostKeyCallback, err := knownhosts.New(config.KnownHostsPath)
+		if err != nil {
+			return nil, fmt.Errorf("SFTP fetcher: reading known hosts: %v", err)
+		}
+		clientConfig.HostKeyCallback = hostKeyCallback
+	}
+
+	return sftpSourceFetcher{
+		clientConfig:   clientConfig,
+		connectTimeout: config.ConnectTimeout,
+	}, nil
+}
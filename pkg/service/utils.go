@@ -0,0 +1,42 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+)
+
+// contentHasher is an io.Writer which calculates SHA-256 hash and size of the written content.
+type contentHasher struct {
+	hash hash.Hash
+	size int64
+}
+
+// Write implements io.Writer interface.
+func (h *contentHasher) Write(p []byte) (int, error) {
+	h.size += int64(len(p))
+	return h.hash.Write(p)
+}
+
+// Size returns written content size.
+func (h *contentHasher) Size() int64 {
+	return h.size
+}
+
+// Hash returns written content hash (HEX).
+func (h *contentHasher) Hash() string {
+	return hex.EncodeToString(h.hash.Sum(nil))
+}
+
+// Reset drops written content state.
+func (h *contentHasher) Reset() {
+	h.hash.Reset()
+	h.size = 0
+}
+
+// newContentHasher creates a new contentHasher object.
+func newContentHasher() *contentHasher {
+	return &contentHasher{
+		hash: sha256.New(),
+	}
+}
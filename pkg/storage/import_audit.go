@@ -0,0 +1,87 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+	"github.com/itiky/mdb-tutorial/pkg/model"
+)
+
+var _ ImportAuditStorage = (*importAuditStorage)(nil)
+
+// importAuditStorage keeps ImportAuditStorage dependencies.
+type importAuditStorage struct {
+	storageCommon
+	mdbCollection *mongo.Collection
+}
+
+// Create implements ImportAuditStorage interface.
+func (s importAuditStorage) Create(ctx context.Context, entry model.ImportAuditEntry) (createdID primitive.ObjectID, retErr error) {
+	if entry.Action == "" {
+		retErr = fmt.Errorf("%w: action: can not be empty", common.ErrInvalidInput)
+		return
+	}
+	if entry.Actor == "" {
+		retErr = fmt.Errorf("%w: actor: can not be empty", common.ErrInvalidInput)
+		return
+	}
+
+	entry.ID = primitive.NewObjectID()
+	entry.CreatedAt = time.Now().UTC()
+	if entry.Timestamps == nil {
+		entry.Timestamps = []time.Time{}
+	}
+
+	res, err := s.mdbCollection.InsertOne(ctx, entry)
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	id, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		retErr = fmt.Errorf("res.InsertedID type convertion failed: %T", res.InsertedID)
+		return
+	}
+	createdID = id
+
+	return
+}
+
+// GetAll implements ImportAuditStorage interface.
+// nolint:govet
+func (s importAuditStorage) GetAll(ctx context.Context, paginationOption common.PaginationOption) (retObjs []model.ImportAuditEntry, retErr error) {
+	findOpts := options.Find().
+		SetSort(bson.D{{"created_at", -1}, {"_id", -1}}).
+		SetSkip(int64(paginationOption.Skip)).
+		SetLimit(int64(paginationOption.Limit))
+
+	cursor, err := s.mdbCollection.Find(ctx, bson.D{}, findOpts)
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	err = cursorIterateAndDecode(ctx, cursor, func(curCursor *mongo.Cursor) error {
+		var entry model.ImportAuditEntry
+		if err := curCursor.Decode(&entry); err != nil {
+			return err
+		}
+		retObjs = append(retObjs, entry)
+
+		return nil
+	})
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	return
+}
@@ -0,0 +1,158 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+	"github.com/itiky/mdb-tutorial/pkg/model"
+	"github.com/itiky/mdb-tutorial/pkg/testutils"
+	"github.com/itiky/mdb-tutorial/pkg/testutils/fixtures"
+)
+
+func (s *StorageTestSuite) TestStorage_ImportAudit() {
+	t := s.T()
+	ctx := context.Background()
+
+	client := testutils.PrepareMongoDBFixtures(ctx, s.r, fixtures.NewEmptyMongoDBFixtures())
+	storage, err := NewStorage(
+		WithDatabase(testutils.TestMongoDBDatabase),
+		WithMongoDBClient(client),
+	)
+	require.NoError(t, err)
+	targetSt := storage.ImportAudit()
+
+	// check GetAll: empty
+	{
+		entries, err := targetSt.GetAll(ctx, common.NewPaginationOption(0, 10))
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	}
+
+	// check Create: invalid input
+	{
+		_, err := targetSt.Create(ctx, model.ImportAuditEntry{Actor: "user"})
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSt.Create(ctx, model.ImportAuditEntry{Action: model.ImportAuditActionDelete})
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check Create / GetAll: newest first
+	timestamp := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	{
+		id1, err := targetSt.Create(ctx, model.ImportAuditEntry{
+			Action:              model.ImportAuditActionDelete,
+			Actor:               "user1",
+			Timestamps:          []time.Time{timestamp},
+			DeletedPriceImports: 2,
+		})
+		require.NoError(t, err)
+
+		id2, err := targetSt.Create(ctx, model.ImportAuditEntry{
+			Action: model.ImportAuditActionDelete,
+			Actor:  "user2",
+			Reason: "bad data",
+			JobID:  "job",
+		})
+		require.NoError(t, err)
+
+		entries, err := targetSt.GetAll(ctx, common.NewPaginationOption(0, 10))
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		require.Equal(t, id2, entries[0].ID)
+		require.Equal(t, "bad data", entries[0].Reason)
+		require.Empty(t, entries[0].Timestamps)
+		require.Equal(t, id1, entries[1].ID)
+		require.Len(t, entries[1].Timestamps, 1)
+		require.True(t, timestamp.Equal(entries[1].Timestamps[0]))
+		require.EqualValues(t, 2, entries[1].DeletedPriceImports)
+		require.False(t, entries[1].CreatedAt.IsZero())
+
+		entries, err = targetSt.GetAll(ctx, common.NewPaginationOption(1, 10))
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, id1, entries[0].ID)
+	}
+}
+
+func (s *StorageTestSuite) TestStorage_DeleteImport() {
+	t := s.T()
+	ctx := context.Background()
+
+	client := testutils.PrepareMongoDBFixtures(ctx, s.r, fixtures.NewEmptyMongoDBFixtures())
+	storage, err := NewStorage(
+		WithDatabase(testutils.TestMongoDBDatabase),
+		WithMongoDBClient(client),
+	)
+	require.NoError(t, err)
+
+	timestamp1 := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	timestamp2 := timestamp1.Add(time.Second)
+
+	productIDs, err := storage.Product().BulkUpsertByNames(ctx, []string{"P1", "P2"})
+	require.NoError(t, err)
+
+	// P1 has prices of both imports, P2 of the 1st one only
+	_, err = storage.PriceImport().BulkUpsertByProductIDAndTimestamp(ctx, []model.PricesImport{
+		{ProductID: productIDs["P1"], Timestamp: timestamp1, Prices: []model.Price{model.NewPrice(model.MustParseMoney("1", "USD"))}},
+		{ProductID: productIDs["P2"], Timestamp: timestamp1, Prices: []model.Price{model.NewPrice(model.MustParseMoney("2", "USD"))}},
+		{ProductID: productIDs["P1"], Timestamp: timestamp2, Prices: []model.Price{model.NewPrice(model.MustParseMoney("3", "USD"))}},
+	})
+	require.NoError(t, err)
+
+	_, err = storage.ImportLedger().Create(ctx, model.ImportLedgerEntry{Source: model.ImportSource{ContentHash: "hash"}, Timestamp: timestamp1})
+	require.NoError(t, err)
+
+	// check DeleteByTimeRange: invalid input
+	{
+		_, _, err := storage.PriceImport().DeleteByTimeRange(ctx, time.Time{}, timestamp1)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, _, err = storage.PriceImport().DeleteByTimeRange(ctx, timestamp1, timestamp1)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = storage.ImportLedger().DeleteByTimeRange(ctx, timestamp2, timestamp1)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check DeleteByTimeRange: the 1st import
+	{
+		deletedCnt, ids, err := storage.PriceImport().DeleteByTimeRange(ctx, timestamp1, timestamp2)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, deletedCnt)
+		require.ElementsMatch(t, []primitive.ObjectID{productIDs["P1"], productIDs["P2"]}, ids)
+
+		imports, err := storage.PriceImport().GetAll(ctx, time.Time{}, "")
+		require.NoError(t, err)
+		require.Len(t, imports, 1)
+		require.True(t, timestamp2.Equal(imports[0].Timestamp))
+
+		deletedCnt, err = storage.ImportLedger().DeleteByTimeRange(ctx, timestamp1, timestamp2)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, deletedCnt)
+
+		_, err = storage.ImportLedger().GetLatestByContentHash(ctx, "hash")
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+
+	// check DeleteOrphans: P1 is still referenced
+	{
+		deletedCnt, err := storage.Product().DeleteOrphans(ctx, nil)
+		require.NoError(t, err)
+		require.Zero(t, deletedCnt)
+
+		deletedCnt, err = storage.Product().DeleteOrphans(ctx, []primitive.ObjectID{productIDs["P1"], productIDs["P2"]})
+		require.NoError(t, err)
+		require.EqualValues(t, 1, deletedCnt)
+
+		products, err := storage.Product().GetAll(ctx)
+		require.NoError(t, err)
+		require.Len(t, products, 1)
+		require.Equal(t, productIDs["P1"], products[0].ID)
+	}
+}
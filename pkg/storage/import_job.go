@@ -0,0 +1,259 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+	"github.com/itiky/mdb-tutorial/pkg/model"
+)
+
+var _ ImportJobStorage = (*importJobStorage)(nil)
+
+// finalImportJobStates are states of finished import jobs.
+var finalImportJobStates = []model.ImportJobState{
+	model.ImportJobStateDone,
+	model.ImportJobStateFailed,
+	model.ImportJobStateSkipped,
+	model.ImportJobStateRolledBack,
+}
+
+// importJobStorage keeps ImportJobStorage dependencies.
+type importJobStorage struct {
+	storageCommon
+	mdbCollection *mongo.Collection
+}
+
+// Create implements ImportJobStorage interface.
+func (s importJobStorage) Create(ctx context.Context, job model.ImportJob) (createdID primitive.ObjectID, retErr error) {
+	if job.URL == "" {
+		retErr = fmt.Errorf("%w: url: can not be empty", common.ErrInvalidInput)
+		return
+	}
+
+	now := time.Now().UTC()
+	job.ID = primitive.NewObjectID()
+	if job.State == "" {
+		job.State = model.ImportJobStateQueued
+	}
+	if job.ChunkErrors == nil {
+		job.ChunkErrors = []model.ImportChunkError{}
+	}
+	if job.ArchiveEntries == nil {
+		job.ArchiveEntries = []model.ImportArchiveEntry{}
+	}
+	job.CreatedAt, job.UpdatedAt = now, now
+
+	res, err := s.mdbCollection.InsertOne(ctx, job)
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	id, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		retErr = fmt.Errorf("res.InsertedID type convertion failed: %T", res.InsertedID)
+		return
+	}
+	createdID = id
+
+	return
+}
+
+// GetByID implements ImportJobStorage interface.
+func (s importJobStorage) GetByID(ctx context.Context, id string) (retObj model.ImportJob, retErr error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		retErr = fmt.Errorf("%w: id: %v", common.ErrInvalidInput, err)
+		return
+	}
+
+	filter := bson.M{"_id": objectID}
+	res := s.mdbCollection.FindOne(ctx, filter)
+	if err := singleResultDecode(res, &retObj); err != nil {
+		retErr = err
+		return
+	}
+
+	return
+}
+
+// GetAll implements ImportJobStorage interface.
+// nolint:govet
+func (s importJobStorage) GetAll(ctx context.Context, paginationOption common.PaginationOption) (retObjs []model.ImportJob, retErr error) {
+	findOpts := options.Find().
+		SetSort(bson.D{{"created_at", -1}, {"_id", -1}}).
+		SetSkip(int64(paginationOption.Skip)).
+		SetLimit(int64(paginationOption.Limit))
+
+	cursor, err := s.mdbCollection.Find(ctx, bson.D{}, findOpts)
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	err = cursorIterateAndDecode(ctx, cursor, func(curCursor *mongo.Cursor) error {
+		var job model.ImportJob
+		if err := curCursor.Decode(&job); err != nil {
+			return err
+		}
+		retObjs = append(retObjs, job)
+
+		return nil
+	})
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	return
+}
+
+// SetState implements ImportJobStorage interface.
+func (s importJobStorage) SetState(ctx context.Context, id primitive.ObjectID, state model.ImportJobState, errMsg string) error {
+	if state == "" {
+		return fmt.Errorf("%w: state: can not be empty", common.ErrInvalidInput)
+	}
+
+	update := bson.M{"$set": bson.M{
+		"state":      state,
+		"error":      errMsg,
+		"updated_at": time.Now().UTC(),
+	}}
+
+	return s.updateByID(ctx, id, update)
+}
+
+// SetImportSource implements ImportJobStorage interface.
+func (s importJobStorage) SetImportSource(ctx context.Context, id primitive.ObjectID, timestamp time.Time, source model.ImportSource) error {
+	if timestamp.IsZero() {
+		return fmt.Errorf("%w: timestamp: can not be empty", common.ErrInvalidInput)
+	}
+
+	update := bson.M{"$set": bson.M{
+		"import_timestamp": timestamp,
+		"source":           source,
+		"updated_at":       time.Now().UTC(),
+	}}
+
+	return s.updateByID(ctx, id, update)
+}
+
+// AddChunkResult implements ImportJobStorage interface.
+func (s importJobStorage) AddChunkResult(ctx context.Context, id primitive.ObjectID, result model.ImportChunkResult) error {
+	inc := bson.M{
+		"progress.chunks_processed": 1,
+	}
+	update := bson.M{
+		"$set": bson.M{"updated_at": time.Now().UTC()},
+	}
+
+	if result.IsFailed() {
+		inc["progress.chunks_failed"] = 1
+		update["$push"] = bson.M{"chunk_errors": result.Error}
+	}
+	// chunk entries are imported even if some rows weren't parsed
+	if !result.IsFailed() || result.Error.ExecutionError == "" {
+		inc["progress.entries_processed"] = result.Entries
+	}
+	update["$inc"] = inc
+
+	return s.updateByID(ctx, id, update)
+}
+
+// AddArchiveEntry implements ImportJobStorage interface.
+func (s importJobStorage) AddArchiveEntry(ctx context.Context, id primitive.ObjectID, entry model.ImportArchiveEntry) error {
+	if entry.Name == "" {
+		return fmt.Errorf("%w: entry.Name: can not be empty", common.ErrInvalidInput)
+	}
+	if entry.ImportTimestamp.IsZero() {
+		return fmt.Errorf("%w: entry.ImportTimestamp: can not be empty", common.ErrInvalidInput)
+	}
+
+	update := bson.M{
+		"$set":  bson.M{"updated_at": time.Now().UTC()},
+		"$push": bson.M{"archive_entries": entry},
+	}
+
+	return s.updateByID(ctx, id, update)
+}
+
+// Heartbeat implements ImportJobStorage interface.
+func (s importJobStorage) Heartbeat(ctx context.Context, id primitive.ObjectID) error {
+	if id.IsZero() {
+		return fmt.Errorf("%w: id: can not be empty", common.ErrInvalidInput)
+	}
+
+	// finished jobs are not updated (job might be finished concurrently)
+	filter := bson.M{
+		"_id":   id,
+		"state": bson.M{"$nin": finalImportJobStates},
+	}
+	update := bson.M{"$set": bson.M{
+		"updated_at": time.Now().UTC(),
+	}}
+
+	if _, err := s.mdbCollection.UpdateOne(ctx, filter, update); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FailUnfinished implements ImportJobStorage interface.
+func (s importJobStorage) FailUnfinished(ctx context.Context, owner string, expiredBefore time.Time, errMsg string) (retUpdated int64, retErr error) {
+	if owner == "" && expiredBefore.IsZero() {
+		retErr = fmt.Errorf("%w: owner / expiredBefore: both can not be empty", common.ErrInvalidInput)
+		return
+	}
+
+	conditions := bson.A{}
+	if owner != "" {
+		// jobs created before owners were recorded have no owner field
+		conditions = append(conditions, bson.M{"owner": bson.M{"$in": bson.A{owner, nil}}})
+	}
+	if !expiredBefore.IsZero() {
+		conditions = append(conditions, bson.M{"updated_at": bson.M{"$lt": expiredBefore}})
+	}
+	filter := bson.M{
+		"state": bson.M{"$nin": finalImportJobStates},
+		"$or":   conditions,
+	}
+	update := bson.M{"$set": bson.M{
+		"state":      model.ImportJobStateFailed,
+		"error":      errMsg,
+		"updated_at": time.Now().UTC(),
+	}}
+
+	res, err := s.mdbCollection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		retErr = err
+		return
+	}
+	retUpdated = res.ModifiedCount
+
+	return
+}
+
+// updateByID updates a single import job and checks it exists.
+func (s importJobStorage) updateByID(ctx context.Context, id primitive.ObjectID, update bson.M) error {
+	if id.IsZero() {
+		return fmt.Errorf("%w: id: can not be empty", common.ErrInvalidInput)
+	}
+
+	res, err := s.mdbCollection.UpdateOne(ctx, bson.M{"_id": id}, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return common.ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,231 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+	"github.com/itiky/mdb-tutorial/pkg/model"
+	"github.com/itiky/mdb-tutorial/pkg/testutils"
+	"github.com/itiky/mdb-tutorial/pkg/testutils/fixtures"
+)
+
+func (s *StorageTestSuite) TestStorage_ImportJob() {
+	t := s.T()
+	ctx := context.Background()
+
+	client := testutils.PrepareMongoDBFixtures(ctx, s.r, fixtures.NewEmptyMongoDBFixtures())
+	storage, err := NewStorage(
+		WithDatabase(testutils.TestMongoDBDatabase),
+		WithMongoDBClient(client),
+	)
+	require.NoError(t, err)
+	targetSt := storage.ImportJob()
+
+	pageOpt := common.NewPaginationOption(0, 100)
+
+	// check GetAll: empty
+	{
+		resp, err := targetSt.GetAll(ctx, pageOpt)
+		require.NoError(t, err)
+		require.Empty(t, resp)
+	}
+
+	// check GetByID: invalid ObjectID
+	{
+		_, err := targetSt.GetByID(ctx, "invalid")
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check GetByID: non-existing ObjectID
+	{
+		_, err := targetSt.GetByID(ctx, primitive.NewObjectID().Hex())
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+
+	// check Create: invalid input
+	{
+		_, err := targetSt.Create(ctx, model.ImportJob{})
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check Create: ok
+	var jobID primitive.ObjectID
+	{
+		id, err := targetSt.Create(ctx, model.ImportJob{URL: "http://localhost/1.csv"})
+		require.NoError(t, err)
+		require.False(t, id.IsZero())
+		jobID = id
+
+		job, err := targetSt.GetByID(ctx, id.Hex())
+		require.NoError(t, err)
+		require.Equal(t, "http://localhost/1.csv", job.URL)
+		require.Equal(t, model.ImportJobStateQueued, job.State)
+		require.False(t, job.CreatedAt.IsZero())
+		require.Empty(t, job.ChunkErrors)
+	}
+
+	// check SetState / SetImportSource: non-existing
+	{
+		err := targetSt.SetState(ctx, primitive.NewObjectID(), model.ImportJobStateDone, "")
+		require.True(t, errors.Is(err, common.ErrNotFound))
+
+		err = targetSt.SetImportSource(ctx, primitive.NewObjectID(), time.Now(), model.ImportSource{})
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+
+	// check SetImportSource: ok
+	importTimestamp := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	importSource := model.ImportSource{
+		URL:         "http://localhost/1.csv",
+		ETag:        `"etag"`,
+		Size:        100,
+		ContentHash: "hash",
+	}
+	{
+		err := targetSt.SetImportSource(ctx, jobID, importTimestamp, importSource)
+		require.NoError(t, err)
+	}
+
+	// check AddChunkResult: succeeded and failed chunks
+	{
+		err := targetSt.AddChunkResult(ctx, jobID, model.ImportChunkResult{ChunkID: 1, Entries: 3})
+		require.NoError(t, err)
+
+		err = targetSt.AddChunkResult(ctx, jobID, model.ImportChunkResult{
+			ChunkID: 2,
+			Entries: 2,
+			Error: &model.ImportChunkError{
+				ChunkID:        2,
+				ParsingErrors:  []string{"parsing line [5]: invalid row length (3)"},
+				ExecutionError: "",
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	// check AddArchiveEntry: invalid input and ok
+	archiveEntry := model.ImportArchiveEntry{Name: "prices/1.csv", ImportTimestamp: importTimestamp}
+	{
+		err := targetSt.AddArchiveEntry(ctx, jobID, model.ImportArchiveEntry{})
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		err = targetSt.AddArchiveEntry(ctx, jobID, archiveEntry)
+		require.NoError(t, err)
+	}
+
+	// check SetState: failed
+	{
+		err := targetSt.SetState(ctx, jobID, model.ImportJobStateFailed, "partially processed")
+		require.NoError(t, err)
+	}
+
+	// check GetByID: updated
+	{
+		job, err := targetSt.GetByID(ctx, jobID.Hex())
+		require.NoError(t, err)
+		require.Equal(t, model.ImportJobStateFailed, job.State)
+		require.Equal(t, "partially processed", job.Error)
+		require.True(t, importTimestamp.Equal(job.ImportTimestamp))
+		require.Equal(t, importSource, job.Source)
+		require.Equal(t, 2, job.Progress.ChunksProcessed)
+		require.Equal(t, 1, job.Progress.ChunksFailed)
+		require.Equal(t, 5, job.Progress.EntriesProcessed)
+		require.Len(t, job.ChunkErrors, 1)
+		require.Equal(t, 2, job.ChunkErrors[0].ChunkID)
+		require.Len(t, job.ChunkErrors[0].ParsingErrors, 1)
+		require.Len(t, job.ArchiveEntries, 1)
+		require.Equal(t, archiveEntry.Name, job.ArchiveEntries[0].Name)
+		require.True(t, archiveEntry.ImportTimestamp.Equal(job.ArchiveEntries[0].ImportTimestamp))
+	}
+
+	// check GetAll: newest first
+	{
+		id, err := targetSt.Create(ctx, model.ImportJob{URL: "http://localhost/2.csv"})
+		require.NoError(t, err)
+
+		resp, err := targetSt.GetAll(ctx, pageOpt)
+		require.NoError(t, err)
+		require.Len(t, resp, 2)
+		require.Equal(t, id, resp[0].ID)
+		require.Equal(t, jobID, resp[1].ID)
+
+		resp, err = targetSt.GetAll(ctx, common.NewPaginationOption(1, 100))
+		require.NoError(t, err)
+		require.Len(t, resp, 1)
+		require.Equal(t, jobID, resp[0].ID)
+	}
+
+	// check FailUnfinished: only unfinished jobs of the owner are failed
+	{
+		_, err := targetSt.FailUnfinished(ctx, "", time.Time{}, "interrupted")
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		ownedID, err := targetSt.Create(ctx, model.ImportJob{URL: "http://localhost/3.csv", Owner: "server_1", State: model.ImportJobStateProcessing})
+		require.NoError(t, err)
+		doneID, err := targetSt.Create(ctx, model.ImportJob{URL: "http://localhost/4.csv", Owner: "server_1", State: model.ImportJobStateDone})
+		require.NoError(t, err)
+		otherID, err := targetSt.Create(ctx, model.ImportJob{URL: "http://localhost/5.csv", Owner: "server_2", State: model.ImportJobStateDownloading})
+		require.NoError(t, err)
+
+		failed, err := targetSt.FailUnfinished(ctx, "server_1", time.Now().UTC().Add(-time.Hour), "interrupted")
+		require.NoError(t, err)
+		require.EqualValues(t, 1, failed)
+
+		for id, expState := range map[primitive.ObjectID]model.ImportJobState{
+			ownedID: model.ImportJobStateFailed,
+			doneID:  model.ImportJobStateDone,
+			otherID: model.ImportJobStateDownloading,
+		} {
+			job, err := targetSt.GetByID(ctx, id.Hex())
+			require.NoError(t, err)
+			require.Equal(t, expState, job.State, job.URL)
+		}
+
+		job, err := targetSt.GetByID(ctx, ownedID.Hex())
+		require.NoError(t, err)
+		require.Equal(t, "interrupted", job.Error)
+	}
+
+	// check Heartbeat and FailUnfinished: jobs of any owner with expired leases are failed
+	{
+		require.True(t, errors.Is(targetSt.Heartbeat(ctx, primitive.NilObjectID), common.ErrInvalidInput))
+
+		expiredID, err := targetSt.Create(ctx, model.ImportJob{URL: "http://localhost/6.csv", Owner: "server_3", State: model.ImportJobStateProcessing})
+		require.NoError(t, err)
+		aliveID, err := targetSt.Create(ctx, model.ImportJob{URL: "http://localhost/7.csv", Owner: "server_3", State: model.ImportJobStateProcessing})
+		require.NoError(t, err)
+
+		time.Sleep(10 * time.Millisecond)
+		expiredBefore := time.Now().UTC()
+		time.Sleep(10 * time.Millisecond)
+		require.NoError(t, targetSt.Heartbeat(ctx, aliveID))
+
+		failed, err := targetSt.FailUnfinished(ctx, "", expiredBefore, "expired")
+		require.NoError(t, err)
+		// unfinished jobs created above (2.csv, server_2 5.csv) have expired too
+		require.EqualValues(t, 3, failed)
+
+		job, err := targetSt.GetByID(ctx, expiredID.Hex())
+		require.NoError(t, err)
+		require.Equal(t, model.ImportJobStateFailed, job.State)
+		require.Equal(t, "expired", job.Error)
+
+		job, err = targetSt.GetByID(ctx, aliveID.Hex())
+		require.NoError(t, err)
+		require.Equal(t, model.ImportJobStateProcessing, job.State)
+
+		// finished jobs are not renewed
+		require.NoError(t, targetSt.Heartbeat(ctx, expiredID))
+		job, err = targetSt.GetByID(ctx, expiredID.Hex())
+		require.NoError(t, err)
+		require.Equal(t, model.ImportJobStateFailed, job.State)
+	}
+}
@@ -0,0 +1,125 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+	"github.com/itiky/mdb-tutorial/pkg/model"
+)
+
+var _ ImportLedgerStorage = (*importLedgerStorage)(nil)
+
+// importLedgerStorage keeps ImportLedgerStorage dependencies.
+type importLedgerStorage struct {
+	storageCommon
+	mdbCollection *mongo.Collection
+}
+
+// Create implements ImportLedgerStorage interface.
+func (s importLedgerStorage) Create(ctx context.Context, entry model.ImportLedgerEntry) (createdID primitive.ObjectID, retErr error) {
+	if entry.Source.ContentHash == "" {
+		retErr = fmt.Errorf("%w: source.content_hash: can not be empty", common.ErrInvalidInput)
+		return
+	}
+	if entry.Timestamp.IsZero() {
+		retErr = fmt.Errorf("%w: timestamp: can not be empty", common.ErrInvalidInput)
+		return
+	}
+
+	entry.ID = primitive.NewObjectID()
+	entry.CreatedAt = time.Now().UTC()
+
+	res, err := s.mdbCollection.InsertOne(ctx, entry)
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			retErr = fmt.Errorf("%w: content %s has already been claimed", common.ErrAlreadyExists, entry.Source.ContentHash)
+			return
+		}
+		retErr = err
+		return
+	}
+
+	id, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		retErr = fmt.Errorf("res.InsertedID type convertion failed: %T", res.InsertedID)
+		return
+	}
+	createdID = id
+
+	return
+}
+
+// Complete implements ImportLedgerStorage interface.
+func (s importLedgerStorage) Complete(ctx context.Context, id primitive.ObjectID) error {
+	res, err := s.mdbCollection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{"pending": false}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("%w: import ledger entry %s", common.ErrNotFound, id.Hex())
+	}
+
+	return nil
+}
+
+// DeletePending implements ImportLedgerStorage interface.
+func (s importLedgerStorage) DeletePending(ctx context.Context, id primitive.ObjectID) (retDeleted bool, retErr error) {
+	res, err := s.mdbCollection.DeleteOne(ctx, bson.M{"_id": id, "pending": true})
+	if err != nil {
+		retErr = err
+		return
+	}
+	retDeleted = res.DeletedCount > 0
+
+	return
+}
+
+// DeleteByTimeRange implements ImportLedgerStorage interface.
+func (s importLedgerStorage) DeleteByTimeRange(ctx context.Context, from, to time.Time) (retDeleted int64, retErr error) {
+	if from.IsZero() || !to.After(from) {
+		retErr = fmt.Errorf("%w: time range [%s, %s): invalid", common.ErrInvalidInput, from, to)
+		return
+	}
+
+	res, err := s.mdbCollection.DeleteMany(ctx, bson.M{"timestamp": bson.M{"$gte": from, "$lt": to}})
+	if err != nil {
+		retErr = err
+		return
+	}
+	retDeleted = res.DeletedCount
+
+	return
+}
+
+// GetLatestByContentHash implements ImportLedgerStorage interface.
+// nolint:govet
+func (s importLedgerStorage) GetLatestByContentHash(ctx context.Context, contentHash string) (retObj model.ImportLedgerEntry, retErr error) {
+	filter := bson.M{"source.content_hash": contentHash, "pending": bson.M{"$ne": true}}
+	findOpts := options.FindOne().SetSort(bson.D{{"timestamp", -1}})
+
+	res := s.mdbCollection.FindOne(ctx, filter, findOpts)
+	if err := singleResultDecode(res, &retObj); err != nil {
+		retErr = err
+		return
+	}
+
+	return
+}
+
+// GetClaimByContentHash implements ImportLedgerStorage interface.
+func (s importLedgerStorage) GetClaimByContentHash(ctx context.Context, contentHash string) (retObj model.ImportLedgerEntry, retErr error) {
+	res := s.mdbCollection.FindOne(ctx, bson.M{"source.content_hash": contentHash, "forced": false})
+	if err := singleResultDecode(res, &retObj); err != nil {
+		retErr = err
+		return
+	}
+
+	return
+}
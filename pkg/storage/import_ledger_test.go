@@ -0,0 +1,135 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+	"github.com/itiky/mdb-tutorial/pkg/model"
+	"github.com/itiky/mdb-tutorial/pkg/testutils"
+	"github.com/itiky/mdb-tutorial/pkg/testutils/fixtures"
+)
+
+func (s *StorageTestSuite) TestStorage_ImportLedger() {
+	t := s.T()
+	ctx := context.Background()
+
+	client := testutils.PrepareMongoDBFixtures(ctx, s.r, fixtures.NewEmptyMongoDBFixtures())
+	storage, err := NewStorage(
+		WithDatabase(testutils.TestMongoDBDatabase),
+		WithMongoDBClient(client),
+	)
+	require.NoError(t, err)
+	targetSt := storage.ImportLedger()
+
+	collection := client.Database(testutils.TestMongoDBDatabase).Collection(ImportLedgerCollection)
+	require.NoError(t, createPartialIndex(ctx, collection, ImportLedgerContentHashIndex, bson.D{{"source.content_hash", 1}}, true, bson.D{{"forced", false}})) // nolint:govet
+
+	source := model.ImportSource{
+		URL:         "http://localhost/1.csv",
+		ETag:        `"etag"`,
+		Size:        100,
+		ContentHash: "hash",
+	}
+
+	// check GetLatestByContentHash: non-existing
+	{
+		_, err := targetSt.GetLatestByContentHash(ctx, source.ContentHash)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+
+	// check Create: invalid input
+	{
+		_, err := targetSt.Create(ctx, model.ImportLedgerEntry{Timestamp: time.Now()})
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSt.Create(ctx, model.ImportLedgerEntry{Source: source})
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check Create: 1st and 2nd (forced) imports
+	timestamp1 := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	timestamp2 := time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC)
+	{
+		id, err := targetSt.Create(ctx, model.ImportLedgerEntry{Source: source, Timestamp: timestamp1})
+		require.NoError(t, err)
+		require.False(t, id.IsZero())
+
+		id, err = targetSt.Create(ctx, model.ImportLedgerEntry{Source: source, Timestamp: timestamp2, Forced: true})
+		require.NoError(t, err)
+		require.False(t, id.IsZero())
+	}
+
+	// check Create: the content hash is already claimed
+	{
+		_, err := targetSt.Create(ctx, model.ImportLedgerEntry{Source: source, Timestamp: timestamp2})
+		require.True(t, errors.Is(err, common.ErrAlreadyExists))
+	}
+
+	// check GetLatestByContentHash: the latest one
+	{
+		entry, err := targetSt.GetLatestByContentHash(ctx, source.ContentHash)
+		require.NoError(t, err)
+		require.Equal(t, source, entry.Source)
+		require.True(t, timestamp2.Equal(entry.Timestamp))
+		require.True(t, entry.Forced)
+	}
+
+	// check GetClaimByContentHash
+	{
+		entry, err := targetSt.GetClaimByContentHash(ctx, source.ContentHash)
+		require.NoError(t, err)
+		require.True(t, timestamp1.Equal(entry.Timestamp))
+		require.False(t, entry.Forced)
+	}
+
+	// check DeletePending: not pending entries are kept
+	{
+		entry, err := targetSt.GetClaimByContentHash(ctx, source.ContentHash)
+		require.NoError(t, err)
+
+		deleted, err := targetSt.DeletePending(ctx, entry.ID)
+		require.NoError(t, err)
+		require.False(t, deleted)
+	}
+
+	// check Create / Complete / DeletePending: pending claim
+	pendingSource := source
+	pendingSource.ContentHash = "pending_hash"
+	{
+		jobID := primitive.NewObjectID()
+		id, err := targetSt.Create(ctx, model.ImportLedgerEntry{Source: pendingSource, Timestamp: timestamp1, JobID: jobID, Pending: true})
+		require.NoError(t, err)
+
+		// pending entries are not imported yet
+		_, err = targetSt.GetLatestByContentHash(ctx, pendingSource.ContentHash)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+
+		entry, err := targetSt.GetClaimByContentHash(ctx, pendingSource.ContentHash)
+		require.NoError(t, err)
+		require.True(t, entry.Pending)
+		require.Equal(t, jobID, entry.JobID)
+
+		deleted, err := targetSt.DeletePending(ctx, id)
+		require.NoError(t, err)
+		require.True(t, deleted)
+
+		id, err = targetSt.Create(ctx, model.ImportLedgerEntry{Source: pendingSource, Timestamp: timestamp2, JobID: jobID, Pending: true})
+		require.NoError(t, err)
+		require.NoError(t, targetSt.Complete(ctx, id))
+
+		entry, err = targetSt.GetLatestByContentHash(ctx, pendingSource.ContentHash)
+		require.NoError(t, err)
+		require.False(t, entry.Pending)
+		require.True(t, timestamp2.Equal(entry.Timestamp))
+
+		err = targetSt.Complete(ctx, primitive.NewObjectID())
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+}
@@ -0,0 +1,57 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+	"github.com/itiky/mdb-tutorial/pkg/model"
+)
+
+var _ ImportTimestampStorage = (*importTimestampStorage)(nil)
+
+// importTimestampStorage keeps ImportTimestampStorage dependencies.
+type importTimestampStorage struct {
+	storageCommon
+	mdbCollection *mongo.Collection
+}
+
+// Reserve implements ImportTimestampStorage interface.
+func (s importTimestampStorage) Reserve(ctx context.Context, reservation model.ImportTimestamp) error {
+	if reservation.Timestamp.IsZero() {
+		return fmt.Errorf("%w: timestamp: can not be empty", common.ErrInvalidInput)
+	}
+	if reservation.JobID.IsZero() {
+		return fmt.Errorf("%w: job_id: can not be empty", common.ErrInvalidInput)
+	}
+	if !reservation.Timestamp.Equal(reservation.Timestamp.Truncate(time.Millisecond)) {
+		return fmt.Errorf("%w: timestamp: should have milliseconds precision", common.ErrInvalidInput)
+	}
+
+	reservation.Timestamp = reservation.Timestamp.UTC()
+	reservation.CreatedAt = time.Now().UTC()
+
+	if _, err := s.mdbCollection.InsertOne(ctx, reservation); err != nil {
+		if isDuplicateKeyError(err) {
+			return fmt.Errorf("%w: import timestamp %s has already been reserved", common.ErrAlreadyExists, reservation.Timestamp.Format(time.RFC3339Nano))
+		}
+		return err
+	}
+
+	return nil
+}
+
+// GetByTimestamp implements ImportTimestampStorage interface.
+func (s importTimestampStorage) GetByTimestamp(ctx context.Context, timestamp time.Time) (retObj model.ImportTimestamp, retErr error) {
+	res := s.mdbCollection.FindOne(ctx, bson.M{"_id": timestamp.UTC()})
+	if err := singleResultDecode(res, &retObj); err != nil {
+		retErr = err
+		return
+	}
+
+	return
+}
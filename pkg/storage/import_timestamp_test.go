@@ -0,0 +1,72 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+	"github.com/itiky/mdb-tutorial/pkg/model"
+	"github.com/itiky/mdb-tutorial/pkg/testutils"
+	"github.com/itiky/mdb-tutorial/pkg/testutils/fixtures"
+)
+
+func (s *StorageTestSuite) TestStorage_ImportTimestamp() {
+	t := s.T()
+	ctx := context.Background()
+
+	client := testutils.PrepareMongoDBFixtures(ctx, s.r, fixtures.NewEmptyMongoDBFixtures())
+	storage, err := NewStorage(
+		WithDatabase(testutils.TestMongoDBDatabase),
+		WithMongoDBClient(client),
+	)
+	require.NoError(t, err)
+	targetSt := storage.ImportTimestamp()
+
+	timestamp := time.Date(2000, 1, 1, 0, 0, 0, int(time.Millisecond), time.UTC)
+	jobID1, jobID2 := primitive.NewObjectID(), primitive.NewObjectID()
+
+	// check Reserve: invalid input
+	{
+		err := targetSt.Reserve(ctx, model.ImportTimestamp{JobID: jobID1})
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		err = targetSt.Reserve(ctx, model.ImportTimestamp{Timestamp: timestamp})
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		err = targetSt.Reserve(ctx, model.ImportTimestamp{Timestamp: timestamp.Add(time.Microsecond), JobID: jobID1})
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check GetByTimestamp: not found
+	{
+		_, err := targetSt.GetByTimestamp(ctx, timestamp)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+
+	// check Reserve: unique timestamps
+	{
+		require.NoError(t, targetSt.Reserve(ctx, model.ImportTimestamp{Timestamp: timestamp, JobID: jobID1}))
+
+		err := targetSt.Reserve(ctx, model.ImportTimestamp{Timestamp: timestamp, JobID: jobID2})
+		require.True(t, errors.Is(err, common.ErrAlreadyExists))
+
+		require.NoError(t, targetSt.Reserve(ctx, model.ImportTimestamp{Timestamp: timestamp.Add(time.Millisecond), JobID: jobID2}))
+	}
+
+	// check GetByTimestamp
+	{
+		reservation, err := targetSt.GetByTimestamp(ctx, timestamp)
+		require.NoError(t, err)
+		require.True(t, timestamp.Equal(reservation.Timestamp))
+		require.Equal(t, jobID1, reservation.JobID)
+		require.False(t, reservation.CreatedAt.IsZero())
+
+		reservation, err = targetSt.GetByTimestamp(ctx, timestamp.Add(time.Millisecond))
+		require.NoError(t, err)
+		require.Equal(t, jobID2, reservation.JobID)
+	}
+}
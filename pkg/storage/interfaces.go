@@ -16,6 +16,36 @@ type Storage interface {
 	Product() ProductStorage
 	// PriceImport returns configured PriceImportStorage.
 	PriceImport() PriceImportStorage
+	// ImportJob returns configured ImportJobStorage.
+	ImportJob() ImportJobStorage
+	// ImportLedger returns configured ImportLedgerStorage.
+	ImportLedger() ImportLedgerStorage
+	// ImportAudit returns configured ImportAuditStorage.
+	ImportAudit() ImportAuditStorage
+	// ImportTimestamp returns configured ImportTimestampStorage.
+	ImportTimestamp() ImportTimestampStorage
+	// PriceAlert returns configured PriceAlertStorage.
+	PriceAlert() PriceAlertStorage
+	// Migration returns configured MigrationStorage.
+	Migration() MigrationStorage
+	// StartTransaction starts a new multi-document transaction.
+	StartTransaction(ctx context.Context) (Transaction, error)
+	// WithTransaction runs fn within a new transaction: it is committed if fn succeeds and aborted otherwise.
+	// fn is retried on transient transaction errors (write conflicts with concurrent transactions), so it should be idempotent.
+	WithTransaction(ctx context.Context, fn func(txCtx context.Context) error) error
+	// SupportsTransactions checks if the MongoDB deployment supports transactions (a replica set or a sharded cluster).
+	SupportsTransactions(ctx context.Context) (bool, error)
+}
+
+// Transaction is a MongoDB multi-document transaction (requires a replica set or a sharded cluster deployment).
+// Transaction operations must not be run concurrently.
+type Transaction interface {
+	// Context binds ctx to the transaction: Storage operations called with the returned context are the transaction part.
+	Context(ctx context.Context) context.Context
+	// Commit commits the transaction and ends its session.
+	Commit(ctx context.Context) error
+	// Abort aborts the transaction and ends its session.
+	Abort(ctx context.Context) error
 }
 
 // ProductStorage provides "products" collection operation.
@@ -27,6 +57,15 @@ type ProductStorage interface {
 	// UpsertByName sets product by unique name.
 	// Returns created ID (if created).
 	UpsertByName(ctx context.Context, product model.Product) (primitive.ObjectID, error)
+	// BulkUpsertByNames sets products by unique names with a single bulk write.
+	// Returns IDs of all (created and existing) products by name.
+	BulkUpsertByNames(ctx context.Context, names []string) (map[string]primitive.ObjectID, error)
+	// DeleteOrphans deletes products (of the given IDs) that are not referenced by any price import.
+	// Check and deletion are not atomic, so it should be run within a transaction.
+	// Returns the number of deleted objects.
+	DeleteOrphans(ctx context.Context, ids []primitive.ObjectID) (int64, error)
+	// GetByIDs loads products by IDs (missing ones are skipped).
+	GetByIDs(ctx context.Context, ids []primitive.ObjectID) ([]model.Product, error)
 	// GetAll loads all product objects.
 	GetAll(ctx context.Context) ([]model.Product, error)
 }
@@ -36,8 +75,138 @@ type PriceImportStorage interface {
 	// UpsertByProductIDAndTimestamp sets price import by unique pair {timestamp, productID}.
 	// Returns created ID (if created).
 	UpsertByProductIDAndTimestamp(ctx context.Context, pricesImport model.PricesImport) (primitive.ObjectID, error)
+	// BulkUpsertByProductIDAndTimestamp sets price imports by unique pairs {timestamp, productID} with a single bulk write.
+	// Returns the number of created objects.
+	BulkUpsertByProductIDAndTimestamp(ctx context.Context, pricesImports []model.PricesImport) (int64, error)
+	// BulkAppendByProductIDAndTimestamp appends prices to price imports by unique pairs {timestamp, productID} with a single bulk write
+	// (price imports are created if not exist), so concurrent writes of the same pair are merged.
+	// Returns the number of created objects.
+	BulkAppendByProductIDAndTimestamp(ctx context.Context, pricesImports []model.PricesImport) (int64, error)
+	// DeleteByTimeRange deletes price imports with timestamp within [from, to) range.
+	// Returns the number of deleted objects and IDs of affected products.
+	DeleteByTimeRange(ctx context.Context, from, to time.Time) (int64, []primitive.ObjectID, error)
 	// GetAll loads all price import objects with optional filtering.
 	GetAll(ctx context.Context, timestamp time.Time, productID string) ([]model.PricesImport, error)
-	// GetPriceEntries returns merged Product and PriceImport collections with sort and pagination options.
-	GetPriceEntries(ctx context.Context, sortOptions common.SortOptions, paginationOption common.PaginationOption) (model.PriceEntries, error)
+	// GetByTimeRange loads price import objects of the [from, to) DateTime range sorted by timestamp.
+	GetByTimeRange(ctx context.Context, from, to time.Time) ([]model.PricesImport, error)
+	// GetPriceEntries returns merged Product and PriceImport collections with filter, sort and pagination options.
+	// Pagination cursor (keyset pagination) could be used instead of skip, the next page cursor is returned if there are more entries.
+	// Total number of filtered entries is calculated if countTotal is set.
+	GetPriceEntries(ctx context.Context, filter model.PriceEntriesFilter, sortOptions common.SortOptions, paginationOption common.PaginationOption, countTotal bool) (model.PriceEntriesPage, error)
+	// StreamPriceEntries iterates over merged Product and PriceImport collections with filter and sort options emitting the handler for every entry.
+	// Entries are not sorted if sortOptions are empty, limit 0 means no limit. Iteration is stopped on the handler error.
+	StreamPriceEntries(ctx context.Context, filter model.PriceEntriesFilter, sortOptions common.SortOptions, limit int, handler func(entry model.PriceEntry) error) error
+	// GetImportTimestamps returns distinct import timestamps within [from, to) range (oldest first).
+	GetImportTimestamps(ctx context.Context, from, to time.Time) ([]time.Time, error)
+	// GetLastImportTimestamps returns up to limit the most recent distinct import timestamps (newest first) skipping the excluded ones.
+	GetLastImportTimestamps(ctx context.Context, limit int, excluded []time.Time) ([]time.Time, error)
+	// GetLatestPrices returns the most recent price import prices per product (sorted by product name) with filter and pagination options.
+	// Products without price imports (as of the filter DateTime) are skipped before pagination,
+	// so only the last page could contain fewer entries than the limit.
+	GetLatestPrices(ctx context.Context, filter model.LatestPricesFilter, paginationOption common.PaginationOption) ([]model.LatestPrices, error)
+	// StreamTopMoversPrices iterates over products prices of both compared points in time emitting the handler for every product
+	// present at both points. Price imports are matched by the filter exact timestamps groups (prices of a group are concatenated
+	// in the timestamps and the CSV-file rows order), otherwise the latest price import as of the filter DateTime is used per product.
+	// Iteration is stopped on the handler error.
+	StreamTopMoversPrices(ctx context.Context, filter model.TopMoversPricesFilter, handler func(prices model.TopMoversPrices) error) error
+	// GetProductHistory returns the product prices series in the chronological order (prices of a single import are kept in the import order).
+	// If downsampling is requested, a single price per {bucket, currency} is returned.
+	GetProductHistory(ctx context.Context, productID primitive.ObjectID, params model.PriceHistoryParams) ([]model.PriceHistoryPoint, error)
+	// GetCandles returns the product OHLC candles per {interval bucket, currency} ordered by bucket start.
+	GetCandles(ctx context.Context, productID primitive.ObjectID, params model.CandlesParams) ([]model.Candle, error)
+	// GetPriceStats returns per product and currency prices statistics (sorted by product name and currency) with filter and pagination options.
+	GetPriceStats(ctx context.Context, filter model.PriceEntriesFilter, paginationOption common.PaginationOption) ([]model.PriceStats, error)
+}
+
+// ImportJobStorage provides "import_jobs" collection operation.
+type ImportJobStorage interface {
+	// Create inserts a new import job.
+	// Returns created ID.
+	Create(ctx context.Context, job model.ImportJob) (primitive.ObjectID, error)
+	// GetByID loads import job by ID.
+	GetByID(ctx context.Context, id string) (model.ImportJob, error)
+	// GetAll loads import jobs (newest first) with pagination options.
+	GetAll(ctx context.Context, paginationOption common.PaginationOption) ([]model.ImportJob, error)
+	// SetState updates import job state and the job level error.
+	SetState(ctx context.Context, id primitive.ObjectID, state model.ImportJobState, errMsg string) error
+	// SetImportSource updates import job prices import timestamp and downloaded file metadata.
+	SetImportSource(ctx context.Context, id primitive.ObjectID, timestamp time.Time, source model.ImportSource) error
+	// AddChunkResult updates import job progress and appends chunk errors (if any).
+	AddChunkResult(ctx context.Context, id primitive.ObjectID, result model.ImportChunkResult) error
+	// AddArchiveEntry appends a processed archive entry.
+	AddArchiveEntry(ctx context.Context, id primitive.ObjectID, entry model.ImportArchiveEntry) error
+	// Heartbeat renews the not finished import job lease (updated_at is set to now).
+	Heartbeat(ctx context.Context, id primitive.ObjectID) error
+	// FailUnfinished sets the failed state for not finished import jobs of the owner (and jobs without an owner)
+	// and jobs of any owner not updated since expiredBefore (expired lease). Both filters are optional (not both).
+	// Returns the number of updated objects.
+	FailUnfinished(ctx context.Context, owner string, expiredBefore time.Time, errMsg string) (int64, error)
+}
+
+// ImportLedgerStorage provides "import_ledger" collection operation.
+type ImportLedgerStorage interface {
+	// Create inserts a new import ledger entry.
+	// Returns created ID, common.ErrAlreadyExists if a not forced entry has already claimed the content hash.
+	Create(ctx context.Context, entry model.ImportLedgerEntry) (primitive.ObjectID, error)
+	// Complete marks the pending import ledger entry as imported.
+	Complete(ctx context.Context, id primitive.ObjectID) error
+	// DeletePending deletes the pending import ledger entry (releasing the content hash claim).
+	// Returns false if the entry is not found or not pending.
+	DeletePending(ctx context.Context, id primitive.ObjectID) (bool, error)
+	// GetLatestByContentHash loads the latest imported (not pending) import ledger entry for file content hash.
+	GetLatestByContentHash(ctx context.Context, contentHash string) (model.ImportLedgerEntry, error)
+	// GetClaimByContentHash loads the not forced import ledger entry claiming the file content hash.
+	GetClaimByContentHash(ctx context.Context, contentHash string) (model.ImportLedgerEntry, error)
+	// DeleteByTimeRange deletes import ledger entries with import timestamp within [from, to) range (so the content could be imported again).
+	// Returns the number of deleted objects.
+	DeleteByTimeRange(ctx context.Context, from, to time.Time) (int64, error)
+}
+
+// ImportAuditStorage provides "import_audit" collection operation.
+type ImportAuditStorage interface {
+	// Create inserts a new import audit entry.
+	// Returns created ID.
+	Create(ctx context.Context, entry model.ImportAuditEntry) (primitive.ObjectID, error)
+	// GetAll loads import audit entries (newest first) with pagination options.
+	GetAll(ctx context.Context, paginationOption common.PaginationOption) ([]model.ImportAuditEntry, error)
+}
+
+// ImportTimestampStorage provides "import_timestamps" collection operations.
+type ImportTimestampStorage interface {
+	// Reserve reserves the import timestamp (milliseconds precision) for the job.
+	// Returns common.ErrAlreadyExists if the timestamp has already been reserved (reservations are never released).
+	Reserve(ctx context.Context, reservation model.ImportTimestamp) error
+	// GetByTimestamp loads the import timestamp reservation.
+	GetByTimestamp(ctx context.Context, timestamp time.Time) (model.ImportTimestamp, error)
+}
+
+// PriceAlertStorage provides "price_alerts" collection operations.
+type PriceAlertStorage interface {
+	// Create inserts a new price alert rule.
+	// Returns created ID.
+	Create(ctx context.Context, rule model.PriceAlertRule) (primitive.ObjectID, error)
+	// GetByID loads price alert rule by ID.
+	GetByID(ctx context.Context, id string) (model.PriceAlertRule, error)
+	// GetAll loads price alert rules (newest first) with pagination options.
+	GetAll(ctx context.Context, paginationOption common.PaginationOption) ([]model.PriceAlertRule, error)
+	// GetEnabled loads all enabled price alert rules (sorted by product name).
+	GetEnabled(ctx context.Context) ([]model.PriceAlertRule, error)
+	// Update updates price alert rule user defined fields.
+	Update(ctx context.Context, rule model.PriceAlertRule) error
+	// SetTriggered updates price alert rule the last notification DateTime.
+	SetTriggered(ctx context.Context, id primitive.ObjectID, timestamp time.Time) error
+	// Delete deletes price alert rule by ID.
+	Delete(ctx context.Context, id string) error
+}
+
+// MigrationStorage provides DB schema migrations ("schema_migrations" collection keeps applied ones).
+type MigrationStorage interface {
+	// Up applies pending migrations up to the target version (0 - the latest one).
+	// Returns applied migrations.
+	Up(ctx context.Context, targetVersion int) ([]model.SchemaMigration, error)
+	// Down reverts the latest applied migrations (steps number).
+	// Returns reverted migrations.
+	Down(ctx context.Context, steps int) ([]model.SchemaMigration, error)
+	// Status returns known and applied migrations state ordered by version.
+	Status(ctx context.Context) ([]model.SchemaMigrationStatus, error)
 }
@@ -0,0 +1,252 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+	"github.com/itiky/mdb-tutorial/pkg/model"
+)
+
+var _ MigrationStorage = (*migrationStorage)(nil)
+
+// migration is a versioned DB schema change.
+// Up and Down should be idempotent as a failed migration is not recorded and is retried by the next run.
+type migration struct {
+	Version     int
+	Description string
+	Up          func(ctx context.Context, db *mongo.Database, params migrationParams) error
+	Down        func(ctx context.Context, db *mongo.Database, params migrationParams) error
+}
+
+// migrationParams keeps application configured values data migrations depend on.
+type migrationParams struct {
+	// ISO-4217 currency code set for legacy prices stored without one
+	LegacyPriceCurrency string
+}
+
+// migrationStorage keeps MigrationStorage dependencies.
+type migrationStorage struct {
+	storageCommon
+	db            *mongo.Database
+	mdbCollection *mongo.Collection
+	migrations    []migration
+	params        migrationParams
+}
+
+// Up implements MigrationStorage interface.
+func (s migrationStorage) Up(ctx context.Context, targetVersion int) (retObjs []model.SchemaMigration, retErr error) {
+	if err := s.validate(); err != nil {
+		retErr = err
+		return
+	}
+	if targetVersion < 0 {
+		retErr = fmt.Errorf("%w: targetVersion: should be GTE 0", common.ErrInvalidInput)
+		return
+	}
+	if targetVersion > 0 {
+		if _, found := s.getMigration(targetVersion); !found {
+			retErr = fmt.Errorf("%w: targetVersion: unknown migration %d", common.ErrInvalidInput, targetVersion)
+			return
+		}
+	}
+
+	applied, err := s.getApplied(ctx)
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	for _, m := range s.migrations {
+		if targetVersion > 0 && m.Version > targetVersion {
+			break
+		}
+		if _, found := applied[m.Version]; found {
+			continue
+		}
+
+		s.logger.Infof("Migration %d (%s): applying", m.Version, m.Description)
+		if err := m.Up(ctx, s.db, s.params); err != nil {
+			retErr = fmt.Errorf("migration %d (%s): up: %w", m.Version, m.Description, err)
+			return
+		}
+
+		record := model.SchemaMigration{
+			Version:     m.Version,
+			Description: m.Description,
+			AppliedAt:   time.Now().UTC(),
+		}
+		if _, err := s.mdbCollection.InsertOne(ctx, record); err != nil {
+			// migration has been applied concurrently (by another application instance)
+			if isDuplicateKeyError(err) {
+				s.logger.Infof("Migration %d (%s): already applied concurrently", m.Version, m.Description)
+				continue
+			}
+			retErr = fmt.Errorf("migration %d (%s): recording: %w", m.Version, m.Description, err)
+			return
+		}
+		retObjs = append(retObjs, record)
+	}
+
+	return
+}
+
+// Down implements MigrationStorage interface.
+// nolint:govet
+func (s migrationStorage) Down(ctx context.Context, steps int) (retObjs []model.SchemaMigration, retErr error) {
+	if err := s.validate(); err != nil {
+		retErr = err
+		return
+	}
+	if steps <= 0 {
+		retErr = fmt.Errorf("%w: steps: should be GT 0", common.ErrInvalidInput)
+		return
+	}
+
+	findOpts := options.Find().
+		SetSort(bson.D{{"_id", -1}}).
+		SetLimit(int64(steps))
+
+	cursor, err := s.mdbCollection.Find(ctx, bson.M{}, findOpts)
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	records := make([]model.SchemaMigration, 0, steps)
+	err = cursorIterateAndDecode(ctx, cursor, func(curCursor *mongo.Cursor) error {
+		var record model.SchemaMigration
+		if err := curCursor.Decode(&record); err != nil {
+			return err
+		}
+		records = append(records, record)
+
+		return nil
+	})
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	for _, record := range records {
+		m, found := s.getMigration(record.Version)
+		if !found {
+			retErr = fmt.Errorf("migration %d (%s): unknown to the current application version, can not be reverted", record.Version, record.Description)
+			return
+		}
+
+		s.logger.Infof("Migration %d (%s): reverting", m.Version, m.Description)
+		if err := m.Down(ctx, s.db, s.params); err != nil {
+			retErr = fmt.Errorf("migration %d (%s): down: %w", m.Version, m.Description, err)
+			return
+		}
+
+		if _, err := s.mdbCollection.DeleteOne(ctx, bson.M{"_id": m.Version}); err != nil {
+			retErr = fmt.Errorf("migration %d (%s): removing record: %w", m.Version, m.Description, err)
+			return
+		}
+		retObjs = append(retObjs, record)
+	}
+
+	return
+}
+
+// Status implements MigrationStorage interface.
+func (s migrationStorage) Status(ctx context.Context) (retObjs []model.SchemaMigrationStatus, retErr error) {
+	if err := s.validate(); err != nil {
+		retErr = err
+		return
+	}
+
+	applied, err := s.getApplied(ctx)
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	for _, m := range s.migrations {
+		status := model.SchemaMigrationStatus{
+			Version:     m.Version,
+			Description: m.Description,
+		}
+		if record, found := applied[m.Version]; found {
+			status.Applied = true
+			status.AppliedAt = record.AppliedAt
+			delete(applied, m.Version)
+		}
+		retObjs = append(retObjs, status)
+	}
+
+	// applied by a newer application version
+	for _, record := range applied {
+		retObjs = append(retObjs, model.SchemaMigrationStatus{
+			Version:     record.Version,
+			Description: record.Description,
+			Applied:     true,
+			AppliedAt:   record.AppliedAt,
+			Unknown:     true,
+		})
+	}
+	sort.Slice(retObjs, func(i, j int) bool {
+		return retObjs[i].Version < retObjs[j].Version
+	})
+
+	return
+}
+
+// getApplied loads applied migration records by version.
+func (s migrationStorage) getApplied(ctx context.Context) (map[int]model.SchemaMigration, error) {
+	cursor, err := s.mdbCollection.Find(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+
+	applied := make(map[int]model.SchemaMigration)
+	err = cursorIterateAndDecode(ctx, cursor, func(curCursor *mongo.Cursor) error {
+		var record model.SchemaMigration
+		if err := curCursor.Decode(&record); err != nil {
+			return err
+		}
+		applied[record.Version] = record
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return applied, nil
+}
+
+// getMigration looks for a known migration by version.
+func (s migrationStorage) getMigration(version int) (migration, bool) {
+	for _, m := range s.migrations {
+		if m.Version == version {
+			return m, true
+		}
+	}
+
+	return migration{}, false
+}
+
+// validate checks migrations list consistency.
+func (s migrationStorage) validate() error {
+	prevVersion := 0
+	for i, m := range s.migrations {
+		if m.Version <= prevVersion {
+			return fmt.Errorf("migrations[%d]: version %d: should be GT the previous one (%d)", i, m.Version, prevVersion)
+		}
+		if m.Up == nil || m.Down == nil {
+			return fmt.Errorf("migrations[%d]: version %d: up / down funcs should be set", i, m.Version)
+		}
+		prevVersion = m.Version
+	}
+
+	return nil
+}
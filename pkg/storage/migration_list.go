@@ -0,0 +1,333 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/itiky/mdb-tutorial/pkg/model"
+)
+
+const (
+	ProductsNameIndex              = "name_unique"
+	PriceImportsProductIDTSIndex   = "product_id_timestamp"
+	PriceImportsTimestampIndex     = "timestamp"
+	ImportLedgerContentHashTSIndex = "source_content_hash_timestamp"
+	ImportLedgerContentHashIndex   = "source_content_hash_unique"
+	ImportJobsCreatedAtIndex       = "created_at_id"
+	ImportAuditCreatedAtIndex      = "created_at_id"
+	PriceAlertsCreatedAtIndex      = "created_at_id"
+	PriceAlertsEnabledProductIndex = "enabled_product_name"
+	mdbIndexNotFoundErrorCode      = 27
+	mdbNamespaceNotFoundErrorCode  = 26
+)
+
+// schemaMigrations is the ordered list of DB schema migrations.
+// Applied migrations must not be changed, new ones are appended with the next version.
+// nolint:govet
+var schemaMigrations = []migration{
+	{
+		Version:     1,
+		Description: "products: unique name index",
+		Up: func(ctx context.Context, db *mongo.Database, _ migrationParams) error {
+			if err := mergeDuplicateProducts(ctx, db); err != nil {
+				return err
+			}
+
+			return createIndex(ctx, db.Collection(ProductsCollection), ProductsNameIndex, bson.D{{"name", 1}}, true)
+		},
+		Down: func(ctx context.Context, db *mongo.Database, _ migrationParams) error {
+			return dropIndex(ctx, db.Collection(ProductsCollection), ProductsNameIndex)
+		},
+	},
+	{
+		Version:     2,
+		Description: "price_imports: {product_id, timestamp} and {timestamp} indexes",
+		Up: func(ctx context.Context, db *mongo.Database, _ migrationParams) error {
+			collection := db.Collection(PriceImportsCollection)
+			if err := createIndex(ctx, collection, PriceImportsProductIDTSIndex, bson.D{{"product_id", 1}, {"timestamp", 1}}, false); err != nil {
+				return err
+			}
+
+			return createIndex(ctx, collection, PriceImportsTimestampIndex, bson.D{{"timestamp", 1}}, false)
+		},
+		Down: func(ctx context.Context, db *mongo.Database, _ migrationParams) error {
+			collection := db.Collection(PriceImportsCollection)
+			if err := dropIndex(ctx, collection, PriceImportsTimestampIndex); err != nil {
+				return err
+			}
+
+			return dropIndex(ctx, collection, PriceImportsProductIDTSIndex)
+		},
+	},
+	{
+		Version:     3,
+		Description: "import_ledger: {source.content_hash, timestamp} index",
+		Up: func(ctx context.Context, db *mongo.Database, _ migrationParams) error {
+			return createIndex(ctx, db.Collection(ImportLedgerCollection), ImportLedgerContentHashTSIndex, bson.D{{"source.content_hash", 1}, {"timestamp", -1}}, false)
+		},
+		Down: func(ctx context.Context, db *mongo.Database, _ migrationParams) error {
+			return dropIndex(ctx, db.Collection(ImportLedgerCollection), ImportLedgerContentHashTSIndex)
+		},
+	},
+	{
+		Version:     4,
+		Description: "import_jobs: {created_at, _id} index",
+		Up: func(ctx context.Context, db *mongo.Database, _ migrationParams) error {
+			return createIndex(ctx, db.Collection(ImportJobsCollection), ImportJobsCreatedAtIndex, bson.D{{"created_at", -1}, {"_id", -1}}, false)
+		},
+		Down: func(ctx context.Context, db *mongo.Database, _ migrationParams) error {
+			return dropIndex(ctx, db.Collection(ImportJobsCollection), ImportJobsCreatedAtIndex)
+		},
+	},
+	{
+		Version:     5,
+		Description: "import_audit: {created_at, _id} index",
+		Up: func(ctx context.Context, db *mongo.Database, _ migrationParams) error {
+			return createIndex(ctx, db.Collection(ImportAuditCollection), ImportAuditCreatedAtIndex, bson.D{{"created_at", -1}, {"_id", -1}}, false)
+		},
+		Down: func(ctx context.Context, db *mongo.Database, _ migrationParams) error {
+			return dropIndex(ctx, db.Collection(ImportAuditCollection), ImportAuditCreatedAtIndex)
+		},
+	},
+	{
+		Version:     6,
+		Description: "price_alerts: {created_at, _id} and {enabled, product_name} indexes",
+		Up: func(ctx context.Context, db *mongo.Database, _ migrationParams) error {
+			collection := db.Collection(PriceAlertsCollection)
+			if err := createIndex(ctx, collection, PriceAlertsCreatedAtIndex, bson.D{{"created_at", -1}, {"_id", -1}}, false); err != nil {
+				return err
+			}
+
+			return createIndex(ctx, collection, PriceAlertsEnabledProductIndex, bson.D{{"enabled", 1}, {"product_name", 1}}, false)
+		},
+		Down: func(ctx context.Context, db *mongo.Database, _ migrationParams) error {
+			collection := db.Collection(PriceAlertsCollection)
+			if err := dropIndex(ctx, collection, PriceAlertsEnabledProductIndex); err != nil {
+				return err
+			}
+
+			return dropIndex(ctx, collection, PriceAlertsCreatedAtIndex)
+		},
+	},
+	{
+		Version:     7,
+		Description: "price_imports: legacy integer prices to decimals with currency",
+		Up: func(ctx context.Context, db *mongo.Database, params migrationParams) error {
+			return convertLegacyPrices(ctx, db, params.LegacyPriceCurrency)
+		},
+		Down: func(ctx context.Context, db *mongo.Database, _ migrationParams) error {
+			// converted prices are valid for the previous schema versions as well
+			return nil
+		},
+	},
+	{
+		Version:     8,
+		Description: "price_imports: unique {product_id, timestamp} index",
+		Up: func(ctx context.Context, db *mongo.Database, _ migrationParams) error {
+			if err := mergeDuplicatePriceImports(ctx, db); err != nil {
+				return err
+			}
+
+			collection := db.Collection(PriceImportsCollection)
+			if err := dropIndex(ctx, collection, PriceImportsProductIDTSIndex); err != nil {
+				return err
+			}
+
+			return createIndex(ctx, collection, PriceImportsProductIDTSIndex, bson.D{{"product_id", 1}, {"timestamp", 1}}, true)
+		},
+		Down: func(ctx context.Context, db *mongo.Database, _ migrationParams) error {
+			collection := db.Collection(PriceImportsCollection)
+			if err := dropIndex(ctx, collection, PriceImportsProductIDTSIndex); err != nil {
+				return err
+			}
+
+			return createIndex(ctx, collection, PriceImportsProductIDTSIndex, bson.D{{"product_id", 1}, {"timestamp", 1}}, false)
+		},
+	},
+	{
+		Version:     9,
+		Description: "import_ledger: unique {source.content_hash} index for not forced entries",
+		Up: func(ctx context.Context, db *mongo.Database, _ migrationParams) error {
+			// entries recorded before have no "forced" field and are not indexed (those are checked before import claims)
+			return createPartialIndex(ctx, db.Collection(ImportLedgerCollection), ImportLedgerContentHashIndex, bson.D{{"source.content_hash", 1}}, true, bson.D{{"forced", false}})
+		},
+		Down: func(ctx context.Context, db *mongo.Database, _ migrationParams) error {
+			return dropIndex(ctx, db.Collection(ImportLedgerCollection), ImportLedgerContentHashIndex)
+		},
+	},
+}
+
+// createIndex creates a named collection index (no-op if it already exists).
+func createIndex(ctx context.Context, collection *mongo.Collection, name string, keys bson.D, unique bool) error {
+	return createIndexWithOpts(ctx, collection, name, keys, options.Index().SetUnique(unique))
+}
+
+// createPartialIndex creates a named collection index for documents matching the filter (no-op if it already exists).
+func createPartialIndex(ctx context.Context, collection *mongo.Collection, name string, keys bson.D, unique bool, filter bson.D) error {
+	return createIndexWithOpts(ctx, collection, name, keys, options.Index().SetUnique(unique).SetPartialFilterExpression(filter))
+}
+
+// createIndexWithOpts creates a named collection index with options (no-op if it already exists).
+func createIndexWithOpts(ctx context.Context, collection *mongo.Collection, name string, keys bson.D, indexOpts *options.IndexOptions) error {
+	indexModel := mongo.IndexModel{
+		Keys:    keys,
+		Options: indexOpts.SetName(name),
+	}
+
+	if _, err := collection.Indexes().CreateOne(ctx, indexModel); err != nil {
+		return fmt.Errorf("%s: creating index %s: %w", collection.Name(), name, err)
+	}
+
+	return nil
+}
+
+// dropIndex drops a named collection index (no-op if it doesn't exist).
+func dropIndex(ctx context.Context, collection *mongo.Collection, name string) error {
+	if _, err := collection.Indexes().DropOne(ctx, name); err != nil {
+		var cmdErr mongo.CommandError
+		if errors.As(err, &cmdErr) && (cmdErr.Code == mdbIndexNotFoundErrorCode || cmdErr.Code == mdbNamespaceNotFoundErrorCode) {
+			return nil
+		}
+
+		return fmt.Errorf("%s: dropping index %s: %w", collection.Name(), name, err)
+	}
+
+	return nil
+}
+
+// convertLegacyPrices converts legacy integer prices (whole currency units) to Decimal128 values
+// setting the currency for prices stored without one (requires MongoDB 4.2+ pipeline updates).
+// nolint:govet
+func convertLegacyPrices(ctx context.Context, db *mongo.Database, currency string) error {
+	if !model.IsValidCurrency(currency) {
+		return fmt.Errorf("legacy prices currency: invalid ISO-4217 code (%s)", currency)
+	}
+
+	filter := bson.M{"prices": bson.M{"$elemMatch": bson.M{"$or": bson.A{
+		bson.M{"value": bson.M{"$not": bson.M{"$type": "decimal"}}},
+		bson.M{"currency": bson.M{"$exists": false}},
+		bson.M{"currency": ""},
+	}}}}
+	update := mongo.Pipeline{
+		{{"$set", bson.D{
+			{"prices", bson.D{{"$map", bson.D{
+				{"input", "$prices"},
+				{"in", bson.D{
+					{"value", bson.D{{"$toDecimal", "$$this.value"}}},
+					{"currency", bson.D{{"$cond", bson.A{
+						bson.D{{"$eq", bson.A{bson.D{{"$ifNull", bson.A{"$$this.currency", ""}}}, ""}}},
+						currency,
+						"$$this.currency",
+					}}}},
+				}},
+			}}}},
+		}}},
+	}
+
+	if _, err := db.Collection(PriceImportsCollection).UpdateMany(ctx, filter, update); err != nil {
+		return fmt.Errorf("%s: converting legacy prices: %w", PriceImportsCollection, err)
+	}
+
+	return nil
+}
+
+// mergeDuplicatePriceImports merges price imports with the same {product_id, timestamp} pair (created by concurrent upserts
+// or by mergeDuplicateProducts) before the unique index is built: the oldest price import is kept, prices of others are appended to it.
+// nolint:govet
+func mergeDuplicatePriceImports(ctx context.Context, db *mongo.Database) error {
+	priceImports := db.Collection(PriceImportsCollection)
+
+	pipeline := mongo.Pipeline{
+		{{"$sort", bson.D{{"_id", 1}}}},
+		{{"$group", bson.D{
+			{"_id", bson.D{{"product_id", "$product_id"}, {"timestamp", "$timestamp"}}},
+			{"ids", bson.D{{"$push", "$_id"}}},
+			{"prices", bson.D{{"$push", "$prices"}}},
+		}}},
+		{{"$match", bson.D{{"ids.1", bson.D{{"$exists", true}}}}}},
+		{{"$project", bson.D{
+			{"ids", 1},
+			{"prices", bson.D{{"$reduce", bson.D{
+				{"input", "$prices"},
+				{"initialValue", bson.A{}},
+				{"in", bson.D{{"$concatArrays", bson.A{"$$value", bson.D{{"$ifNull", bson.A{"$$this", bson.A{}}}}}}}},
+			}}}},
+		}}},
+	}
+
+	cursor, err := priceImports.Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		return fmt.Errorf("%s: duplicates lookup: %w", PriceImportsCollection, err)
+	}
+
+	return cursorIterateAndDecode(ctx, cursor, func(curCursor *mongo.Cursor) error {
+		var duplicates struct {
+			IDs    []primitive.ObjectID `bson:"ids"`
+			Prices bson.A               `bson:"prices"`
+		}
+		if err := curCursor.Decode(&duplicates); err != nil {
+			return err
+		}
+		keepID, mergeIDs := duplicates.IDs[0], duplicates.IDs[1:]
+
+		if _, err := priceImports.UpdateOne(ctx, bson.M{"_id": keepID}, bson.M{"$set": bson.M{"prices": duplicates.Prices}}); err != nil {
+			return fmt.Errorf("price import %s: merging prices: %w", keepID.Hex(), err)
+		}
+
+		if _, err := priceImports.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": mergeIDs}}); err != nil {
+			return fmt.Errorf("price import %s: removing duplicates: %w", keepID.Hex(), err)
+		}
+
+		return nil
+	})
+}
+
+// mergeDuplicateProducts merges products with the same name (created by concurrent upserts) before the unique index is built:
+// the oldest product is kept, price imports of others are moved to it.
+// nolint:govet
+func mergeDuplicateProducts(ctx context.Context, db *mongo.Database) error {
+	products, priceImports := db.Collection(ProductsCollection), db.Collection(PriceImportsCollection)
+
+	pipeline := mongo.Pipeline{
+		{{"$sort", bson.D{{"_id", 1}}}},
+		{{"$group", bson.D{
+			{"_id", "$name"},
+			{"ids", bson.D{{"$push", "$_id"}}},
+		}}},
+		{{"$match", bson.D{{"ids.1", bson.D{{"$exists", true}}}}}},
+	}
+
+	cursor, err := products.Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		return fmt.Errorf("%s: duplicates lookup: %w", ProductsCollection, err)
+	}
+
+	return cursorIterateAndDecode(ctx, cursor, func(curCursor *mongo.Cursor) error {
+		var duplicates struct {
+			Name string               `bson:"_id"`
+			IDs  []primitive.ObjectID `bson:"ids"`
+		}
+		if err := curCursor.Decode(&duplicates); err != nil {
+			return err
+		}
+		keepID, mergeIDs := duplicates.IDs[0], duplicates.IDs[1:]
+
+		filter := bson.M{"product_id": bson.M{"$in": mergeIDs}}
+		update := bson.M{"$set": bson.M{"product_id": keepID}}
+		if _, err := priceImports.UpdateMany(ctx, filter, update); err != nil {
+			return fmt.Errorf("product %s: moving price imports: %w", duplicates.Name, err)
+		}
+
+		if _, err := products.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": mergeIDs}}); err != nil {
+			return fmt.Errorf("product %s: removing duplicates: %w", duplicates.Name, err)
+		}
+
+		return nil
+	})
+}
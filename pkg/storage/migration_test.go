@@ -0,0 +1,252 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+	"github.com/itiky/mdb-tutorial/pkg/model"
+	"github.com/itiky/mdb-tutorial/pkg/testutils"
+	"github.com/itiky/mdb-tutorial/pkg/testutils/fixtures"
+)
+
+func (s *StorageTestSuite) TestStorage_Migration() {
+	t := s.T()
+	ctx := context.Background()
+
+	client := testutils.PrepareMongoDBFixtures(ctx, s.r, fixtures.NewEmptyMongoDBFixtures())
+	db := client.Database(testutils.TestMongoDBDatabase)
+	require.NoError(t, db.Collection(MigrationsCollection).Drop(ctx))
+
+	storage, err := NewStorage(
+		WithDatabase(testutils.TestMongoDBDatabase),
+		WithMongoDBClient(client),
+	)
+	require.NoError(t, err)
+	targetSt := storage.Migration()
+
+	getIndexNames := func(collection string) map[string]bool {
+		cursor, err := db.Collection(collection).Indexes().List(ctx)
+		require.NoError(t, err)
+
+		var indexes []bson.M
+		require.NoError(t, cursor.All(ctx, &indexes))
+
+		names := make(map[string]bool, len(indexes))
+		for _, index := range indexes {
+			names[index["name"].(string)] = true
+		}
+
+		return names
+	}
+
+	// duplicate products created by concurrent upserts before the unique index
+	productID1, productID2, productID3 := primitive.NewObjectID(), primitive.NewObjectID(), primitive.NewObjectID()
+	timestamp := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	{
+		_, err := db.Collection(ProductsCollection).InsertMany(ctx, []interface{}{
+			model.Product{ID: productID1, Name: "P1"},
+			model.Product{ID: productID2, Name: "P1"},
+			model.Product{ID: productID3, Name: "P2"},
+		})
+		require.NoError(t, err)
+
+		_, err = db.Collection(PriceImportsCollection).InsertMany(ctx, []interface{}{
+			model.PricesImport{ID: primitive.NewObjectID(), ProductID: productID2, Timestamp: timestamp},
+			model.PricesImport{ID: primitive.NewObjectID(), ProductID: productID3, Timestamp: timestamp},
+		})
+		require.NoError(t, err)
+	}
+
+	// check Status: all pending
+	{
+		statuses, err := targetSt.Status(ctx)
+		require.NoError(t, err)
+		require.Len(t, statuses, len(schemaMigrations))
+		for i, status := range statuses {
+			require.Equal(t, schemaMigrations[i].Version, status.Version)
+			require.False(t, status.Applied)
+		}
+	}
+
+	// check Up / Down: invalid input
+	{
+		_, err := targetSt.Up(ctx, -1)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSt.Up(ctx, 100)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSt.Down(ctx, 0)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check Up: to version 1
+	{
+		applied, err := targetSt.Up(ctx, 1)
+		require.NoError(t, err)
+		require.Len(t, applied, 1)
+		require.Equal(t, 1, applied[0].Version)
+
+		require.True(t, getIndexNames(ProductsCollection)[ProductsNameIndex])
+		require.False(t, getIndexNames(PriceImportsCollection)[PriceImportsProductIDTSIndex])
+	}
+
+	// check duplicate products are merged
+	{
+		products, err := storage.Product().GetAll(ctx)
+		require.NoError(t, err)
+		require.Len(t, products, 2)
+
+		imports, err := storage.PriceImport().GetAll(ctx, time.Time{}, productID1.Hex())
+		require.NoError(t, err)
+		require.Len(t, imports, 1)
+
+		_, err = db.Collection(ProductsCollection).InsertOne(ctx, model.Product{ID: primitive.NewObjectID(), Name: "P2"})
+		require.True(t, isDuplicateKeyError(err))
+	}
+
+	// check UpsertByName / BulkUpsertByNames: existing products
+	{
+		createdID, err := storage.Product().UpsertByName(ctx, model.Product{Name: "P1"})
+		require.NoError(t, err)
+		require.True(t, createdID.IsZero())
+
+		ids, err := storage.Product().BulkUpsertByNames(ctx, []string{"P1", "P2", "P3"})
+		require.NoError(t, err)
+		require.Equal(t, productID1, ids["P1"])
+		require.Equal(t, productID3, ids["P2"])
+	}
+
+	// duplicate price imports: created by concurrent upserts before the unique index
+	duplicateTimestamp := timestamp.Add(2 * time.Hour)
+	{
+		_, err := db.Collection(PriceImportsCollection).InsertMany(ctx, []interface{}{
+			model.PricesImport{ID: primitive.NewObjectID(), ProductID: productID1, Timestamp: duplicateTimestamp, Prices: []model.Price{
+				model.NewPrice(model.MustParseMoney("1", "USD")),
+			}},
+			model.PricesImport{ID: primitive.NewObjectID(), ProductID: productID1, Timestamp: duplicateTimestamp, Prices: []model.Price{
+				model.NewPrice(model.MustParseMoney("2", "USD")),
+				model.NewPrice(model.MustParseMoney("3", "EUR")),
+			}},
+		})
+		require.NoError(t, err)
+	}
+
+	// legacy integer prices (stored without currency) can't be decoded
+	legacyTimestamp := timestamp.Add(time.Hour)
+	{
+		_, err := db.Collection(PriceImportsCollection).InsertOne(ctx, bson.M{
+			"_id":        primitive.NewObjectID(),
+			"product_id": productID3,
+			"timestamp":  legacyTimestamp,
+			"prices": bson.A{
+				bson.M{"value": int32(12)},
+				bson.M{"value": int64(7), "currency": "EUR"},
+			},
+		})
+		require.NoError(t, err)
+
+		_, err = storage.PriceImport().GetAll(ctx, legacyTimestamp, "")
+		require.Error(t, err)
+	}
+
+	// check Up: the rest
+	{
+		applied, err := targetSt.Up(ctx, 0)
+		require.NoError(t, err)
+		require.Len(t, applied, len(schemaMigrations)-1)
+
+		require.True(t, getIndexNames(PriceImportsCollection)[PriceImportsProductIDTSIndex])
+		require.True(t, getIndexNames(ImportLedgerCollection)[ImportLedgerContentHashTSIndex])
+		require.True(t, getIndexNames(ImportLedgerCollection)[ImportLedgerContentHashIndex])
+
+		applied, err = targetSt.Up(ctx, 0)
+		require.NoError(t, err)
+		require.Empty(t, applied)
+
+		statuses, err := targetSt.Status(ctx)
+		require.NoError(t, err)
+		for _, status := range statuses {
+			require.True(t, status.Applied)
+			require.False(t, status.AppliedAt.IsZero())
+		}
+	}
+
+	// check legacy prices are converted (the default currency is set)
+	{
+		imports, err := storage.PriceImport().GetAll(ctx, legacyTimestamp, "")
+		require.NoError(t, err)
+		require.Len(t, imports, 1)
+		require.Len(t, imports[0].Prices, 2)
+		require.Equal(t, 0, imports[0].Prices[0].Money().Cmp(model.MustParseMoney("12", "USD")))
+		require.Equal(t, "USD", imports[0].Prices[0].Currency)
+		require.Equal(t, 0, imports[0].Prices[1].Money().Cmp(model.MustParseMoney("7", "EUR")))
+		require.Equal(t, "EUR", imports[0].Prices[1].Currency)
+
+		imports, err = storage.PriceImport().GetAll(ctx, timestamp, "")
+		require.NoError(t, err)
+		require.Len(t, imports, 2)
+	}
+
+	// check duplicate price imports are merged (prices are appended in the creation order)
+	{
+		imports, err := storage.PriceImport().GetAll(ctx, duplicateTimestamp, productID1.Hex())
+		require.NoError(t, err)
+		require.Len(t, imports, 1)
+		require.Len(t, imports[0].Prices, 3)
+		require.Equal(t, 0, imports[0].Prices[0].Money().Cmp(model.MustParseMoney("1", "USD")))
+		require.Equal(t, 0, imports[0].Prices[2].Money().Cmp(model.MustParseMoney("3", "EUR")))
+
+		_, err = db.Collection(PriceImportsCollection).InsertOne(ctx, model.PricesImport{ID: primitive.NewObjectID(), ProductID: productID1, Timestamp: duplicateTimestamp})
+		require.True(t, isDuplicateKeyError(err))
+	}
+
+	// check Status: unknown applied migration
+	{
+		_, err := db.Collection(MigrationsCollection).InsertOne(ctx, model.SchemaMigration{Version: 1000, Description: "future"})
+		require.NoError(t, err)
+
+		statuses, err := targetSt.Status(ctx)
+		require.NoError(t, err)
+		require.Len(t, statuses, len(schemaMigrations)+1)
+		require.True(t, statuses[len(statuses)-1].Unknown)
+
+		_, err = targetSt.Down(ctx, 1)
+		require.Error(t, err)
+
+		_, err = db.Collection(MigrationsCollection).DeleteOne(ctx, bson.M{"_id": 1000})
+		require.NoError(t, err)
+	}
+
+	// check Down: all
+	{
+		reverted, err := targetSt.Down(ctx, 100)
+		require.NoError(t, err)
+		require.Len(t, reverted, len(schemaMigrations))
+		require.Equal(t, schemaMigrations[len(schemaMigrations)-1].Version, reverted[0].Version)
+
+		require.False(t, getIndexNames(ProductsCollection)[ProductsNameIndex])
+		require.False(t, getIndexNames(PriceImportsCollection)[PriceImportsProductIDTSIndex])
+
+		statuses, err := targetSt.Status(ctx)
+		require.NoError(t, err)
+		for _, status := range statuses {
+			require.False(t, status.Applied)
+		}
+	}
+
+	// check validate: invalid migrations list
+	{
+		invalidSt := migrationStorage{
+			migrations: []migration{schemaMigrations[1], schemaMigrations[0]},
+		}
+		_, err := invalidSt.Status(ctx)
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,181 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+	"github.com/itiky/mdb-tutorial/pkg/model"
+)
+
+var _ PriceAlertStorage = (*priceAlertStorage)(nil)
+
+// priceAlertStorage keeps PriceAlertStorage dependencies.
+type priceAlertStorage struct {
+	storageCommon
+	mdbCollection *mongo.Collection
+}
+
+// Create implements PriceAlertStorage interface.
+func (s priceAlertStorage) Create(ctx context.Context, rule model.PriceAlertRule) (createdID primitive.ObjectID, retErr error) {
+	if err := rule.Validate(); err != nil {
+		retErr = err
+		return
+	}
+
+	now := time.Now().UTC()
+	rule.ID = primitive.NewObjectID()
+	rule.LastTriggeredAt = time.Time{}
+	rule.CreatedAt, rule.UpdatedAt = now, now
+
+	res, err := s.mdbCollection.InsertOne(ctx, rule)
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	id, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		retErr = fmt.Errorf("res.InsertedID type convertion failed: %T", res.InsertedID)
+		return
+	}
+	createdID = id
+
+	return
+}
+
+// GetByID implements PriceAlertStorage interface.
+func (s priceAlertStorage) GetByID(ctx context.Context, id string) (retObj model.PriceAlertRule, retErr error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		retErr = fmt.Errorf("%w: id: %v", common.ErrInvalidInput, err)
+		return
+	}
+
+	filter := bson.M{"_id": objectID}
+	res := s.mdbCollection.FindOne(ctx, filter)
+	if err := singleResultDecode(res, &retObj); err != nil {
+		retErr = err
+		return
+	}
+
+	return
+}
+
+// GetAll implements PriceAlertStorage interface.
+// nolint:govet
+func (s priceAlertStorage) GetAll(ctx context.Context, paginationOption common.PaginationOption) ([]model.PriceAlertRule, error) {
+	findOpts := options.Find().
+		SetSort(bson.D{{"created_at", -1}, {"_id", -1}}).
+		SetSkip(int64(paginationOption.Skip)).
+		SetLimit(int64(paginationOption.Limit))
+
+	return s.find(ctx, bson.M{}, findOpts)
+}
+
+// GetEnabled implements PriceAlertStorage interface.
+// nolint:govet
+func (s priceAlertStorage) GetEnabled(ctx context.Context) ([]model.PriceAlertRule, error) {
+	findOpts := options.Find().SetSort(bson.D{{"product_name", 1}, {"_id", 1}})
+
+	return s.find(ctx, bson.M{"enabled": true}, findOpts)
+}
+
+// Update implements PriceAlertStorage interface.
+func (s priceAlertStorage) Update(ctx context.Context, rule model.PriceAlertRule) error {
+	if err := rule.Validate(); err != nil {
+		return err
+	}
+
+	update := bson.M{"$set": bson.M{
+		"name":           rule.Name,
+		"product_name":   rule.ProductName,
+		"currency":       rule.Currency,
+		"condition":      rule.Condition,
+		"threshold":      rule.Threshold,
+		"change_percent": rule.ChangePercent,
+		"enabled":        rule.Enabled,
+		"updated_at":     time.Now().UTC(),
+	}}
+
+	return s.updateByID(ctx, rule.ID, update)
+}
+
+// SetTriggered implements PriceAlertStorage interface.
+func (s priceAlertStorage) SetTriggered(ctx context.Context, id primitive.ObjectID, timestamp time.Time) error {
+	if timestamp.IsZero() {
+		return fmt.Errorf("%w: timestamp: can not be empty", common.ErrInvalidInput)
+	}
+
+	update := bson.M{"$set": bson.M{
+		"last_triggered_at": timestamp,
+	}}
+
+	return s.updateByID(ctx, id, update)
+}
+
+// Delete implements PriceAlertStorage interface.
+func (s priceAlertStorage) Delete(ctx context.Context, id string) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("%w: id: %v", common.ErrInvalidInput, err)
+	}
+
+	res, err := s.mdbCollection.DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return common.ErrNotFound
+	}
+
+	return nil
+}
+
+// find loads price alert rules by filter.
+func (s priceAlertStorage) find(ctx context.Context, filter bson.M, findOpts *options.FindOptions) (retObjs []model.PriceAlertRule, retErr error) {
+	cursor, err := s.mdbCollection.Find(ctx, filter, findOpts)
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	err = cursorIterateAndDecode(ctx, cursor, func(curCursor *mongo.Cursor) error {
+		var rule model.PriceAlertRule
+		if err := curCursor.Decode(&rule); err != nil {
+			return err
+		}
+		retObjs = append(retObjs, rule)
+
+		return nil
+	})
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	return
+}
+
+// updateByID updates a single price alert rule and checks it exists.
+func (s priceAlertStorage) updateByID(ctx context.Context, id primitive.ObjectID, update bson.M) error {
+	if id.IsZero() {
+		return fmt.Errorf("%w: id: can not be empty", common.ErrInvalidInput)
+	}
+
+	res, err := s.mdbCollection.UpdateOne(ctx, bson.M{"_id": id}, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return common.ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+	"github.com/itiky/mdb-tutorial/pkg/model"
+	"github.com/itiky/mdb-tutorial/pkg/testutils"
+	"github.com/itiky/mdb-tutorial/pkg/testutils/fixtures"
+)
+
+func (s *StorageTestSuite) TestStorage_PriceAlert() {
+	t := s.T()
+	ctx := context.Background()
+
+	client := testutils.PrepareMongoDBFixtures(ctx, s.r, fixtures.NewEmptyMongoDBFixtures())
+	storage, err := NewStorage(
+		WithDatabase(testutils.TestMongoDBDatabase),
+		WithMongoDBClient(client),
+	)
+	require.NoError(t, err)
+	targetSt := storage.PriceAlert()
+
+	ruleAbove := model.PriceAlertRule{
+		Name:        "above",
+		ProductName: "P2",
+		Currency:    "USD",
+		Condition:   model.PriceAlertConditionAbove,
+		Threshold:   model.MustParseMoney("10.5", "USD"),
+		Enabled:     true,
+	}
+	ruleChange := model.PriceAlertRule{
+		ProductName:   "P1",
+		Currency:      "EUR",
+		Condition:     model.PriceAlertConditionChange,
+		ChangePercent: 5,
+		Enabled:       true,
+	}
+
+	// check GetAll / GetEnabled: empty
+	{
+		rules, err := targetSt.GetAll(ctx, common.NewPaginationOption(0, 10))
+		require.NoError(t, err)
+		require.Empty(t, rules)
+
+		rules, err = targetSt.GetEnabled(ctx)
+		require.NoError(t, err)
+		require.Empty(t, rules)
+	}
+
+	// check Create: invalid input
+	{
+		_, err := targetSt.Create(ctx, model.PriceAlertRule{ProductName: "P1", Currency: "USD"})
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		invalidRule := ruleChange
+		invalidRule.ChangePercent = 0
+		_, err = targetSt.Create(ctx, invalidRule)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		invalidRule = ruleAbove
+		invalidRule.Threshold = model.MustParseMoney("10.5", "EUR")
+		_, err = targetSt.Create(ctx, invalidRule)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check Create / GetByID
+	{
+		id, err := targetSt.Create(ctx, ruleAbove)
+		require.NoError(t, err)
+		ruleAbove.ID = id
+
+		rule, err := targetSt.GetByID(ctx, id.Hex())
+		require.NoError(t, err)
+		require.Equal(t, ruleAbove.Name, rule.Name)
+		require.Equal(t, ruleAbove.ProductName, rule.ProductName)
+		require.Equal(t, ruleAbove.Condition, rule.Condition)
+		require.Equal(t, 0, rule.Threshold.Cmp(ruleAbove.Threshold))
+		require.True(t, rule.Enabled)
+		require.True(t, rule.LastTriggeredAt.IsZero())
+		require.False(t, rule.CreatedAt.IsZero())
+
+		id, err = targetSt.Create(ctx, ruleChange)
+		require.NoError(t, err)
+		ruleChange.ID = id
+	}
+
+	// check GetByID: invalid / not found
+	{
+		_, err := targetSt.GetByID(ctx, "invalid")
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSt.GetByID(ctx, primitive.NewObjectID().Hex())
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+
+	// check GetAll: newest first
+	{
+		rules, err := targetSt.GetAll(ctx, common.NewPaginationOption(0, 10))
+		require.NoError(t, err)
+		require.Len(t, rules, 2)
+		require.Equal(t, ruleChange.ID, rules[0].ID)
+		require.Equal(t, ruleAbove.ID, rules[1].ID)
+
+		rules, err = targetSt.GetAll(ctx, common.NewPaginationOption(1, 10))
+		require.NoError(t, err)
+		require.Len(t, rules, 1)
+		require.Equal(t, ruleAbove.ID, rules[0].ID)
+	}
+
+	// check GetEnabled: sorted by product name
+	{
+		rules, err := targetSt.GetEnabled(ctx)
+		require.NoError(t, err)
+		require.Len(t, rules, 2)
+		require.Equal(t, ruleChange.ID, rules[0].ID)
+		require.Equal(t, ruleAbove.ID, rules[1].ID)
+	}
+
+	// check Update: disable and change threshold
+	{
+		updatedRule := ruleAbove
+		updatedRule.Enabled = false
+		updatedRule.Threshold = model.MustParseMoney("20", "USD")
+		require.NoError(t, targetSt.Update(ctx, updatedRule))
+
+		rule, err := targetSt.GetByID(ctx, ruleAbove.ID.Hex())
+		require.NoError(t, err)
+		require.False(t, rule.Enabled)
+		require.Equal(t, 0, rule.Threshold.Cmp(updatedRule.Threshold))
+
+		rules, err := targetSt.GetEnabled(ctx)
+		require.NoError(t, err)
+		require.Len(t, rules, 1)
+		require.Equal(t, ruleChange.ID, rules[0].ID)
+	}
+
+	// check Update: not found
+	{
+		notFoundRule := ruleChange
+		notFoundRule.ID = primitive.NewObjectID()
+		err := targetSt.Update(ctx, notFoundRule)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+
+	// check SetTriggered
+	{
+		timestamp := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		require.NoError(t, targetSt.SetTriggered(ctx, ruleChange.ID, timestamp))
+
+		rule, err := targetSt.GetByID(ctx, ruleChange.ID.Hex())
+		require.NoError(t, err)
+		require.True(t, timestamp.Equal(rule.LastTriggeredAt))
+
+		err = targetSt.SetTriggered(ctx, primitive.NewObjectID(), timestamp)
+		require.True(t, errors.Is(err, common.ErrNotFound))
+	}
+
+	// check Delete
+	{
+		require.NoError(t, targetSt.Delete(ctx, ruleAbove.ID.Hex()))
+
+		err := targetSt.Delete(ctx, ruleAbove.ID.Hex())
+		require.True(t, errors.Is(err, common.ErrNotFound))
+
+		rules, err := targetSt.GetAll(ctx, common.NewPaginationOption(0, 10))
+		require.NoError(t, err)
+		require.Len(t, rules, 1)
+		require.Equal(t, ruleChange.ID, rules[0].ID)
+	}
+}
@@ -0,0 +1,196 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+	"github.com/itiky/mdb-tutorial/pkg/model"
+)
+
+const (
+	// MongoDB "Unrecognized expression" error code ($dateTrunc is supported since 5.0)
+	mdbInvalidPipelineOperatorErrorCode = 168
+)
+
+// GetCandles implements PriceImportStorage interface.
+// Candles are built by $group with $dateTrunc, if the server doesn't support it (MongoDB < 5.0),
+// the prices series is read and candles are built in Go.
+func (s priceImportStorage) GetCandles(ctx context.Context, productID primitive.ObjectID, params model.CandlesParams) (retObjs []model.Candle, retErr error) {
+	if productID.IsZero() {
+		retErr = fmt.Errorf("%w: productID: empty", common.ErrInvalidInput)
+		return
+	}
+	if err := params.Validate(); err != nil {
+		retErr = err
+		return
+	}
+
+	pipeline := newProductPricesPipeline(productID, params.TimestampFrom, params.TimestampTo)
+
+	candles, err := s.getCandlesByDateTrunc(ctx, pipeline, params.Interval)
+	if err == nil {
+		retObjs = candles
+		return
+	}
+	if !isInvalidPipelineOperatorError(err) {
+		retErr = err
+		return
+	}
+
+	candles, err = s.getCandlesFallback(ctx, pipeline, params.Interval)
+	if err != nil {
+		retErr = fmt.Errorf("fallback: %w", err)
+		return
+	}
+	retObjs = candles
+
+	return
+}
+
+// getCandlesByDateTrunc builds candles with the aggregation pipeline.
+// nolint:govet
+func (s priceImportStorage) getCandlesByDateTrunc(ctx context.Context, pricesPipeline mongo.Pipeline, interval model.CandleInterval) ([]model.Candle, error) {
+	dateTrunc := bson.D{
+		{"date", "$timestamp"},
+		{"unit", string(interval)},
+		{"timezone", "UTC"},
+	}
+	if interval == model.CandleIntervalWeek {
+		dateTrunc = append(dateTrunc, bson.E{Key: "startOfWeek", Value: "monday"})
+	}
+
+	groupStage := bson.D{
+		{"$group", bson.D{
+			{"_id", bson.D{
+				{"start", bson.D{{"$dateTrunc", dateTrunc}}},
+				{"currency", "$price.currency"},
+			}},
+			{"open", bson.D{{"$first", "$price.amount"}}},
+			{"high", bson.D{{"$max", "$price.amount"}}},
+			{"low", bson.D{{"$min", "$price.amount"}}},
+			{"close", bson.D{{"$last", "$price.amount"}}},
+			{"count", bson.D{{"$sum", 1}}},
+		}},
+	}
+	projectStage := bson.D{
+		{"$project", bson.D{
+			{"_id", 0},
+			{"start", "$_id.start"},
+			{"currency", "$_id.currency"},
+			{"open", bson.D{{"amount", "$open"}, {"currency", "$_id.currency"}}},
+			{"high", bson.D{{"amount", "$high"}, {"currency", "$_id.currency"}}},
+			{"low", bson.D{{"amount", "$low"}, {"currency", "$_id.currency"}}},
+			{"close", bson.D{{"amount", "$close"}, {"currency", "$_id.currency"}}},
+			{"count", 1},
+		}},
+	}
+
+	pipeline := append(mongo.Pipeline{}, pricesPipeline...)
+	pipeline = append(pipeline, groupStage, projectStage)
+	pipeline = addSortAggregationStage(pipeline, common.SortOptions{
+		{FieldName: "start", Order: common.AscOrder},
+		{FieldName: "currency", Order: common.AscOrder},
+	})
+
+	cursor, err := s.mdbCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	candles := make([]model.Candle, 0)
+	err = cursorIterateAndDecode(ctx, cursor, func(curCursor *mongo.Cursor) error {
+		var candle model.Candle
+		if err := curCursor.Decode(&candle); err != nil {
+			return err
+		}
+		candles = append(candles, candle)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return candles, nil
+}
+
+// getCandlesFallback reads the prices series and builds candles in Go.
+func (s priceImportStorage) getCandlesFallback(ctx context.Context, pricesPipeline mongo.Pipeline, interval model.CandleInterval) ([]model.Candle, error) {
+	cursor, err := s.mdbCollection.Aggregate(ctx, pricesPipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	type candleKey struct {
+		start    int64
+		currency string
+	}
+	candlesIdx := make(map[candleKey]int)
+	candles := make([]model.Candle, 0)
+
+	err = cursorIterateAndDecode(ctx, cursor, func(curCursor *mongo.Cursor) error {
+		var point model.PriceHistoryPoint
+		if err := curCursor.Decode(&point); err != nil {
+			return err
+		}
+
+		start := interval.Truncate(point.Timestamp)
+		key := candleKey{start: start.UnixNano(), currency: point.Price.Currency}
+		idx, found := candlesIdx[key]
+		if !found {
+			candlesIdx[key] = len(candles)
+			candles = append(candles, model.Candle{
+				Start:    start,
+				Currency: point.Price.Currency,
+				Open:     point.Price,
+				High:     point.Price,
+				Low:      point.Price,
+				Close:    point.Price,
+				Count:    1,
+			})
+			return nil
+		}
+
+		// points are read in the chronological order
+		candle := &candles[idx]
+		if point.Price.Cmp(candle.High) > 0 {
+			candle.High = point.Price
+		}
+		if point.Price.Cmp(candle.Low) < 0 {
+			candle.Low = point.Price
+		}
+		candle.Close = point.Price
+		candle.Count++
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(candles, func(i, j int) bool {
+		if !candles[i].Start.Equal(candles[j].Start) {
+			return candles[i].Start.Before(candles[j].Start)
+		}
+		return candles[i].Currency < candles[j].Currency
+	})
+
+	return candles, nil
+}
+
+// isInvalidPipelineOperatorError checks if the aggregation failed due to an unsupported operator.
+func isInvalidPipelineOperatorError(err error) bool {
+	var cmdErr mongo.CommandError
+	if errors.As(err, &cmdErr) {
+		return cmdErr.Code == mdbInvalidPipelineOperatorErrorCode
+	}
+
+	return false
+}
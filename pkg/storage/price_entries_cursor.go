@@ -0,0 +1,111 @@
+package storage
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/itiky/mdb-tutorial/pkg/common"
+)
+
+const (
+	// Unwound price entry unique key fields (price import ID and price index within the import)
+	priceEntryIDField  = "_id"
+	priceEntryIdxField = "price_idx"
+)
+
+// priceEntriesCursor is a keyset pagination continuation token payload.
+type priceEntriesCursor struct {
+	// Sort options signature the cursor was created for
+	Sort string `bson:"s"`
+	// The last page entry sort key values (in the sort keys order)
+	Keys bson.A `bson:"k"`
+}
+
+// newPriceEntriesSortKeys returns price entries sort keys: requested ones with the unique key appended (so the order is total).
+func newPriceEntriesSortKeys(sortOptions common.SortOptions) common.SortOptions {
+	keys := make(common.SortOptions, 0, len(sortOptions)+2)
+	keys = append(keys, sortOptions...)
+	keys = append(keys,
+		common.SortOption{FieldName: priceEntryIDField, Order: common.AscOrder},
+		common.SortOption{FieldName: priceEntryIdxField, Order: common.AscOrder},
+	)
+
+	return keys
+}
+
+// sortKeysSignature builds sort keys string representation used to check the cursor belongs to the same query.
+func sortKeysSignature(sortKeys common.SortOptions) string {
+	parts := make([]string, 0, len(sortKeys))
+	for _, key := range sortKeys {
+		parts = append(parts, fmt.Sprintf("%s:%d", key.FieldName, key.Order.MongoDBOrder()))
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// encodePriceEntriesCursor builds continuation token using the last page entry sort key values.
+func encodePriceEntriesCursor(sortKeys common.SortOptions, lastEntry bson.Raw) (string, error) {
+	cursor := priceEntriesCursor{
+		Sort: sortKeysSignature(sortKeys),
+		Keys: make(bson.A, 0, len(sortKeys)),
+	}
+	for _, key := range sortKeys {
+		value, err := lastEntry.LookupErr(strings.Split(key.FieldName, ".")...)
+		if err != nil {
+			return "", fmt.Errorf("sort key %s lookup: %w", key.FieldName, err)
+		}
+		cursor.Keys = append(cursor.Keys, value)
+	}
+
+	data, err := bson.Marshal(cursor)
+	if err != nil {
+		return "", fmt.Errorf("marshal: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodePriceEntriesCursor parses continuation token checking it has been created for the same sort keys.
+func decodePriceEntriesCursor(sortKeys common.SortOptions, token string) (priceEntriesCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return priceEntriesCursor{}, fmt.Errorf("%w: cursor: malformed", common.ErrInvalidInput)
+	}
+
+	var cursor priceEntriesCursor
+	if err := bson.Unmarshal(data, &cursor); err != nil {
+		return priceEntriesCursor{}, fmt.Errorf("%w: cursor: malformed", common.ErrInvalidInput)
+	}
+
+	if cursor.Sort != sortKeysSignature(sortKeys) || len(cursor.Keys) != len(sortKeys) {
+		return priceEntriesCursor{}, fmt.Errorf("%w: cursor: created for different sort options", common.ErrInvalidInput)
+	}
+
+	return cursor, nil
+}
+
+// newKeysetMatch builds a filter for entries following the cursor in the sort keys order:
+// (k1 > v1) OR (k1 == v1 AND k2 > v2) OR ... ($lt for DESC keys).
+// nolint:govet
+func newKeysetMatch(sortKeys common.SortOptions, cursor priceEntriesCursor) bson.M {
+	conditions := make(bson.A, 0, len(sortKeys))
+	for i, key := range sortKeys {
+		condition := bson.D{}
+		for j := 0; j < i; j++ {
+			condition = append(condition, bson.E{Key: sortKeys[j].FieldName, Value: cursor.Keys[j]})
+		}
+
+		op := "$gt"
+		if key.Order == common.DescOrder {
+			op = "$lt"
+		}
+		condition = append(condition, bson.E{Key: key.FieldName, Value: bson.D{{op, cursor.Keys[i]}}})
+
+		conditions = append(conditions, condition)
+	}
+
+	return bson.M{"$or": conditions}
+}
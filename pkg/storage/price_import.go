@@ -2,7 +2,10 @@ package storage
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"regexp"
+	"sort"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -59,6 +62,152 @@ func (s priceImportStorage) UpsertByProductIDAndTimestamp(ctx context.Context, p
 	return
 }
 
+// BulkUpsertByProductIDAndTimestamp implements PriceImportStorage interface.
+func (s priceImportStorage) BulkUpsertByProductIDAndTimestamp(ctx context.Context, pricesImports []model.PricesImport) (retCreated int64, retErr error) {
+	if len(pricesImports) == 0 {
+		retErr = fmt.Errorf("%w: pricesImports: empty", common.ErrInvalidInput)
+		return
+	}
+
+	writeModels := make([]mongo.WriteModel, 0, len(pricesImports))
+	for i, pricesImport := range pricesImports {
+		if pricesImport.Timestamp.IsZero() {
+			retErr = fmt.Errorf("%w: pricesImports[%d]: timestamp: can not be empty", common.ErrInvalidInput, i)
+			return
+		}
+		if pricesImport.ProductID.IsZero() {
+			retErr = fmt.Errorf("%w: pricesImports[%d]: product_id: can not be empty", common.ErrInvalidInput, i)
+			return
+		}
+
+		writeModels = append(writeModels, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{
+				"timestamp":  pricesImport.Timestamp,
+				"product_id": pricesImport.ProductID,
+			}).
+			SetUpdate(bson.M{"$set": bson.M{
+				"prices": pricesImport.Prices,
+			}}).
+			SetUpsert(true),
+		)
+	}
+
+	res, err := s.mdbCollection.BulkWrite(ctx, writeModels, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		retErr = fmt.Errorf("bulk write: %w", err)
+		return
+	}
+	retCreated = res.UpsertedCount
+
+	return
+}
+
+// BulkAppendByProductIDAndTimestamp implements PriceImportStorage interface.
+func (s priceImportStorage) BulkAppendByProductIDAndTimestamp(ctx context.Context, pricesImports []model.PricesImport) (retCreated int64, retErr error) {
+	if len(pricesImports) == 0 {
+		retErr = fmt.Errorf("%w: pricesImports: empty", common.ErrInvalidInput)
+		return
+	}
+
+	writeModels := make([]mongo.WriteModel, 0, len(pricesImports))
+	for i, pricesImport := range pricesImports {
+		if pricesImport.Timestamp.IsZero() {
+			retErr = fmt.Errorf("%w: pricesImports[%d]: timestamp: can not be empty", common.ErrInvalidInput, i)
+			return
+		}
+		if pricesImport.ProductID.IsZero() {
+			retErr = fmt.Errorf("%w: pricesImports[%d]: product_id: can not be empty", common.ErrInvalidInput, i)
+			return
+		}
+		if len(pricesImport.Prices) == 0 {
+			retErr = fmt.Errorf("%w: pricesImports[%d]: prices: empty", common.ErrInvalidInput, i)
+			return
+		}
+
+		// chunks are appended concurrently in arbitrary order: prices with known rows are kept sorted by the CSV-file row,
+		// so the last currency price is the latest CSV-file row one
+		push := bson.M{"$each": pricesImport.Prices}
+		if hasPriceRows(pricesImport.Prices) {
+			push["$sort"] = bson.M{"row": 1}
+		}
+
+		writeModels = append(writeModels, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{
+				"timestamp":  pricesImport.Timestamp,
+				"product_id": pricesImport.ProductID,
+			}).
+			SetUpdate(bson.M{"$push": bson.M{
+				"prices": push,
+			}}).
+			SetUpsert(true),
+		)
+	}
+
+	res, err := s.mdbCollection.BulkWrite(ctx, writeModels, options.BulkWrite().SetOrdered(false))
+	if res != nil {
+		retCreated = res.UpsertedCount
+	}
+
+	// concurrent upserts race for the unique {product_id, timestamp} index: only failed writes are retried once
+	// (others are already applied and must not be appended twice)
+	var bulkErr mongo.BulkWriteException
+	if isDuplicateKeyError(err) && errors.As(err, &bulkErr) && bulkErr.WriteConcernError == nil {
+		retryModels := make([]mongo.WriteModel, 0, len(bulkErr.WriteErrors))
+		for _, writeErr := range bulkErr.WriteErrors {
+			if !duplicateKeyErrorCodes[writeErr.Code] {
+				retErr = fmt.Errorf("bulk write: %w", err)
+				return
+			}
+			retryModels = append(retryModels, writeModels[writeErr.Index])
+		}
+
+		res, err = s.mdbCollection.BulkWrite(ctx, retryModels, options.BulkWrite().SetOrdered(false))
+		if res != nil {
+			retCreated += res.UpsertedCount
+		}
+	}
+	if err != nil {
+		retErr = fmt.Errorf("bulk write: %w", err)
+		return
+	}
+
+	return
+}
+
+// DeleteByTimeRange implements PriceImportStorage interface.
+func (s priceImportStorage) DeleteByTimeRange(ctx context.Context, from, to time.Time) (retDeleted int64, retProductIDs []primitive.ObjectID, retErr error) {
+	if from.IsZero() || !to.After(from) {
+		retErr = fmt.Errorf("%w: time range [%s, %s): invalid", common.ErrInvalidInput, from, to)
+		return
+	}
+
+	filter := bson.M{"timestamp": bson.M{"$gte": from, "$lt": to}}
+
+	// affected products are collected first as orphans cleanup candidates
+	productIDs, err := s.mdbCollection.Distinct(ctx, "product_id", filter)
+	if err != nil {
+		retErr = fmt.Errorf("product IDs lookup: %w", err)
+		return
+	}
+	for _, rawID := range productIDs {
+		id, ok := rawID.(primitive.ObjectID)
+		if !ok {
+			retErr = fmt.Errorf("product_id type convertion failed: %T", rawID)
+			return
+		}
+		retProductIDs = append(retProductIDs, id)
+	}
+
+	res, err := s.mdbCollection.DeleteMany(ctx, filter)
+	if err != nil {
+		retErr = err
+		return
+	}
+	retDeleted = res.DeletedCount
+
+	return
+}
+
 // GetAll implements PriceImportStorage interface.
 func (s priceImportStorage) GetAll(ctx context.Context, timestamp time.Time, productID string) (retObjs []model.PricesImport, retErr error) {
 	filter := bson.M{}
@@ -98,12 +247,764 @@ func (s priceImportStorage) GetAll(ctx context.Context, timestamp time.Time, pro
 	return
 }
 
+// GetByTimeRange implements PriceImportStorage interface.
+// nolint:govet
+func (s priceImportStorage) GetByTimeRange(ctx context.Context, from, to time.Time) (retObjs []model.PricesImport, retErr error) {
+	if from.IsZero() || !to.After(from) {
+		retErr = fmt.Errorf("%w: time range [%s, %s): invalid", common.ErrInvalidInput, from, to)
+		return
+	}
+
+	filter := bson.M{"timestamp": bson.M{"$gte": from, "$lt": to}}
+	findOpts := options.Find().SetSort(bson.D{{"timestamp", 1}})
+
+	cursor, err := s.mdbCollection.Find(ctx, filter, findOpts)
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	err = cursorIterateAndDecode(ctx, cursor, func(curCursor *mongo.Cursor) error {
+		var pricesImport model.PricesImport
+		if err := curCursor.Decode(&pricesImport); err != nil {
+			return err
+		}
+		retObjs = append(retObjs, pricesImport)
+
+		return nil
+	})
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	return
+}
+
+// GetImportTimestamps implements PriceImportStorage interface.
+func (s priceImportStorage) GetImportTimestamps(ctx context.Context, from, to time.Time) (retObjs []time.Time, retErr error) {
+	if from.IsZero() || !to.After(from) {
+		retErr = fmt.Errorf("%w: time range [%s, %s): invalid", common.ErrInvalidInput, from, to)
+		return
+	}
+
+	rawTimestamps, err := s.mdbCollection.Distinct(ctx, "timestamp", bson.M{"timestamp": bson.M{"$gte": from, "$lt": to}})
+	if err != nil {
+		retErr = err
+		return
+	}
+	for _, rawTimestamp := range rawTimestamps {
+		timestamp, ok := rawTimestamp.(primitive.DateTime)
+		if !ok {
+			retErr = fmt.Errorf("timestamp type convertion failed: %T", rawTimestamp)
+			return
+		}
+		retObjs = append(retObjs, timestamp.Time().UTC())
+	}
+	sort.Slice(retObjs, func(i, j int) bool { return retObjs[i].Before(retObjs[j]) })
+
+	return
+}
+
+// GetLastImportTimestamps implements PriceImportStorage interface.
+// Every timestamp is queried separately using the {timestamp} index.
+// nolint:govet
+func (s priceImportStorage) GetLastImportTimestamps(ctx context.Context, limit int, excluded []time.Time) (retObjs []time.Time, retErr error) {
+	if limit <= 0 {
+		retErr = fmt.Errorf("%w: limit: should be GT 0", common.ErrInvalidInput)
+		return
+	}
+
+	findOpts := options.FindOne().
+		SetSort(bson.D{{"timestamp", -1}}).
+		SetProjection(bson.D{{"timestamp", 1}})
+
+	// nil slice is encoded as null ($nin requires an array)
+	if excluded == nil {
+		excluded = []time.Time{}
+	}
+
+	filter := bson.M{"timestamp": bson.M{"$nin": excluded}}
+	for len(retObjs) < limit {
+		var pricesImport model.PricesImport
+		if err := singleResultDecode(s.mdbCollection.FindOne(ctx, filter, findOpts), &pricesImport); err != nil {
+			if errors.Is(err, common.ErrNotFound) {
+				break
+			}
+			retErr = err
+			return
+		}
+
+		retObjs = append(retObjs, pricesImport.Timestamp)
+		filter = bson.M{"timestamp": bson.M{"$lt": pricesImport.Timestamp, "$nin": excluded}}
+	}
+
+	return
+}
+
 // GetPriceEntries implements PriceImportStorage interface.
 // nolint:govet
 func (s priceImportStorage) GetPriceEntries(
 	ctx context.Context,
-	sortOptions common.SortOptions, paginationOption common.PaginationOption,
-) (retObjs model.PriceEntries, retErr error) {
+	filter model.PriceEntriesFilter, sortOptions common.SortOptions, paginationOption common.PaginationOption, countTotal bool,
+) (retPage model.PriceEntriesPage, retErr error) {
+
+	retPage.TotalCount = -1
+	if countTotal {
+		retPage.TotalCount = 0
+	}
+
+	if err := filter.Validate(); err != nil {
+		retErr = err
+		return
+	}
+
+	// entries are always sorted by the unique key as well, so pages are stable and the next page cursor could be built
+	sortKeys := newPriceEntriesSortKeys(sortOptions)
+	var pageCursor *priceEntriesCursor
+	if paginationOption.Cursor != "" {
+		decodedCursor, err := decodePriceEntriesCursor(sortKeys, paginationOption.Cursor)
+		if err != nil {
+			retErr = err
+			return
+		}
+		pageCursor = &decodedCursor
+	}
+
+	// define filter stages: price imports fields are matched before $lookup (indexes could be used)
+	importsMatch, found, err := s.newPriceImportsMatch(ctx, filter)
+	if err != nil {
+		retErr = err
+		return
+	}
+	if !found {
+		return
+	}
+	// if the first sort key is a price import one, the cursor position is matched before $lookup as well (_id index is used),
+	// unless all entries are counted
+	keysetMatch := bson.M{}
+	if pageCursor != nil {
+		keysetMatch = newKeysetMatch(sortKeys, *pageCursor)
+		if sortKeys[0].FieldName == priceEntryIDField && !countTotal {
+			importsMatch[priceEntryIDField] = bson.M{"$gte": pageCursor.Keys[0]}
+		}
+	}
+	pipeline := s.newPriceEntriesPipeline(importsMatch, filter)
+
+	// one extra entry is requested to check if there are more entries
+	pageOption := paginationOption
+	pageOption.Limit++
+	pagePipeline := mongo.Pipeline{}
+	pagePipeline = addMatchAggregationStage(pagePipeline, keysetMatch)
+	pagePipeline = addSortAggregationStage(pagePipeline, sortKeys)
+	pagePipeline = addPaginationAggregationStage(pagePipeline, pageOption)
+
+	// page entries and total count are calculated within a single request
+	if countTotal {
+		pipeline = append(pipeline, bson.D{
+			{"$facet", bson.D{
+				{"entries", pagePipeline},
+				{"total", bson.A{bson.D{{"$count", "count"}}}},
+			}},
+		})
+	} else {
+		pipeline = append(pipeline, pagePipeline...)
+	}
+
+	cursor, err := s.mdbCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	rawEntries := make([]bson.Raw, 0, pageOption.Limit)
+	err = cursorIterateAndDecode(ctx, cursor, func(curCursor *mongo.Cursor) error {
+		if !countTotal {
+			rawEntries = append(rawEntries, append(bson.Raw(nil), curCursor.Current...))
+			return nil
+		}
+
+		var facetResult struct {
+			Entries []bson.Raw `bson:"entries"`
+			Total   []struct {
+				Count int64 `bson:"count"`
+			} `bson:"total"`
+		}
+		if err := curCursor.Decode(&facetResult); err != nil {
+			return err
+		}
+		rawEntries = facetResult.Entries
+		if len(facetResult.Total) > 0 {
+			retPage.TotalCount = facetResult.Total[0].Count
+		}
+
+		return nil
+	})
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	if len(rawEntries) > paginationOption.Limit {
+		rawEntries, retPage.HasMore = rawEntries[:paginationOption.Limit], true
+	}
+
+	for i, rawEntry := range rawEntries {
+		var priceEntry model.PriceEntry
+		if err := bson.Unmarshal(rawEntry, &priceEntry); err != nil {
+			retErr = fmt.Errorf("decoding [%d]: %w", i, err)
+			return
+		}
+		retPage.Entries = append(retPage.Entries, priceEntry)
+	}
+
+	if retPage.HasMore {
+		nextCursor, err := encodePriceEntriesCursor(sortKeys, rawEntries[len(rawEntries)-1])
+		if err != nil {
+			retErr = fmt.Errorf("next page cursor: %w", err)
+			return
+		}
+		retPage.NextCursor = nextCursor
+	}
+
+	return
+}
+
+// StreamPriceEntries implements PriceImportStorage interface.
+// nolint:govet
+func (s priceImportStorage) StreamPriceEntries(
+	ctx context.Context,
+	filter model.PriceEntriesFilter, sortOptions common.SortOptions, limit int,
+	handler func(entry model.PriceEntry) error,
+) (retErr error) {
+
+	if err := filter.Validate(); err != nil {
+		retErr = err
+		return
+	}
+	if limit < 0 {
+		retErr = fmt.Errorf("%w: limit: should be GTE 0", common.ErrInvalidInput)
+		return
+	}
+	if handler == nil {
+		retErr = fmt.Errorf("%w: handler: nil", common.ErrInvalidInput)
+		return
+	}
+
+	importsMatch, found, err := s.newPriceImportsMatch(ctx, filter)
+	if err != nil {
+		retErr = err
+		return
+	}
+	if !found {
+		return
+	}
+
+	// entries are sorted only if requested, sorting the whole collection might exceed the memory limit, so disk use is allowed
+	pipeline := s.newPriceEntriesPipeline(importsMatch, filter)
+	if len(sortOptions) > 0 {
+		pipeline = addSortAggregationStage(pipeline, newPriceEntriesSortKeys(sortOptions))
+	}
+	if limit > 0 {
+		pipeline = append(pipeline, bson.D{{"$limit", limit}})
+	}
+
+	cursor, err := s.mdbCollection.Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		retErr = err
+		return
+	}
+	defer cursor.Close(ctx)
+
+	err = cursorIterateAndDecode(ctx, cursor, func(curCursor *mongo.Cursor) error {
+		var priceEntry model.PriceEntry
+		if err := curCursor.Decode(&priceEntry); err != nil {
+			return err
+		}
+
+		return handler(priceEntry)
+	})
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	return
+}
+
+// GetPriceStats implements PriceImportStorage interface.
+// Filters are applied the same way as for GetPriceEntries, but products are looked up after grouping (once per group).
+// Median is calculated using the sorted group prices array ($median requires MongoDB 7.0).
+// nolint:govet
+func (s priceImportStorage) GetPriceStats(
+	ctx context.Context,
+	filter model.PriceEntriesFilter, paginationOption common.PaginationOption,
+) (retObjs []model.PriceStats, retErr error) {
+
+	if err := filter.Validate(); err != nil {
+		retErr = err
+		return
+	}
+
+	importsMatch, found, err := s.newPriceImportsMatch(ctx, filter)
+	if err != nil {
+		retErr = err
+		return
+	}
+	if !found {
+		return
+	}
+	productsMatch := newProductsMatch(filter)
+	pricesMatch := newPricesMatch(filter)
+
+	// legacy integer prices are converted to decimals (those have no currency)
+	projectPricesStage := bson.D{
+		{"$project", bson.D{
+			{"product_id", 1},
+			{"price", bson.D{
+				{"amount", bson.D{{"$toDecimal", "$prices.value"}}},
+				{"currency", "$prices.currency"},
+			}},
+		}},
+	}
+	// sorted amounts are pushed to calculate the median
+	groupStage := bson.D{
+		{"$group", bson.D{
+			{"_id", bson.D{
+				{"product_id", "$product_id"},
+				{"currency", "$price.currency"},
+			}},
+			{"min", bson.D{{"$min", "$price.amount"}}},
+			{"max", bson.D{{"$max", "$price.amount"}}},
+			{"mean", bson.D{{"$avg", "$price.amount"}}},
+			{"std_dev", bson.D{{"$stdDevPop", "$price.amount"}}},
+			{"prices_count", bson.D{{"$sum", 1}}},
+			{"imports", bson.D{{"$addToSet", "$_id"}}},
+			{"amounts", bson.D{{"$push", "$price.amount"}}},
+		}},
+	}
+	medianIdx := func(round string) bson.D {
+		return bson.D{{"$toInt", bson.D{{round, bson.D{{"$divide", bson.A{bson.D{{"$subtract", bson.A{"$prices_count", 1}}}, 2}}}}}}}
+	}
+	lookupStage := bson.D{
+		{"$lookup", bson.D{
+			{"from", s.productsCollection},
+			{"localField", "_id.product_id"},
+			{"foreignField", "_id"},
+			{"as", "fromProducts"},
+		}},
+	}
+	projectStatsStage := bson.D{
+		{"$project", bson.D{
+			{"_id", 0},
+			{"name", bson.D{{"$arrayElemAt", bson.A{"$fromProducts.name", 0}}}},
+			{"currency", "$_id.currency"},
+			{"min", bson.D{{"amount", "$min"}, {"currency", "$_id.currency"}}},
+			{"max", bson.D{{"amount", "$max"}, {"currency", "$_id.currency"}}},
+			{"mean", bson.D{{"amount", bson.D{{"$toDecimal", "$mean"}}}, {"currency", "$_id.currency"}}},
+			{"median", bson.D{
+				{"amount", bson.D{{"$toDecimal", bson.D{{"$avg", bson.A{
+					bson.D{{"$arrayElemAt", bson.A{"$amounts", medianIdx("$floor")}}},
+					bson.D{{"$arrayElemAt", bson.A{"$amounts", medianIdx("$ceil")}}},
+				}}}}}},
+				{"currency", "$_id.currency"},
+			}},
+			{"std_dev", bson.D{{"$toDouble", "$std_dev"}}},
+			{"prices_count", 1},
+			{"imports_count", bson.D{{"$size", "$imports"}}},
+		}},
+	}
+
+	// build pipeline
+	pipeline := mongo.Pipeline{}
+	pipeline = addMatchAggregationStage(pipeline, importsMatch)
+	pipeline = append(pipeline, bson.D{{"$unwind", "$prices"}}, projectPricesStage)
+	pipeline = addMatchAggregationStage(pipeline, pricesMatch)
+	pipeline = addSortAggregationStage(pipeline, common.SortOptions{
+		{FieldName: "product_id", Order: common.AscOrder},
+		{FieldName: "price.amount", Order: common.AscOrder},
+	})
+	pipeline = append(pipeline, groupStage, lookupStage, projectStatsStage)
+	pipeline = addMatchAggregationStage(pipeline, productsMatch)
+	pipeline = addSortAggregationStage(pipeline, common.SortOptions{
+		{FieldName: "name", Order: common.AscOrder},
+		{FieldName: "currency", Order: common.AscOrder},
+	})
+	pipeline = addPaginationAggregationStage(pipeline, paginationOption)
+
+	cursor, err := s.mdbCollection.Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	err = cursorIterateAndDecode(ctx, cursor, func(curCursor *mongo.Cursor) error {
+		var stats model.PriceStats
+		if err := curCursor.Decode(&stats); err != nil {
+			return err
+		}
+		retObjs = append(retObjs, stats)
+
+		return nil
+	})
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	return
+}
+
+// GetLatestPrices implements PriceImportStorage interface.
+// Aggregation starts from "products" (name index is used for sorting) and paginates them after the lookup,
+// so products without price imports don't shorten pages. Pipeline has no blocking stages, so the latest price import
+// is looked up only until the page is filled (skipped products included). The lookup walks the {product_id, timestamp} index by product_id equality
+// in timestamp DESC order, the AsOf range predicate ($expr with $lte) isn't an index bound before MongoDB 5.0,
+// so imports newer than AsOf are scanned and filtered out.
+// nolint:govet
+func (s priceImportStorage) GetLatestPrices(
+	ctx context.Context,
+	filter model.LatestPricesFilter, paginationOption common.PaginationOption,
+) (retObjs []model.LatestPrices, retErr error) {
+
+	if err := filter.Validate(); err != nil {
+		retErr = err
+		return
+	}
+
+	productsMatch := bson.M{}
+	if len(filter.ProductNames) > 0 {
+		productsMatch["name"] = bson.M{"$in": filter.ProductNames}
+	}
+
+	lookupMatchExpr := bson.D{{"$eq", bson.A{"$product_id", "$$productID"}}}
+	if !filter.AsOf.IsZero() {
+		lookupMatchExpr = bson.D{{"$and", bson.A{
+			lookupMatchExpr,
+			bson.D{{"$lte", bson.A{"$timestamp", filter.AsOf}}},
+		}}}
+	}
+
+	// legacy integer prices are converted to decimals (those have no currency)
+	lookupStage := bson.D{
+		{"$lookup", bson.D{
+			{"from", s.mdbCollection.Name()},
+			{"let", bson.D{{"productID", "$_id"}}},
+			{"pipeline", mongo.Pipeline{
+				{{"$match", bson.D{{"$expr", lookupMatchExpr}}}},
+				{{"$sort", bson.D{{"timestamp", -1}}}},
+				{{"$limit", 1}},
+				{{"$project", bson.D{
+					{"_id", 0},
+					{"timestamp", 1},
+					{"prices", newMoneyPricesExpr("$prices")},
+				}}},
+			}},
+			{"as", "latest"},
+		}},
+	}
+	// products without price imports are dropped here (before pagination)
+	unwindStage := bson.D{
+		{"$unwind", "$latest"},
+	}
+	projectStage := bson.D{
+		{"$project", bson.D{
+			{"_id", 0},
+			{"name", 1},
+			{"timestamp", "$latest.timestamp"},
+			{"prices", "$latest.prices"},
+		}},
+	}
+
+	// build pipeline
+	pipeline := mongo.Pipeline{}
+	pipeline = addMatchAggregationStage(pipeline, productsMatch)
+	pipeline = addSortAggregationStage(pipeline, common.SortOptions{{FieldName: "name", Order: common.AscOrder}})
+	pipeline = append(pipeline, lookupStage, unwindStage)
+	pipeline = addPaginationAggregationStage(pipeline, paginationOption)
+	pipeline = append(pipeline, projectStage)
+
+	cursor, err := s.mdbCollection.Database().Collection(s.productsCollection).Aggregate(ctx, pipeline)
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	err = cursorIterateAndDecode(ctx, cursor, func(curCursor *mongo.Cursor) error {
+		var latestPrices model.LatestPrices
+		if err := curCursor.Decode(&latestPrices); err != nil {
+			return err
+		}
+		retObjs = append(retObjs, latestPrices)
+
+		return nil
+	})
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	return
+}
+
+// StreamTopMoversPrices implements PriceImportStorage interface.
+// Exact timestamps groups are compared with a single price_imports aggregation: imports of both groups are matched by the {timestamp} index
+// and grouped per product (the {product_id, timestamp} unique index guarantees a single document per import), so a group prices
+// are concatenated in the timestamps order, products present in a single group are dropped. "As of" timestamps aggregation
+// starts from "products" looking up the latest price import as of both timestamps per product
+// (the {product_id, timestamp} index is walked as for GetLatestPrices).
+// nolint:govet
+func (s priceImportStorage) StreamTopMoversPrices(
+	ctx context.Context,
+	filter model.TopMoversPricesFilter,
+	handler func(prices model.TopMoversPrices) error,
+) (retErr error) {
+
+	if err := filter.Validate(); err != nil {
+		retErr = err
+		return
+	}
+	if handler == nil {
+		retErr = fmt.Errorf("%w: handler: nil", common.ErrInvalidInput)
+		return
+	}
+
+	var collection *mongo.Collection
+	var pipeline mongo.Pipeline
+	if filter.IsExact() {
+		groupPricesExpr := func(timestamps []time.Time) bson.D {
+			// prices of the other group imports are pushed as nulls (skipped on concatenation)
+			return bson.D{{"$push", bson.D{{"$cond", bson.A{
+				bson.D{{"$in", bson.A{"$timestamp", timestamps}}},
+				"$prices",
+				nil,
+			}}}}}
+		}
+		concatPricesExpr := func(input string) bson.D {
+			return bson.D{{"$reduce", bson.D{
+				{"input", input},
+				{"initialValue", bson.A{}},
+				{"in", bson.D{{"$concatArrays", bson.A{"$$value", bson.D{{"$ifNull", bson.A{"$$this", bson.A{}}}}}}}},
+			}}}
+		}
+
+		timestamps := make([]time.Time, 0, len(filter.ImportFrom)+len(filter.ImportTo))
+		timestamps = append(timestamps, filter.ImportFrom...)
+		timestamps = append(timestamps, filter.ImportTo...)
+
+		collection = s.mdbCollection
+		pipeline = mongo.Pipeline{
+			{{"$match", bson.D{{"timestamp", bson.D{{"$in", timestamps}}}}}},
+			{{"$sort", bson.D{{"timestamp", 1}}}},
+			{{"$group", bson.D{
+				{"_id", "$product_id"},
+				{"from_prices", groupPricesExpr(filter.ImportFrom)},
+				{"to_prices", groupPricesExpr(filter.ImportTo)},
+			}}},
+			{{"$project", bson.D{
+				{"from_prices", concatPricesExpr("$from_prices")},
+				{"to_prices", concatPricesExpr("$to_prices")},
+			}}},
+			{{"$match", bson.D{
+				{"from_prices.0", bson.D{{"$exists", true}}},
+				{"to_prices.0", bson.D{{"$exists", true}}},
+			}}},
+			{{"$lookup", bson.D{
+				{"from", s.productsCollection},
+				{"localField", "_id"},
+				{"foreignField", "_id"},
+				{"as", "product"},
+			}}},
+			{{"$unwind", "$product"}},
+			{{"$project", bson.D{
+				{"_id", 0},
+				{"name", "$product.name"},
+				{"from_prices", newMoneyPricesExpr("$from_prices")},
+				{"to_prices", newMoneyPricesExpr("$to_prices")},
+			}}},
+		}
+	} else {
+		latestImportLookupStage := func(asOf time.Time, as string) bson.D {
+			return bson.D{
+				{"$lookup", bson.D{
+					{"from", s.mdbCollection.Name()},
+					{"let", bson.D{{"productID", "$_id"}}},
+					{"pipeline", mongo.Pipeline{
+						{{"$match", bson.D{{"$expr", bson.D{{"$and", bson.A{
+							bson.D{{"$eq", bson.A{"$product_id", "$$productID"}}},
+							bson.D{{"$lte", bson.A{"$timestamp", asOf}}},
+						}}}}}}},
+						{{"$sort", bson.D{{"timestamp", -1}}}},
+						{{"$limit", 1}},
+						{{"$project", bson.D{
+							{"_id", 0},
+							{"prices", 1},
+						}}},
+					}},
+					{"as", as},
+				}},
+			}
+		}
+
+		// products without price imports as of any of the timestamps are dropped by $unwind
+		collection = s.mdbCollection.Database().Collection(s.productsCollection)
+		pipeline = mongo.Pipeline{
+			latestImportLookupStage(filter.AsOfFrom, "from"),
+			{{"$unwind", "$from"}},
+			latestImportLookupStage(filter.AsOfTo, "to"),
+			{{"$unwind", "$to"}},
+			{{"$project", bson.D{
+				{"_id", 0},
+				{"name", 1},
+				{"from_prices", newMoneyPricesExpr("$from.prices")},
+				{"to_prices", newMoneyPricesExpr("$to.prices")},
+			}}},
+		}
+	}
+
+	cursor, err := collection.Aggregate(ctx, pipeline, options.Aggregate().SetAllowDiskUse(true))
+	if err != nil {
+		retErr = err
+		return
+	}
+	defer cursor.Close(ctx)
+
+	err = cursorIterateAndDecode(ctx, cursor, func(curCursor *mongo.Cursor) error {
+		var prices model.TopMoversPrices
+		if err := curCursor.Decode(&prices); err != nil {
+			return err
+		}
+
+		return handler(prices)
+	})
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	return
+}
+
+// newMoneyPricesExpr returns an aggregation expression converting prices array to Money objects
+// (legacy integer prices are converted to decimals, those have no currency).
+// nolint:govet
+func newMoneyPricesExpr(input string) bson.D {
+	return bson.D{
+		{"$map", bson.D{
+			{"input", input},
+			{"in", bson.D{
+				{"amount", bson.D{{"$toDecimal", "$$this.value"}}},
+				{"currency", "$$this.currency"},
+			}},
+		}},
+	}
+}
+
+// GetProductHistory implements PriceImportStorage interface.
+// Buckets are aligned to UNIX epoch.
+// nolint:govet
+func (s priceImportStorage) GetProductHistory(ctx context.Context, productID primitive.ObjectID, params model.PriceHistoryParams) (retObjs []model.PriceHistoryPoint, retErr error) {
+	if productID.IsZero() {
+		retErr = fmt.Errorf("%w: productID: empty", common.ErrInvalidInput)
+		return
+	}
+	if err := params.Validate(); err != nil {
+		retErr = err
+		return
+	}
+
+	pipeline := newProductPricesPipeline(productID, params.TimestampFrom, params.TimestampTo)
+	if params.BucketSize > 0 {
+		bucketMs := params.BucketSize.Milliseconds()
+		groupStage := bson.D{
+			{"$group", bson.D{
+				{"_id", bson.D{
+					{"bucket", bson.D{{"$subtract", bson.A{
+						"$timestamp",
+						bson.D{{"$mod", bson.A{bson.D{{"$toLong", "$timestamp"}}, bucketMs}}},
+					}}}},
+					{"currency", "$price.currency"},
+				}},
+				{"amount", bson.D{{"$" + string(params.Downsampling), "$price.amount"}}},
+			}},
+		}
+		projectStage := bson.D{
+			{"$project", bson.D{
+				{"_id", 0},
+				{"timestamp", "$_id.bucket"},
+				{"price", bson.D{
+					{"amount", "$amount"},
+					{"currency", "$_id.currency"},
+				}},
+			}},
+		}
+
+		pipeline = append(pipeline, groupStage, projectStage)
+		pipeline = addSortAggregationStage(pipeline, common.SortOptions{
+			{FieldName: "timestamp", Order: common.AscOrder},
+			{FieldName: "price.currency", Order: common.AscOrder},
+		})
+	}
+
+	cursor, err := s.mdbCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	err = cursorIterateAndDecode(ctx, cursor, func(curCursor *mongo.Cursor) error {
+		var point model.PriceHistoryPoint
+		if err := curCursor.Decode(&point); err != nil {
+			return err
+		}
+		retObjs = append(retObjs, point)
+
+		return nil
+	})
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	return
+}
+
+// newProductPricesPipeline builds the product prices series aggregation pipeline ({timestamp, price} documents in the chronological order).
+// Price imports are matched and sorted using the {product_id, timestamp} index.
+// Legacy integer prices are converted to decimals (those have no currency), $unwind keeps the prices order.
+// nolint:govet
+func newProductPricesPipeline(productID primitive.ObjectID, from, to time.Time) mongo.Pipeline {
+	importsMatch := bson.M{"product_id": productID}
+	if timestampRange := newRangeCondition(from, to); len(timestampRange) > 0 {
+		importsMatch["timestamp"] = timestampRange
+	}
+
+	pipeline := mongo.Pipeline{}
+	pipeline = addMatchAggregationStage(pipeline, importsMatch)
+	pipeline = addSortAggregationStage(pipeline, common.SortOptions{{FieldName: "timestamp", Order: common.AscOrder}})
+	pipeline = append(pipeline,
+		bson.D{{"$unwind", "$prices"}},
+		bson.D{
+			{"$project", bson.D{
+				{"_id", 0},
+				{"timestamp", 1},
+				{"price", bson.D{
+					{"amount", bson.D{{"$toDecimal", "$prices.value"}}},
+					{"currency", "$prices.currency"},
+				}},
+			}},
+		},
+	)
+
+	return pipeline
+}
+
+// newPriceEntriesPipeline builds price entries aggregation pipeline (without sort and pagination stages):
+// price imports are matched, joined with products, unwound to price entries and matched again.
+// nolint:govet
+func (s priceImportStorage) newPriceEntriesPipeline(importsMatch bson.M, filter model.PriceEntriesFilter) mongo.Pipeline {
+	productsMatch := newProductsMatch(filter)
+	pricesMatch := newPricesMatch(filter)
 
 	// define required stages
 	lookupStage := bson.D{
@@ -125,46 +1026,135 @@ func (s priceImportStorage) GetPriceEntries(
 		}},
 	}
 	unwindStage := bson.D{
-		{"$unwind", "$prices"},
+		{"$unwind", bson.D{
+			{"path", "$prices"},
+			{"includeArrayIndex", priceEntryIdxField},
+		}},
 	}
+	// legacy integer prices are converted to decimals (those have no currency)
 	addFieldsStage := bson.D{
 		{"$addFields", bson.D{
-			{"price", "$prices.value"},
+			{"price", bson.D{
+				{"amount", bson.D{{"$toDecimal", "$prices.value"}}},
+				{"currency", "$prices.currency"},
+			}},
 		}},
 	}
 	projectStage := bson.D{
 		{"$project", bson.D{
 			{"fromProducts", 0},
-			{"_id", 0},
 			{"product_id", 0},
 			{"prices", 0},
 		}},
 	}
 
 	// build pipeline
-	pipeline := mongo.Pipeline{lookupStage, replaceRootStage, unwindStage, addFieldsStage, projectStage}
-	pipeline = addSortAggregationStage(pipeline, sortOptions)
-	pipeline = addPaginationAggregationStage(pipeline, paginationOption)
+	pipeline := mongo.Pipeline{}
+	pipeline = addMatchAggregationStage(pipeline, importsMatch)
+	pipeline = append(pipeline, lookupStage, replaceRootStage)
+	pipeline = addMatchAggregationStage(pipeline, productsMatch)
+	pipeline = append(pipeline, unwindStage, addFieldsStage)
+	pipeline = addMatchAggregationStage(pipeline, pricesMatch)
+	pipeline = append(pipeline, projectStage)
 
-	cursor, err := s.mdbCollection.Aggregate(ctx, pipeline)
-	if err != nil {
-		retErr = err
-		return
-	}
+	return pipeline
+}
 
-	err = cursorIterateAndDecode(ctx, cursor, func(curCursor *mongo.Cursor) error {
-		var priceEntry model.PriceEntry
-		if err := curCursor.Decode(&priceEntry); err != nil {
-			return err
+// newPriceImportsMatch builds "price_imports" documents filter.
+// Exact product name is resolved to the product ID (found is false if there is no such product).
+// Price imports containing at least one price within the range are matched (prices are filtered after $unwind).
+func (s priceImportStorage) newPriceImportsMatch(ctx context.Context, filter model.PriceEntriesFilter) (retMatch bson.M, retFound bool, retErr error) {
+	retMatch, retFound = bson.M{}, true
+
+	if filter.ProductName != "" {
+		var product model.Product
+		res := s.mdbCollection.Database().Collection(s.productsCollection).FindOne(ctx, bson.M{"name": filter.ProductName})
+		if err := singleResultDecode(res, &product); err != nil {
+			if errors.Is(err, common.ErrNotFound) {
+				retFound = false
+				return
+			}
+			retErr = fmt.Errorf("product lookup: %w", err)
+			return
 		}
-		retObjs = append(retObjs, priceEntry)
+		retMatch["product_id"] = product.ID
+	}
 
-		return nil
-	})
-	if err != nil {
-		retErr = err
-		return
+	if timestampRange := newRangeCondition(filter.TimestampFrom, filter.TimestampTo); len(timestampRange) > 0 {
+		retMatch["timestamp"] = timestampRange
+	}
+
+	if priceCondition := newPriceCondition(filter, "value"); len(priceCondition) > 0 {
+		retMatch["prices"] = bson.M{"$elemMatch": priceCondition}
 	}
 
 	return
 }
+
+// newProductsMatch builds merged Product documents filter (product name prefix and regex).
+func newProductsMatch(filter model.PriceEntriesFilter) bson.M {
+	nameConditions := bson.A{}
+	if filter.ProductNamePrefix != "" {
+		nameConditions = append(nameConditions, bson.M{"name": primitive.Regex{Pattern: "^" + regexp.QuoteMeta(filter.ProductNamePrefix)}})
+	}
+	if filter.ProductNameRegex != "" {
+		nameConditions = append(nameConditions, bson.M{"name": primitive.Regex{Pattern: filter.ProductNameRegex}})
+	}
+
+	if len(nameConditions) == 0 {
+		return bson.M{}
+	}
+
+	return bson.M{"$and": nameConditions}
+}
+
+// newPricesMatch builds unwound price entries filter (currency and price range).
+func newPricesMatch(filter model.PriceEntriesFilter) bson.M {
+	match := bson.M{}
+	for field, condition := range newPriceCondition(filter, "amount") {
+		match["price."+field] = condition
+	}
+
+	return match
+}
+
+// newPriceCondition builds a single price condition: currency and [min, max] amount range (empty if not set).
+// Both are applied to the same price, so the range is matched within the currency only.
+func newPriceCondition(filter model.PriceEntriesFilter, amountField string) bson.M {
+	condition := bson.M{}
+	if filter.Currency != "" {
+		condition["currency"] = filter.Currency
+	}
+	if priceRange := newPriceRangeCondition(filter); len(priceRange) > 0 {
+		condition[amountField] = priceRange
+	}
+
+	return condition
+}
+
+// newPriceRangeCondition builds [min, max] price amount condition (empty if not set).
+// MongoDB compares numbers of different types, so legacy integer prices are matched as well.
+func newPriceRangeCondition(filter model.PriceEntriesFilter) bson.M {
+	condition := bson.M{}
+	if filter.PriceMin != nil {
+		condition["$gte"] = filter.PriceMin.Amount
+	}
+	if filter.PriceMax != nil {
+		condition["$lte"] = filter.PriceMax.Amount
+	}
+
+	return condition
+}
+
+// newRangeCondition builds [from, to) DateTime condition (empty if not set).
+func newRangeCondition(from, to time.Time) bson.M {
+	condition := bson.M{}
+	if !from.IsZero() {
+		condition["$gte"] = from
+	}
+	if !to.IsZero() {
+		condition["$lt"] = to
+	}
+
+	return condition
+}
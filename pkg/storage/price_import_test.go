@@ -2,10 +2,14 @@ package storage
 
 import (
 	"context"
+	"errors"
 	"sort"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	"github.com/itiky/mdb-tutorial/pkg/common"
@@ -65,7 +69,7 @@ func (s *StorageTestSuite) TestStorage_PriceImportBasic() {
 	}
 
 	// check UpsertByProductIDAndTimestamp: 1st one (update)
-	priceImport1.Prices = append(priceImport1.Prices, model.Price{Value: 1000})
+	priceImport1.Prices = append(priceImport1.Prices, model.NewPrice(model.MustParseMoney("1000.01", "USD")))
 	{
 		id, err := targetSt.UpsertByProductIDAndTimestamp(ctx, priceImport1)
 		require.NoError(t, err)
@@ -103,6 +107,143 @@ func (s *StorageTestSuite) TestStorage_PriceImportBasic() {
 		require.Len(t, resp, 1)
 		require.EqualValues(t, priceImport2, resp[0])
 	}
+
+	// check BulkUpsertByProductIDAndTimestamp: invalid input
+	{
+		_, err := targetSt.BulkUpsertByProductIDAndTimestamp(ctx, nil)
+		require.Error(t, err)
+
+		invalidImport := priceImport1
+		invalidImport.ProductID = primitive.ObjectID{}
+		_, err = targetSt.BulkUpsertByProductIDAndTimestamp(ctx, []model.PricesImport{priceImport2, invalidImport})
+		require.Error(t, err)
+	}
+
+	// check BulkUpsertByProductIDAndTimestamp: update existing and create a new one
+	priceImport3 := fixtures.PriceImports[1]
+	priceImport3.Timestamp = priceImport3.Timestamp.Add(time.Hour)
+	{
+		priceImport1.Prices = priceImport1.Prices[:1]
+		created, err := targetSt.BulkUpsertByProductIDAndTimestamp(ctx, []model.PricesImport{priceImport1, priceImport3})
+		require.NoError(t, err)
+		require.EqualValues(t, 1, created)
+
+		resp, err := targetSt.GetAll(ctx, time.Time{}, "")
+		require.NoError(t, err)
+		require.Len(t, resp, 3)
+
+		resp, err = targetSt.GetAll(ctx, priceImport1.Timestamp, priceImport1.ProductID.Hex())
+		require.NoError(t, err)
+		require.Len(t, resp, 1)
+		require.EqualValues(t, priceImport1, resp[0])
+	}
+
+	// check BulkAppendByProductIDAndTimestamp: invalid input
+	{
+		_, err := targetSt.BulkAppendByProductIDAndTimestamp(ctx, nil)
+		require.Error(t, err)
+
+		invalidImport := priceImport1
+		invalidImport.Prices = nil
+		_, err = targetSt.BulkAppendByProductIDAndTimestamp(ctx, []model.PricesImport{invalidImport})
+		require.Error(t, err)
+	}
+
+	// check BulkAppendByProductIDAndTimestamp: append to existing and create a new one (concurrent chunks of one import)
+	{
+		collection := client.Database(testutils.TestMongoDBDatabase).Collection(PriceImportsCollection)
+		require.NoError(t, createIndex(ctx, collection, PriceImportsProductIDTSIndex, bson.D{{"product_id", 1}, {"timestamp", 1}}, true)) // nolint:govet
+
+		appendImport1 := priceImport1
+		appendImport1.Prices = []model.Price{model.NewPrice(model.MustParseMoney("100", "USD"))}
+		priceImport4 := priceImport3
+		priceImport4.Timestamp = priceImport4.Timestamp.Add(time.Hour)
+
+		wg := sync.WaitGroup{}
+		createdCnts, errs := make([]int64, 2), make([]error, 2)
+		for i := 0; i < 2; i++ {
+			wg.Add(1)
+			go func(idx int) {
+				defer wg.Done()
+				createdCnts[idx], errs[idx] = targetSt.BulkAppendByProductIDAndTimestamp(ctx, []model.PricesImport{appendImport1, priceImport4})
+			}(i)
+		}
+		wg.Wait()
+		require.NoError(t, errs[0])
+		require.NoError(t, errs[1])
+		require.EqualValues(t, 1, createdCnts[0]+createdCnts[1])
+
+		resp, err := targetSt.GetAll(ctx, priceImport1.Timestamp, priceImport1.ProductID.Hex())
+		require.NoError(t, err)
+		require.Len(t, resp, 1)
+		require.Len(t, resp[0].Prices, len(priceImport1.Prices)+2)
+		require.EqualValues(t, priceImport1.Prices, resp[0].Prices[:len(priceImport1.Prices)])
+
+		resp, err = targetSt.GetAll(ctx, priceImport4.Timestamp, priceImport4.ProductID.Hex())
+		require.NoError(t, err)
+		require.Len(t, resp, 1)
+		require.Len(t, resp[0].Prices, 2*len(priceImport4.Prices))
+	}
+
+	// check BulkAppendByProductIDAndTimestamp: prices with rows are kept sorted by the CSV-file row (whatever the chunks write order)
+	{
+		rowsImport := priceImport1
+		rowsImport.Timestamp = rowsImport.Timestamp.Add(90*time.Minute + 7*time.Millisecond)
+
+		chunkRows := [][]int{{7, 9}, {3}, {5, 8}}
+		for _, rows := range chunkRows {
+			chunkImport := rowsImport
+			chunkImport.Prices = nil
+			for _, row := range rows {
+				price := model.NewPrice(model.MustParseMoney(strconv.Itoa(row), "USD"))
+				price.Row = row
+				chunkImport.Prices = append(chunkImport.Prices, price)
+			}
+
+			_, err := targetSt.BulkAppendByProductIDAndTimestamp(ctx, []model.PricesImport{chunkImport})
+			require.NoError(t, err)
+		}
+
+		resp, err := targetSt.GetAll(ctx, rowsImport.Timestamp, rowsImport.ProductID.Hex())
+		require.NoError(t, err)
+		require.Len(t, resp, 1)
+		require.Len(t, resp[0].Prices, 5)
+		for i, row := range []int{3, 5, 7, 8, 9} {
+			require.Equal(t, row, resp[0].Prices[i].Row)
+		}
+	}
+
+	// check GetImportTimestamps
+	{
+		_, err := targetSt.GetImportTimestamps(ctx, time.Time{}, time.Now())
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		imports, err := targetSt.GetAll(ctx, time.Time{}, "")
+		require.NoError(t, err)
+
+		expected := make([]time.Time, 0, len(imports))
+		for _, pricesImport := range imports {
+			found := false
+			for _, timestamp := range expected {
+				found = found || timestamp.Equal(pricesImport.Timestamp)
+			}
+			if !found {
+				expected = append(expected, pricesImport.Timestamp)
+			}
+		}
+		sort.Slice(expected, func(i, j int) bool { return expected[i].Before(expected[j]) })
+
+		timestamps, err := targetSt.GetImportTimestamps(ctx, expected[0], expected[len(expected)-1].Add(time.Millisecond))
+		require.NoError(t, err)
+		require.Len(t, timestamps, len(expected))
+		for i := range expected {
+			require.True(t, expected[i].Equal(timestamps[i]))
+		}
+
+		timestamps, err = targetSt.GetImportTimestamps(ctx, expected[0], expected[0].Add(time.Millisecond))
+		require.NoError(t, err)
+		require.Len(t, timestamps, 1)
+	}
 }
 
 func (s *StorageTestSuite) TestStorage_PriceImportAggregation() {
@@ -124,7 +265,7 @@ func (s *StorageTestSuite) TestStorage_PriceImportAggregation() {
 		for _, price := range priceImport.Prices {
 			expEntries = append(expEntries, model.PriceEntry{
 				Name:      product.Name,
-				Price:     price.Value,
+				Price:     price.Money(),
 				Timestamp: priceImport.Timestamp,
 			})
 		}
@@ -138,7 +279,7 @@ func (s *StorageTestSuite) TestStorage_PriceImportAggregation() {
 				if expEntry.Name != rcvEntry.Name {
 					continue
 				}
-				if expEntry.Price != rcvEntry.Price {
+				if expEntry.Price.Cmp(rcvEntry.Price) != 0 || expEntry.Price.Currency != rcvEntry.Price.Currency {
 					continue
 				}
 				if !expEntry.Timestamp.Equal(rcvEntry.Timestamp) {
@@ -160,14 +301,15 @@ func (s *StorageTestSuite) TestStorage_PriceImportAggregation() {
 		)
 		pageOpt := common.NewPaginationOption(0, 100)
 
-		rcvEntries, err := targetSt.GetPriceEntries(ctx, sortOpts, pageOpt)
+		rcvPage, err := targetSt.GetPriceEntries(ctx, model.PriceEntriesFilter{}, sortOpts, pageOpt, false)
 		require.NoError(t, err)
+		rcvEntries := rcvPage.Entries
 		require.NotEmpty(t, rcvEntries)
 		require.Equal(t, len(expEntries), countRcvEntries(rcvEntries))
 
 		// check sorting
 		sorted := sort.SliceIsSorted(rcvEntries, func(i, j int) bool {
-			return rcvEntries[i].Price < rcvEntries[j].Price
+			return rcvEntries[i].Price.Cmp(rcvEntries[j].Price) < 0
 		})
 		require.True(t, sorted)
 	}
@@ -179,8 +321,9 @@ func (s *StorageTestSuite) TestStorage_PriceImportAggregation() {
 		)
 		pageOpt := common.NewPaginationOption(0, 100)
 
-		rcvEntries, err := targetSt.GetPriceEntries(ctx, sortOpts, pageOpt)
+		rcvPage, err := targetSt.GetPriceEntries(ctx, model.PriceEntriesFilter{}, sortOpts, pageOpt, false)
 		require.NoError(t, err)
+		rcvEntries := rcvPage.Entries
 		require.NotEmpty(t, rcvEntries)
 		require.Equal(t, len(expEntries), countRcvEntries(rcvEntries))
 
@@ -198,8 +341,9 @@ func (s *StorageTestSuite) TestStorage_PriceImportAggregation() {
 		)
 		pageOpt := common.NewPaginationOption(0, 100)
 
-		rcvEntries, err := targetSt.GetPriceEntries(ctx, sortOpts, pageOpt)
+		rcvPage, err := targetSt.GetPriceEntries(ctx, model.PriceEntriesFilter{}, sortOpts, pageOpt, false)
 		require.NoError(t, err)
+		rcvEntries := rcvPage.Entries
 		require.NotEmpty(t, rcvEntries)
 		require.Equal(t, len(expEntries), countRcvEntries(rcvEntries))
 
@@ -218,15 +362,16 @@ func (s *StorageTestSuite) TestStorage_PriceImportAggregation() {
 		)
 		pageOpt := common.NewPaginationOption(0, 100)
 
-		rcvEntries, err := targetSt.GetPriceEntries(ctx, sortOpts, pageOpt)
+		rcvPage, err := targetSt.GetPriceEntries(ctx, model.PriceEntriesFilter{}, sortOpts, pageOpt, false)
 		require.NoError(t, err)
+		rcvEntries := rcvPage.Entries
 		require.NotEmpty(t, rcvEntries)
 		require.Equal(t, len(expEntries), countRcvEntries(rcvEntries))
 
 		// check sorting
 		sorted := sort.SliceIsSorted(rcvEntries, func(i, j int) bool {
 			tsLess := rcvEntries[j].Timestamp.Before(rcvEntries[i].Timestamp)
-			priceLess := rcvEntries[j].Price < rcvEntries[i].Price
+			priceLess := rcvEntries[j].Price.Cmp(rcvEntries[i].Price) < 0
 			return tsLess && priceLess
 		})
 		require.True(t, sorted)
@@ -236,8 +381,9 @@ func (s *StorageTestSuite) TestStorage_PriceImportAggregation() {
 	{
 		pageOpt := common.NewPaginationOption(0, 3)
 
-		rcvEntries, err := targetSt.GetPriceEntries(ctx, nil, pageOpt)
+		rcvPage, err := targetSt.GetPriceEntries(ctx, model.PriceEntriesFilter{}, nil, pageOpt, false)
 		require.NoError(t, err)
+		rcvEntries := rcvPage.Entries
 		require.NotEmpty(t, rcvEntries)
 		require.Equal(t, 3, countRcvEntries(rcvEntries))
 	}
@@ -246,9 +392,752 @@ func (s *StorageTestSuite) TestStorage_PriceImportAggregation() {
 	{
 		pageOpt := common.NewPaginationOption(1, 100)
 
-		rcvEntries, err := targetSt.GetPriceEntries(ctx, nil, pageOpt)
+		rcvPage, err := targetSt.GetPriceEntries(ctx, model.PriceEntriesFilter{}, nil, pageOpt, false)
 		require.NoError(t, err)
+		rcvEntries := rcvPage.Entries
 		require.NotEmpty(t, rcvEntries)
 		require.Equal(t, len(expEntries)-1, countRcvEntries(rcvEntries))
 	}
+
+	// check GetPriceEntries: cursor pagination walks through the same entries in the same order as a single page
+	{
+		for _, sortOpts := range []common.SortOptions{
+			nil,
+			model.NewPriceEntriesSortOptions(model.PriceEntrySortByPrice(common.DescOrder)),
+			model.NewPriceEntriesSortOptions(
+				model.PriceEntrySortByImportTimestamp(common.AscOrder),
+				model.PriceEntrySortByProductName(common.DescOrder),
+			),
+		} {
+			expPage, err := targetSt.GetPriceEntries(ctx, model.PriceEntriesFilter{}, sortOpts, common.NewPaginationOption(0, 100), false)
+			require.NoError(t, err)
+			require.Empty(t, expPage.NextCursor)
+
+			rcvEntries, cursor := model.PriceEntries{}, ""
+			for pageIdx := 0; ; pageIdx++ {
+				require.Less(t, pageIdx, len(expEntries))
+
+				rcvPage, err := targetSt.GetPriceEntries(ctx, model.PriceEntriesFilter{}, sortOpts, common.NewCursorPaginationOption(cursor, 2), false)
+				require.NoError(t, err)
+				rcvEntries = append(rcvEntries, rcvPage.Entries...)
+
+				if rcvPage.NextCursor == "" {
+					break
+				}
+				require.Len(t, rcvPage.Entries, 2)
+				cursor = rcvPage.NextCursor
+			}
+
+			require.Equal(t, len(expEntries), countRcvEntries(rcvEntries))
+			require.Len(t, rcvEntries, len(expPage.Entries))
+			for i := range rcvEntries {
+				require.Equal(t, expPage.Entries[i].Name, rcvEntries[i].Name)
+				require.Equal(t, 0, expPage.Entries[i].Price.Cmp(rcvEntries[i].Price))
+				require.True(t, expPage.Entries[i].Timestamp.Equal(rcvEntries[i].Timestamp))
+			}
+		}
+	}
+
+	// check GetPriceEntries: invalid cursor
+	{
+		sortOpts := model.NewPriceEntriesSortOptions(model.PriceEntrySortByPrice(common.DescOrder))
+
+		rcvPage, err := targetSt.GetPriceEntries(ctx, model.PriceEntriesFilter{}, sortOpts, common.NewCursorPaginationOption("", 1), false)
+		require.NoError(t, err)
+		require.NotEmpty(t, rcvPage.NextCursor)
+
+		_, err = targetSt.GetPriceEntries(ctx, model.PriceEntriesFilter{}, nil, common.NewCursorPaginationOption(rcvPage.NextCursor, 1), false)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSt.GetPriceEntries(ctx, model.PriceEntriesFilter{}, sortOpts, common.NewCursorPaginationOption("not a cursor", 1), false)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check GetPriceEntries: total count and has more flag
+	{
+		rcvPage, err := targetSt.GetPriceEntries(ctx, model.PriceEntriesFilter{}, nil, common.NewPaginationOption(0, 2), false)
+		require.NoError(t, err)
+		require.Len(t, rcvPage.Entries, 2)
+		require.True(t, rcvPage.HasMore)
+		require.EqualValues(t, -1, rcvPage.TotalCount)
+
+		rcvPage, err = targetSt.GetPriceEntries(ctx, model.PriceEntriesFilter{}, nil, common.NewPaginationOption(0, 2), true)
+		require.NoError(t, err)
+		require.Len(t, rcvPage.Entries, 2)
+		require.True(t, rcvPage.HasMore)
+		require.EqualValues(t, len(expEntries), rcvPage.TotalCount)
+
+		// total count is not affected by the cursor position
+		rcvPage, err = targetSt.GetPriceEntries(ctx, model.PriceEntriesFilter{}, nil, common.NewCursorPaginationOption(rcvPage.NextCursor, 100), true)
+		require.NoError(t, err)
+		require.Len(t, rcvPage.Entries, len(expEntries)-2)
+		require.False(t, rcvPage.HasMore)
+		require.Empty(t, rcvPage.NextCursor)
+		require.EqualValues(t, len(expEntries), rcvPage.TotalCount)
+
+		rcvPage, err = targetSt.GetPriceEntries(ctx, model.PriceEntriesFilter{}, nil, common.NewPaginationOption(0, len(expEntries)), true)
+		require.NoError(t, err)
+		require.False(t, rcvPage.HasMore)
+		require.Empty(t, rcvPage.NextCursor)
+	}
+
+	// check GetPriceEntries: filters
+	{
+		newPrice := func(amount string) *model.Money {
+			price := model.MustParseMoney(amount, "USD")
+			return &price
+		}
+		midTimestamp := time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC)
+		pageOpt := common.NewPaginationOption(0, 100)
+
+		testCases := []struct {
+			name     string
+			filter   model.PriceEntriesFilter
+			expCount int
+		}{
+			{name: "exact name", filter: model.PriceEntriesFilter{ProductName: "Product A"}, expCount: 2},
+			{name: "exact name: non-existing", filter: model.PriceEntriesFilter{ProductName: "Product"}, expCount: 0},
+			{name: "name prefix", filter: model.PriceEntriesFilter{ProductNamePrefix: "Product"}, expCount: 5},
+			{name: "name prefix: special chars", filter: model.PriceEntriesFilter{ProductNamePrefix: "Product."}, expCount: 0},
+			{name: "name regex", filter: model.PriceEntriesFilter{ProductNameRegex: "B$"}, expCount: 3},
+			{name: "price range", filter: model.PriceEntriesFilter{Currency: "USD", PriceMin: newPrice("75.5"), PriceMax: newPrice("100")}, expCount: 3},
+			{name: "price range: other currency", filter: model.PriceEntriesFilter{Currency: "EUR", PriceMin: newPrice("75.5"), PriceMax: newPrice("100")}, expCount: 0},
+			{name: "currency", filter: model.PriceEntriesFilter{Currency: "USD"}, expCount: 5},
+			{name: "timestamp from", filter: model.PriceEntriesFilter{TimestampFrom: midTimestamp}, expCount: 3},
+			{name: "timestamp to", filter: model.PriceEntriesFilter{TimestampTo: midTimestamp}, expCount: 2},
+			{
+				name: "combined",
+				filter: model.PriceEntriesFilter{
+					ProductNamePrefix: "Product",
+					ProductNameRegex:  "[AB]",
+					Currency:          "USD",
+					PriceMin:          newPrice("100"),
+					TimestampFrom:     midTimestamp,
+				},
+				expCount: 2,
+			},
+		}
+
+		for _, tc := range testCases {
+			rcvPage, err := targetSt.GetPriceEntries(ctx, tc.filter, nil, pageOpt, true)
+			require.NoError(t, err, tc.name)
+			require.EqualValues(t, tc.expCount, rcvPage.TotalCount, tc.name)
+			rcvEntries := rcvPage.Entries
+			require.Len(t, rcvEntries, tc.expCount, tc.name)
+			require.Equal(t, tc.expCount, countRcvEntries(rcvEntries), tc.name)
+		}
+	}
+
+	// check GetPriceEntries: invalid filters
+	{
+		minPrice, maxPrice := model.MustParseMoney("100", "USD"), model.MustParseMoney("50", "USD")
+		pageOpt := common.NewPaginationOption(0, 100)
+
+		_, err := targetSt.GetPriceEntries(ctx, model.PriceEntriesFilter{Currency: "USD", PriceMin: &minPrice, PriceMax: &maxPrice}, nil, pageOpt, false)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		// price range without currency
+		_, err = targetSt.GetPriceEntries(ctx, model.PriceEntriesFilter{PriceMin: &maxPrice}, nil, pageOpt, false)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSt.GetPriceEntries(ctx, model.PriceEntriesFilter{Currency: "usd"}, nil, pageOpt, false)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSt.GetPriceEntries(ctx, model.PriceEntriesFilter{ProductNameRegex: "("}, nil, pageOpt, false)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		timestamp := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		_, err = targetSt.GetPriceEntries(ctx, model.PriceEntriesFilter{TimestampFrom: timestamp, TimestampTo: timestamp}, nil, pageOpt, false)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check StreamPriceEntries: all entries (not sorted)
+	{
+		rcvEntries := model.PriceEntries{}
+		err := targetSt.StreamPriceEntries(ctx, model.PriceEntriesFilter{}, nil, 0, func(entry model.PriceEntry) error {
+			rcvEntries = append(rcvEntries, entry)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, rcvEntries, len(expEntries))
+		require.Equal(t, len(expEntries), countRcvEntries(rcvEntries))
+	}
+
+	// check StreamPriceEntries: the same entries in the same order as GetPriceEntries, filter and limit are applied
+	{
+		filter := model.PriceEntriesFilter{ProductNamePrefix: "Product"}
+		sortOpts := model.NewPriceEntriesSortOptions(
+			model.PriceEntrySortByImportTimestamp(common.DescOrder),
+			model.PriceEntrySortByPrice(common.DescOrder),
+		)
+
+		expPage, err := targetSt.GetPriceEntries(ctx, filter, sortOpts, common.NewPaginationOption(0, 3), false)
+		require.NoError(t, err)
+
+		rcvEntries := model.PriceEntries{}
+		err = targetSt.StreamPriceEntries(ctx, filter, sortOpts, 3, func(entry model.PriceEntry) error {
+			rcvEntries = append(rcvEntries, entry)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, rcvEntries, 3)
+		for i := range rcvEntries {
+			require.Equal(t, expPage.Entries[i].Name, rcvEntries[i].Name, "entry [%d]", i)
+			require.True(t, expPage.Entries[i].Timestamp.Equal(rcvEntries[i].Timestamp), "entry [%d]", i)
+			require.Zero(t, expPage.Entries[i].Price.Cmp(rcvEntries[i].Price), "entry [%d]", i)
+		}
+	}
+
+	// check StreamPriceEntries: iteration is stopped on the handler error
+	{
+		handlerErr, handledCnt := errors.New("handler error"), 0
+		err := targetSt.StreamPriceEntries(ctx, model.PriceEntriesFilter{}, nil, 0, func(entry model.PriceEntry) error {
+			handledCnt++
+			return handlerErr
+		})
+		require.True(t, errors.Is(err, handlerErr))
+		require.Equal(t, 1, handledCnt)
+	}
+
+	// check StreamPriceEntries: invalid input
+	{
+		handler := func(entry model.PriceEntry) error { return nil }
+
+		err := targetSt.StreamPriceEntries(ctx, model.PriceEntriesFilter{ProductNameRegex: "("}, nil, 0, handler)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		err = targetSt.StreamPriceEntries(ctx, model.PriceEntriesFilter{}, nil, -1, handler)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		err = targetSt.StreamPriceEntries(ctx, model.PriceEntriesFilter{}, nil, 0, nil)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+}
+
+func (s *StorageTestSuite) TestStorage_PriceImportLatestPrices() {
+	t := s.T()
+	ctx := context.Background()
+
+	client := testutils.PrepareMongoDBFixtures(ctx, s.r, fixtures.NewEmptyMongoDBFixtures())
+	storage, err := NewStorage(
+		WithDatabase(testutils.TestMongoDBDatabase),
+		WithMongoDBClient(client),
+	)
+	require.NoError(t, err)
+	targetSt := storage.PriceImport()
+
+	timestamp1 := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	timestamp2 := timestamp1.Add(time.Hour)
+
+	// P1 has two imports, P2 has one, P3 has none
+	productIDs, err := storage.Product().BulkUpsertByNames(ctx, []string{"P1", "P2", "P3"})
+	require.NoError(t, err)
+
+	_, err = targetSt.BulkUpsertByProductIDAndTimestamp(ctx, []model.PricesImport{
+		{ProductID: productIDs["P1"], Timestamp: timestamp1, Prices: []model.Price{model.NewPrice(model.MustParseMoney("1", "USD"))}},
+		{ProductID: productIDs["P1"], Timestamp: timestamp2, Prices: []model.Price{
+			model.NewPrice(model.MustParseMoney("2.50", "USD")),
+			model.NewPrice(model.MustParseMoney("3", "EUR")),
+		}},
+		{ProductID: productIDs["P2"], Timestamp: timestamp1, Prices: []model.Price{model.NewPrice(model.MustParseMoney("4", "USD"))}},
+	})
+	require.NoError(t, err)
+
+	// check GetLatestPrices: all products
+	{
+		entries, err := targetSt.GetLatestPrices(ctx, model.LatestPricesFilter{}, common.NewPaginationOption(0, 10))
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+
+		require.Equal(t, "P1", entries[0].Name)
+		require.True(t, timestamp2.Equal(entries[0].Timestamp))
+		require.Len(t, entries[0].Prices, 2)
+		require.Zero(t, entries[0].Prices[0].Cmp(model.MustParseMoney("2.5", "USD")))
+		require.Equal(t, "USD", entries[0].Prices[0].Currency)
+		require.Equal(t, "EUR", entries[0].Prices[1].Currency)
+
+		require.Equal(t, "P2", entries[1].Name)
+		require.True(t, timestamp1.Equal(entries[1].Timestamp))
+		require.Len(t, entries[1].Prices, 1)
+	}
+
+	// check GetLatestPrices: pagination
+	{
+		entries, err := targetSt.GetLatestPrices(ctx, model.LatestPricesFilter{}, common.NewPaginationOption(1, 10))
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, "P2", entries[0].Name)
+
+		entries, err = targetSt.GetLatestPrices(ctx, model.LatestPricesFilter{}, common.NewPaginationOption(0, 1))
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, "P1", entries[0].Name)
+
+		entries, err = targetSt.GetLatestPrices(ctx, model.LatestPricesFilter{}, common.NewPaginationOption(2, 1))
+		require.NoError(t, err)
+		require.Empty(t, entries)
+
+		// products without price imports are skipped before pagination: P1A doesn't shorten pages
+		_, err = storage.Product().BulkUpsertByNames(ctx, []string{"P1A"})
+		require.NoError(t, err)
+
+		entries, err = targetSt.GetLatestPrices(ctx, model.LatestPricesFilter{}, common.NewPaginationOption(1, 1))
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, "P2", entries[0].Name)
+	}
+
+	// check GetLatestPrices: product names filter
+	{
+		entries, err := targetSt.GetLatestPrices(ctx, model.LatestPricesFilter{ProductNames: []string{"P2", "P3", "P4"}}, common.NewPaginationOption(0, 10))
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, "P2", entries[0].Name)
+	}
+
+	// check GetLatestPrices: as of DateTime
+	{
+		entries, err := targetSt.GetLatestPrices(ctx, model.LatestPricesFilter{AsOf: timestamp2.Add(-time.Second)}, common.NewPaginationOption(0, 10))
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		require.Equal(t, "P1", entries[0].Name)
+		require.True(t, timestamp1.Equal(entries[0].Timestamp))
+		require.Len(t, entries[0].Prices, 1)
+		require.Zero(t, entries[0].Prices[0].Cmp(model.MustParseMoney("1", "USD")))
+
+		entries, err = targetSt.GetLatestPrices(ctx, model.LatestPricesFilter{AsOf: timestamp2}, common.NewPaginationOption(0, 10))
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		require.True(t, timestamp2.Equal(entries[0].Timestamp))
+
+		entries, err = targetSt.GetLatestPrices(ctx, model.LatestPricesFilter{AsOf: timestamp1.Add(-time.Second)}, common.NewPaginationOption(0, 10))
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	}
+
+	// check GetLatestPrices: invalid filter
+	{
+		_, err := targetSt.GetLatestPrices(ctx, model.LatestPricesFilter{ProductNames: []string{""}}, common.NewPaginationOption(0, 10))
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check StreamTopMoversPrices
+	{
+		streamPrices := func(filter model.TopMoversPricesFilter) map[string]model.TopMoversPrices {
+			pricesMap := make(map[string]model.TopMoversPrices)
+			err := targetSt.StreamTopMoversPrices(ctx, filter, func(prices model.TopMoversPrices) error {
+				pricesMap[prices.Name] = prices
+				return nil
+			})
+			require.NoError(t, err)
+
+			return pricesMap
+		}
+
+		// exact timestamps: products of both imports only
+		pricesMap := streamPrices(model.TopMoversPricesFilter{ImportFrom: []time.Time{timestamp1}, ImportTo: []time.Time{timestamp2}})
+		require.Len(t, pricesMap, 1)
+		require.Len(t, pricesMap["P1"].FromPrices, 1)
+		require.Zero(t, pricesMap["P1"].FromPrices[0].Cmp(model.MustParseMoney("1", "USD")))
+		require.Len(t, pricesMap["P1"].ToPrices, 2)
+		require.Zero(t, pricesMap["P1"].ToPrices[0].Cmp(model.MustParseMoney("2.5", "USD")))
+		require.Equal(t, "EUR", pricesMap["P1"].ToPrices[1].Currency)
+
+		require.Empty(t, streamPrices(model.TopMoversPricesFilter{ImportFrom: []time.Time{timestamp1.Add(time.Millisecond)}, ImportTo: []time.Time{timestamp2}}))
+
+		// as of timestamps: the latest price import per product
+		pricesMap = streamPrices(model.TopMoversPricesFilter{AsOfFrom: timestamp1.Add(time.Second), AsOfTo: timestamp2.Add(time.Second)})
+		require.Len(t, pricesMap, 2)
+		require.Len(t, pricesMap["P1"].ToPrices, 2)
+		require.Len(t, pricesMap["P2"].FromPrices, 1)
+		require.Zero(t, pricesMap["P2"].ToPrices[0].Cmp(model.MustParseMoney("4", "USD")))
+
+		require.Empty(t, streamPrices(model.TopMoversPricesFilter{AsOfFrom: timestamp1.Add(-time.Second), AsOfTo: timestamp2}))
+
+		// invalid input
+		err := targetSt.StreamTopMoversPrices(ctx, model.TopMoversPricesFilter{AsOfTo: timestamp2}, func(prices model.TopMoversPrices) error { return nil })
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		err = targetSt.StreamTopMoversPrices(ctx, model.TopMoversPricesFilter{ImportFrom: []time.Time{timestamp1}}, func(prices model.TopMoversPrices) error { return nil })
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		err = targetSt.StreamTopMoversPrices(ctx, model.TopMoversPricesFilter{AsOfFrom: timestamp1, AsOfTo: timestamp2}, nil)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check StreamTopMoversPrices: exact timestamps groups (e.g. zip archive entries of a single job)
+	timestamp2Entry2 := timestamp2.Add(time.Millisecond)
+	{
+		_, err := targetSt.BulkUpsertByProductIDAndTimestamp(ctx, []model.PricesImport{
+			{ProductID: productIDs["P1"], Timestamp: timestamp2Entry2, Prices: []model.Price{model.NewPrice(model.MustParseMoney("5", "USD"))}},
+			{ProductID: productIDs["P2"], Timestamp: timestamp2Entry2, Prices: []model.Price{model.NewPrice(model.MustParseMoney("6", "USD"))}},
+		})
+		require.NoError(t, err)
+
+		pricesMap := make(map[string]model.TopMoversPrices)
+		filter := model.TopMoversPricesFilter{ImportFrom: []time.Time{timestamp1}, ImportTo: []time.Time{timestamp2Entry2, timestamp2}}
+		err = targetSt.StreamTopMoversPrices(ctx, filter, func(prices model.TopMoversPrices) error {
+			pricesMap[prices.Name] = prices
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, pricesMap, 2)
+
+		// group prices are concatenated in the timestamps order
+		require.Len(t, pricesMap["P1"].ToPrices, 3)
+		require.Zero(t, pricesMap["P1"].ToPrices[0].Cmp(model.MustParseMoney("2.5", "USD")))
+		require.Zero(t, pricesMap["P1"].ToPrices[2].Cmp(model.MustParseMoney("5", "USD")))
+		require.Len(t, pricesMap["P2"].ToPrices, 1)
+		require.Zero(t, pricesMap["P2"].ToPrices[0].Cmp(model.MustParseMoney("6", "USD")))
+
+		// intersecting groups
+		filter.ImportFrom = append(filter.ImportFrom, timestamp2)
+		err = targetSt.StreamTopMoversPrices(ctx, filter, func(prices model.TopMoversPrices) error { return nil })
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check GetLastImportTimestamps
+	{
+		timestamps, err := targetSt.GetLastImportTimestamps(ctx, 5, nil)
+		require.NoError(t, err)
+		require.Len(t, timestamps, 3)
+		require.True(t, timestamp2Entry2.Equal(timestamps[0]))
+		require.True(t, timestamp2.Equal(timestamps[1]))
+		require.True(t, timestamp1.Equal(timestamps[2]))
+
+		timestamps, err = targetSt.GetLastImportTimestamps(ctx, 1, nil)
+		require.NoError(t, err)
+		require.Len(t, timestamps, 1)
+		require.True(t, timestamp2Entry2.Equal(timestamps[0]))
+
+		// excluded timestamps
+		timestamps, err = targetSt.GetLastImportTimestamps(ctx, 5, []time.Time{timestamp2Entry2, timestamp2})
+		require.NoError(t, err)
+		require.Len(t, timestamps, 1)
+		require.True(t, timestamp1.Equal(timestamps[0]))
+
+		_, err = targetSt.GetLastImportTimestamps(ctx, 0, nil)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+}
+
+func (s *StorageTestSuite) TestStorage_PriceImportProductHistory() {
+	t := s.T()
+	ctx := context.Background()
+
+	client := testutils.PrepareMongoDBFixtures(ctx, s.r, fixtures.NewEmptyMongoDBFixtures())
+	storage, err := NewStorage(
+		WithDatabase(testutils.TestMongoDBDatabase),
+		WithMongoDBClient(client),
+	)
+	require.NoError(t, err)
+	targetSt := storage.PriceImport()
+
+	timestamp1 := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	timestamp2 := timestamp1.Add(time.Hour)
+	timestamp3 := timestamp1.Add(25 * time.Hour)
+
+	productIDs, err := storage.Product().BulkUpsertByNames(ctx, []string{"P1", "P2"})
+	require.NoError(t, err)
+
+	newPrices := func(moneys ...model.Money) []model.Price {
+		prices := make([]model.Price, 0, len(moneys))
+		for _, m := range moneys {
+			prices = append(prices, model.NewPrice(m))
+		}
+		return prices
+	}
+	usd, eur := func(amount string) model.Money { return model.MustParseMoney(amount, "USD") }, func(amount string) model.Money { return model.MustParseMoney(amount, "EUR") }
+
+	// imports are inserted out of the chronological order
+	_, err = targetSt.BulkUpsertByProductIDAndTimestamp(ctx, []model.PricesImport{
+		{ProductID: productIDs["P1"], Timestamp: timestamp3, Prices: newPrices(usd("5"), eur("4"))},
+		{ProductID: productIDs["P1"], Timestamp: timestamp1, Prices: newPrices(usd("1"), usd("3"))},
+		{ProductID: productIDs["P1"], Timestamp: timestamp2, Prices: newPrices(usd("2"))},
+		{ProductID: productIDs["P2"], Timestamp: timestamp1, Prices: newPrices(usd("100"))},
+	})
+	require.NoError(t, err)
+
+	type expPoint struct {
+		timestamp time.Time
+		price     model.Money
+	}
+	checkPoints := func(name string, expPoints []expPoint, rcvPoints []model.PriceHistoryPoint) {
+		require.Len(t, rcvPoints, len(expPoints), name)
+		for i, exp := range expPoints {
+			require.True(t, exp.timestamp.Equal(rcvPoints[i].Timestamp), "%s: point [%d]: timestamp", name, i)
+			require.Zero(t, exp.price.Cmp(rcvPoints[i].Price), "%s: point [%d]: price", name, i)
+			require.Equal(t, exp.price.Currency, rcvPoints[i].Price.Currency, "%s: point [%d]: currency", name, i)
+		}
+	}
+
+	// check GetProductHistory
+	{
+		bucketStart2 := timestamp1.Add(24 * time.Hour)
+		testCases := []struct {
+			name      string
+			params    model.PriceHistoryParams
+			expPoints []expPoint
+		}{
+			{
+				name:   "all",
+				params: model.PriceHistoryParams{},
+				expPoints: []expPoint{
+					{timestamp1, usd("1")}, {timestamp1, usd("3")}, {timestamp2, usd("2")}, {timestamp3, usd("5")}, {timestamp3, eur("4")},
+				},
+			},
+			{
+				name:      "timestamp range",
+				params:    model.PriceHistoryParams{TimestampFrom: timestamp2, TimestampTo: timestamp3},
+				expPoints: []expPoint{{timestamp2, usd("2")}},
+			},
+			{
+				name:      "downsampling: first",
+				params:    model.PriceHistoryParams{BucketSize: 24 * time.Hour, Downsampling: model.PriceHistoryDownsamplingFirst},
+				expPoints: []expPoint{{timestamp1, usd("1")}, {bucketStart2, eur("4")}, {bucketStart2, usd("5")}},
+			},
+			{
+				name:      "downsampling: last",
+				params:    model.PriceHistoryParams{BucketSize: 24 * time.Hour, Downsampling: model.PriceHistoryDownsamplingLast},
+				expPoints: []expPoint{{timestamp1, usd("2")}, {bucketStart2, eur("4")}, {bucketStart2, usd("5")}},
+			},
+			{
+				name:      "downsampling: min",
+				params:    model.PriceHistoryParams{BucketSize: 24 * time.Hour, Downsampling: model.PriceHistoryDownsamplingMin},
+				expPoints: []expPoint{{timestamp1, usd("1")}, {bucketStart2, eur("4")}, {bucketStart2, usd("5")}},
+			},
+			{
+				name:      "downsampling: max",
+				params:    model.PriceHistoryParams{BucketSize: 24 * time.Hour, Downsampling: model.PriceHistoryDownsamplingMax},
+				expPoints: []expPoint{{timestamp1, usd("3")}, {bucketStart2, eur("4")}, {bucketStart2, usd("5")}},
+			},
+		}
+
+		for _, tc := range testCases {
+			rcvPoints, err := targetSt.GetProductHistory(ctx, productIDs["P1"], tc.params)
+			require.NoError(t, err, tc.name)
+			checkPoints(tc.name, tc.expPoints, rcvPoints)
+		}
+	}
+
+	// check GetProductHistory: invalid input
+	{
+		_, err := targetSt.GetProductHistory(ctx, primitive.NilObjectID, model.PriceHistoryParams{})
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSt.GetProductHistory(ctx, productIDs["P1"], model.PriceHistoryParams{BucketSize: time.Hour})
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSt.GetProductHistory(ctx, productIDs["P1"], model.PriceHistoryParams{BucketSize: time.Millisecond, Downsampling: model.PriceHistoryDownsamplingLast})
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSt.GetProductHistory(ctx, productIDs["P1"], model.PriceHistoryParams{Downsampling: model.PriceHistoryDownsamplingLast})
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+}
+
+func (s *StorageTestSuite) TestStorage_PriceImportStats() {
+	t := s.T()
+	ctx := context.Background()
+
+	client := testutils.PrepareMongoDBFixtures(ctx, s.r, fixtures.NewEmptyMongoDBFixtures())
+	storage, err := NewStorage(
+		WithDatabase(testutils.TestMongoDBDatabase),
+		WithMongoDBClient(client),
+	)
+	require.NoError(t, err)
+	targetSt := storage.PriceImport()
+
+	timestamp1 := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	timestamp2 := timestamp1.Add(time.Hour)
+
+	productIDs, err := storage.Product().BulkUpsertByNames(ctx, []string{"P1", "P2"})
+	require.NoError(t, err)
+
+	usd, eur := func(amount string) model.Price { return model.NewPrice(model.MustParseMoney(amount, "USD")) }, func(amount string) model.Price { return model.NewPrice(model.MustParseMoney(amount, "EUR")) }
+	_, err = targetSt.BulkUpsertByProductIDAndTimestamp(ctx, []model.PricesImport{
+		{ProductID: productIDs["P1"], Timestamp: timestamp1, Prices: []model.Price{usd("1"), usd("3")}},
+		{ProductID: productIDs["P1"], Timestamp: timestamp2, Prices: []model.Price{usd("2"), usd("6"), eur("4")}},
+		{ProductID: productIDs["P2"], Timestamp: timestamp1, Prices: []model.Price{usd("10")}},
+	})
+	require.NoError(t, err)
+
+	checkStats := func(stats model.PriceStats, name, currency, min, max, mean, median string, stdDev float64, pricesCnt, importsCnt int64) {
+		require.Equal(t, name, stats.Name)
+		require.Equal(t, currency, stats.Currency)
+		require.Equal(t, currency, stats.Min.Currency)
+		require.Zero(t, model.MustParseMoney(min, currency).Cmp(stats.Min), "%s: min", name)
+		require.Zero(t, model.MustParseMoney(max, currency).Cmp(stats.Max), "%s: max", name)
+		require.Zero(t, model.MustParseMoney(mean, currency).Cmp(stats.Mean), "%s: mean", name)
+		require.Zero(t, model.MustParseMoney(median, currency).Cmp(stats.Median), "%s: median", name)
+		require.InDelta(t, stdDev, stats.StdDev, 1e-9, "%s: stdDev", name)
+		require.Equal(t, pricesCnt, stats.PricesCount, "%s: pricesCount", name)
+		require.Equal(t, importsCnt, stats.ImportsCount, "%s: importsCount", name)
+	}
+
+	// check GetPriceStats: all products
+	{
+		stats, err := targetSt.GetPriceStats(ctx, model.PriceEntriesFilter{}, common.NewPaginationOption(0, 10))
+		require.NoError(t, err)
+		require.Len(t, stats, 3)
+
+		checkStats(stats[0], "P1", "EUR", "4", "4", "4", "4", 0, 1, 1)
+		checkStats(stats[1], "P1", "USD", "1", "6", "3", "2.5", 1.8708286933869707, 4, 2)
+		checkStats(stats[2], "P2", "USD", "10", "10", "10", "10", 0, 1, 1)
+	}
+
+	// check GetPriceStats: timestamp filter
+	{
+		stats, err := targetSt.GetPriceStats(ctx, model.PriceEntriesFilter{TimestampFrom: timestamp2}, common.NewPaginationOption(0, 10))
+		require.NoError(t, err)
+		require.Len(t, stats, 2)
+
+		checkStats(stats[1], "P1", "USD", "2", "6", "4", "4", 2, 2, 1)
+	}
+
+	// check GetPriceStats: name filters and pagination
+	{
+		stats, err := targetSt.GetPriceStats(ctx, model.PriceEntriesFilter{ProductName: "P2"}, common.NewPaginationOption(0, 10))
+		require.NoError(t, err)
+		require.Len(t, stats, 1)
+		require.Equal(t, "P2", stats[0].Name)
+
+		stats, err = targetSt.GetPriceStats(ctx, model.PriceEntriesFilter{ProductNameRegex: "1$"}, common.NewPaginationOption(1, 10))
+		require.NoError(t, err)
+		require.Len(t, stats, 1)
+		require.Equal(t, "USD", stats[0].Currency)
+
+		stats, err = targetSt.GetPriceStats(ctx, model.PriceEntriesFilter{ProductName: "P3"}, common.NewPaginationOption(0, 10))
+		require.NoError(t, err)
+		require.Empty(t, stats)
+	}
+
+	// check GetPriceStats: invalid filter
+	{
+		_, err := targetSt.GetPriceStats(ctx, model.PriceEntriesFilter{ProductNameRegex: "("}, common.NewPaginationOption(0, 10))
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+}
+
+func (s *StorageTestSuite) TestStorage_PriceImportCandles() {
+	t := s.T()
+	ctx := context.Background()
+
+	client := testutils.PrepareMongoDBFixtures(ctx, s.r, fixtures.NewEmptyMongoDBFixtures())
+	storage, err := NewStorage(
+		WithDatabase(testutils.TestMongoDBDatabase),
+		WithMongoDBClient(client),
+	)
+	require.NoError(t, err)
+	targetSt := storage.PriceImport()
+
+	// 2000-01-01 is Saturday, 2000-01-03 is Monday
+	timestamp1 := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	timestamp2 := timestamp1.Add(time.Hour)
+	timestamp3 := time.Date(2000, 1, 3, 10, 0, 0, 0, time.UTC)
+
+	productIDs, err := storage.Product().BulkUpsertByNames(ctx, []string{"P1", "P2"})
+	require.NoError(t, err)
+
+	usd, eur := func(amount string) model.Price { return model.NewPrice(model.MustParseMoney(amount, "USD")) }, func(amount string) model.Price { return model.NewPrice(model.MustParseMoney(amount, "EUR")) }
+	_, err = targetSt.BulkUpsertByProductIDAndTimestamp(ctx, []model.PricesImport{
+		{ProductID: productIDs["P1"], Timestamp: timestamp1, Prices: []model.Price{usd("1"), usd("3")}},
+		{ProductID: productIDs["P1"], Timestamp: timestamp2, Prices: []model.Price{usd("2"), eur("4")}},
+		{ProductID: productIDs["P1"], Timestamp: timestamp3, Prices: []model.Price{usd("5")}},
+		{ProductID: productIDs["P2"], Timestamp: timestamp1, Prices: []model.Price{usd("10")}},
+	})
+	require.NoError(t, err)
+
+	type expCandle struct {
+		start                  time.Time
+		currency               string
+		open, high, low, close string
+		count                  int64
+	}
+	checkCandles := func(name string, expCandles []expCandle, rcvCandles []model.Candle) {
+		require.Len(t, rcvCandles, len(expCandles), name)
+		for i, exp := range expCandles {
+			rcv := rcvCandles[i]
+			require.True(t, exp.start.Equal(rcv.Start), "%s [%d]: start: %s / %s", name, i, exp.start, rcv.Start)
+			require.Equal(t, exp.currency, rcv.Currency, "%s [%d]: currency", name, i)
+			require.Zero(t, model.MustParseMoney(exp.open, exp.currency).Cmp(rcv.Open), "%s [%d]: open", name, i)
+			require.Zero(t, model.MustParseMoney(exp.high, exp.currency).Cmp(rcv.High), "%s [%d]: high", name, i)
+			require.Zero(t, model.MustParseMoney(exp.low, exp.currency).Cmp(rcv.Low), "%s [%d]: low", name, i)
+			require.Zero(t, model.MustParseMoney(exp.close, exp.currency).Cmp(rcv.Close), "%s [%d]: close", name, i)
+			require.Equal(t, exp.count, rcv.Count, "%s [%d]: count", name, i)
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		params     model.CandlesParams
+		expCandles []expCandle
+	}{
+		{
+			name:   "hour",
+			params: model.CandlesParams{Interval: model.CandleIntervalHour},
+			expCandles: []expCandle{
+				{timestamp1, "USD", "1", "3", "1", "3", 2},
+				{timestamp2, "EUR", "4", "4", "4", "4", 1},
+				{timestamp2, "USD", "2", "2", "2", "2", 1},
+				{timestamp3, "USD", "5", "5", "5", "5", 1},
+			},
+		},
+		{
+			name:   "day",
+			params: model.CandlesParams{Interval: model.CandleIntervalDay},
+			expCandles: []expCandle{
+				{timestamp1, "EUR", "4", "4", "4", "4", 1},
+				{timestamp1, "USD", "1", "3", "1", "2", 3},
+				{time.Date(2000, 1, 3, 0, 0, 0, 0, time.UTC), "USD", "5", "5", "5", "5", 1},
+			},
+		},
+		{
+			name:   "week",
+			params: model.CandlesParams{Interval: model.CandleIntervalWeek},
+			expCandles: []expCandle{
+				{time.Date(1999, 12, 27, 0, 0, 0, 0, time.UTC), "EUR", "4", "4", "4", "4", 1},
+				{time.Date(1999, 12, 27, 0, 0, 0, 0, time.UTC), "USD", "1", "3", "1", "2", 3},
+				{time.Date(2000, 1, 3, 0, 0, 0, 0, time.UTC), "USD", "5", "5", "5", "5", 1},
+			},
+		},
+		{
+			name:   "day: timestamp range",
+			params: model.CandlesParams{Interval: model.CandleIntervalDay, TimestampFrom: timestamp2, TimestampTo: timestamp3},
+			expCandles: []expCandle{
+				{timestamp1, "EUR", "4", "4", "4", "4", 1},
+				{timestamp1, "USD", "2", "2", "2", "2", 1},
+			},
+		},
+	}
+
+	// check GetCandles
+	for _, tc := range testCases {
+		rcvCandles, err := targetSt.GetCandles(ctx, productIDs["P1"], tc.params)
+		require.NoError(t, err, tc.name)
+		checkCandles(tc.name, tc.expCandles, rcvCandles)
+	}
+
+	// check GetCandles: Go fallback
+	{
+		priceImportSt := targetSt.(priceImportStorage)
+		for _, tc := range testCases {
+			pipeline := newProductPricesPipeline(productIDs["P1"], tc.params.TimestampFrom, tc.params.TimestampTo)
+			rcvCandles, err := priceImportSt.getCandlesFallback(ctx, pipeline, tc.params.Interval)
+			require.NoError(t, err, tc.name)
+			checkCandles(tc.name+" (fallback)", tc.expCandles, rcvCandles)
+		}
+	}
+
+	// check GetCandles: invalid input
+	{
+		_, err := targetSt.GetCandles(ctx, primitive.NilObjectID, model.CandlesParams{Interval: model.CandleIntervalDay})
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSt.GetCandles(ctx, productIDs["P1"], model.CandlesParams{})
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSt.GetCandles(ctx, productIDs["P1"], model.CandlesParams{Interval: model.CandleIntervalDay, TimestampFrom: timestamp2, TimestampTo: timestamp1})
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
 }
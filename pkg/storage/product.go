@@ -9,6 +9,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 
+	"github.com/itiky/mdb-tutorial/pkg/common"
 	"github.com/itiky/mdb-tutorial/pkg/model"
 )
 
@@ -17,7 +18,8 @@ var _ ProductStorage = (*productStorage)(nil)
 // productStorage keeps ProductStorage dependencies.
 type productStorage struct {
 	storageCommon
-	mdbCollection *mongo.Collection
+	mdbCollection          *mongo.Collection
+	priceImportsCollection *mongo.Collection
 }
 
 // GetByID implements ProductStorage interface.
@@ -57,7 +59,11 @@ func (s productStorage) UpsertByName(ctx context.Context, product model.Product)
 		"name": product.Name,
 	}}
 
+	// concurrent upserts race for the unique name index: the loser is retried as an update
 	res, err := s.mdbCollection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if isDuplicateKeyError(err) {
+		res, err = s.mdbCollection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	}
 	if err != nil {
 		retErr = err
 		return
@@ -74,6 +80,147 @@ func (s productStorage) UpsertByName(ctx context.Context, product model.Product)
 	return
 }
 
+// BulkUpsertByNames implements ProductStorage interface.
+func (s productStorage) BulkUpsertByNames(ctx context.Context, names []string) (retIDs map[string]primitive.ObjectID, retErr error) {
+	if len(names) == 0 {
+		retErr = fmt.Errorf("%w: names: empty", common.ErrInvalidInput)
+		return
+	}
+
+	// upsert (existing products are not modified)
+	writeModels := make([]mongo.WriteModel, 0, len(names))
+	for i, name := range names {
+		if name == "" {
+			retErr = fmt.Errorf("%w: names[%d]: empty", common.ErrInvalidInput, i)
+			return
+		}
+
+		writeModels = append(writeModels, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"name": name}).
+			SetUpdate(bson.M{"$setOnInsert": bson.M{"name": name}}).
+			SetUpsert(true),
+		)
+	}
+
+	// concurrent upserts race for the unique name index: the bulk is retried once as existing products are skipped
+	_, err := s.mdbCollection.BulkWrite(ctx, writeModels, options.BulkWrite().SetOrdered(false))
+	if isDuplicateKeyError(err) {
+		_, err = s.mdbCollection.BulkWrite(ctx, writeModels, options.BulkWrite().SetOrdered(false))
+	}
+	if err != nil {
+		retErr = fmt.Errorf("bulk write: %w", err)
+		return
+	}
+
+	// fetch IDs (the oldest one is used if duplicates have been created before the unique index migration)
+	filter := bson.M{"name": bson.M{"$in": names}}
+	findOpts := options.Find().
+		SetProjection(bson.M{"_id": 1, "name": 1}).
+		SetSort(bson.M{"_id": 1})
+
+	cursor, err := s.mdbCollection.Find(ctx, filter, findOpts)
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	retIDs = make(map[string]primitive.ObjectID, len(names))
+	err = cursorIterateAndDecode(ctx, cursor, func(curCursor *mongo.Cursor) error {
+		var product model.Product
+		if err := curCursor.Decode(&product); err != nil {
+			return err
+		}
+		if _, found := retIDs[product.Name]; !found {
+			retIDs[product.Name] = product.ID
+		}
+
+		return nil
+	})
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	for _, name := range names {
+		if _, found := retIDs[name]; !found {
+			retErr = fmt.Errorf("product %s: not found after upsert", name)
+			return
+		}
+	}
+
+	return
+}
+
+// DeleteOrphans implements ProductStorage interface.
+func (s productStorage) DeleteOrphans(ctx context.Context, ids []primitive.ObjectID) (retDeleted int64, retErr error) {
+	if len(ids) == 0 {
+		return
+	}
+
+	// products still referenced by price imports
+	referencedIDs, err := s.priceImportsCollection.Distinct(ctx, "product_id", bson.M{"product_id": bson.M{"$in": ids}})
+	if err != nil {
+		retErr = fmt.Errorf("referenced products lookup: %w", err)
+		return
+	}
+
+	referencedSet := make(map[primitive.ObjectID]bool, len(referencedIDs))
+	for _, rawID := range referencedIDs {
+		if id, ok := rawID.(primitive.ObjectID); ok {
+			referencedSet[id] = true
+		}
+	}
+
+	orphanIDs := make([]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		if !referencedSet[id] {
+			orphanIDs = append(orphanIDs, id)
+		}
+	}
+	if len(orphanIDs) == 0 {
+		return
+	}
+
+	res, err := s.mdbCollection.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": orphanIDs}})
+	if err != nil {
+		retErr = err
+		return
+	}
+	retDeleted = res.DeletedCount
+
+	return
+}
+
+// GetByIDs implements ProductStorage interface.
+func (s productStorage) GetByIDs(ctx context.Context, ids []primitive.ObjectID) (retObjs []model.Product, retErr error) {
+	if len(ids) == 0 {
+		return
+	}
+
+	filter := bson.M{"_id": bson.M{"$in": ids}}
+	cursor, err := s.mdbCollection.Find(ctx, filter)
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	err = cursorIterateAndDecode(ctx, cursor, func(curCursor *mongo.Cursor) error {
+		var product model.Product
+		if err := curCursor.Decode(&product); err != nil {
+			return err
+		}
+		retObjs = append(retObjs, product)
+
+		return nil
+	})
+	if err != nil {
+		retErr = err
+		return
+	}
+
+	return
+}
+
 // GetAll implements ProductStorage interface.
 func (s productStorage) GetAll(ctx context.Context) (retObjs []model.Product, retErr error) {
 	filter := bson.D{}
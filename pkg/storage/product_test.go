@@ -107,4 +107,57 @@ func (s *StorageTestSuite) TestStorage_Product() {
 		require.NoError(t, err)
 		require.Len(t, companies, 2)
 	}
+
+	// check BulkUpsertByNames: invalid input
+	{
+		_, err := targetSt.BulkUpsertByNames(ctx, nil)
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+
+		_, err = targetSt.BulkUpsertByNames(ctx, []string{"BulkProduct", ""})
+		require.True(t, errors.Is(err, common.ErrInvalidInput))
+	}
+
+	// check BulkUpsertByNames: existing and new ones
+	{
+		ids, err := targetSt.BulkUpsertByNames(ctx, []string{product.Name, "BulkProduct_1", "BulkProduct_2"})
+		require.NoError(t, err)
+		require.Len(t, ids, 3)
+		require.Equal(t, product.ID, ids[product.Name])
+
+		for _, name := range []string{"BulkProduct_1", "BulkProduct_2"} {
+			rcvProduct, err := targetSt.GetByName(ctx, name)
+			require.NoError(t, err)
+			require.Equal(t, rcvProduct.ID, ids[name])
+		}
+
+		products, err := targetSt.GetAll(ctx)
+		require.NoError(t, err)
+		require.Len(t, products, 4)
+	}
+
+	// check BulkUpsertByNames: repeated (no new objects)
+	{
+		ids, err := targetSt.BulkUpsertByNames(ctx, []string{"BulkProduct_2", "BulkProduct_1"})
+		require.NoError(t, err)
+		require.Len(t, ids, 2)
+
+		products, err := targetSt.GetAll(ctx)
+		require.NoError(t, err)
+		require.Len(t, products, 4)
+	}
+
+	// check GetByIDs
+	{
+		ids, err := targetSt.BulkUpsertByNames(ctx, []string{"BulkProduct_1", "BulkProduct_2"})
+		require.NoError(t, err)
+
+		products, err := targetSt.GetByIDs(ctx, nil)
+		require.NoError(t, err)
+		require.Empty(t, products)
+
+		products, err = targetSt.GetByIDs(ctx, []primitive.ObjectID{ids["BulkProduct_1"], primitive.NewObjectID()})
+		require.NoError(t, err)
+		require.Len(t, products, 1)
+		require.Equal(t, "BulkProduct_1", products[0].Name)
+	}
 }
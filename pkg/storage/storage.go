@@ -1,17 +1,32 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 
 	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readconcern"
+	"go.mongodb.org/mongo-driver/mongo/writeconcern"
+
+	"github.com/itiky/mdb-tutorial/pkg/model"
 )
 
 const (
-	DefaultDB              = "db"
-	ProductsCollection     = "products"
-	PriceImportsCollection = "price_imports"
+	DefaultDB                  = "db"
+	ProductsCollection         = "products"
+	PriceImportsCollection     = "price_imports"
+	ImportJobsCollection       = "import_jobs"
+	ImportLedgerCollection     = "import_ledger"
+	ImportAuditCollection      = "import_audit"
+	ImportTimestampsCollection = "import_timestamps"
+	PriceAlertsCollection      = "price_alerts"
+	MigrationsCollection       = "schema_migrations"
+	//
+	transactionMaxAttempts = 3
 )
 
 var _ Storage = (*storage)(nil)
@@ -25,7 +40,8 @@ type storageCommon struct {
 // storage implements Storage interface.
 type storage struct {
 	storageCommon
-	db string
+	db             string
+	legacyCurrency string
 }
 
 // Product implements Storage interface.
@@ -34,6 +50,7 @@ func (s storage) Product() ProductStorage {
 	return productStorage{
 		s.storageCommon,
 		s.client.Database(s.db).Collection(ProductsCollection),
+		s.client.Database(s.db).Collection(PriceImportsCollection),
 	}
 }
 
@@ -47,6 +64,123 @@ func (s storage) PriceImport() PriceImportStorage {
 	}
 }
 
+// ImportJob implements Storage interface.
+// nolint:gosimple
+func (s storage) ImportJob() ImportJobStorage {
+	return importJobStorage{
+		s.storageCommon,
+		s.client.Database(s.db).Collection(ImportJobsCollection),
+	}
+}
+
+// ImportLedger implements Storage interface.
+// nolint:gosimple
+func (s storage) ImportLedger() ImportLedgerStorage {
+	return importLedgerStorage{
+		s.storageCommon,
+		s.client.Database(s.db).Collection(ImportLedgerCollection),
+	}
+}
+
+// ImportAudit implements Storage interface.
+// nolint:gosimple
+func (s storage) ImportAudit() ImportAuditStorage {
+	return importAuditStorage{
+		s.storageCommon,
+		s.client.Database(s.db).Collection(ImportAuditCollection),
+	}
+}
+
+// ImportTimestamp implements Storage interface.
+// nolint:gosimple
+func (s storage) ImportTimestamp() ImportTimestampStorage {
+	return importTimestampStorage{
+		s.storageCommon,
+		s.client.Database(s.db).Collection(ImportTimestampsCollection),
+	}
+}
+
+// PriceAlert implements Storage interface.
+// nolint:gosimple
+func (s storage) PriceAlert() PriceAlertStorage {
+	return priceAlertStorage{
+		s.storageCommon,
+		s.client.Database(s.db).Collection(PriceAlertsCollection),
+	}
+}
+
+// Migration implements Storage interface.
+// nolint:gosimple
+func (s storage) Migration() MigrationStorage {
+	db := s.client.Database(s.db)
+
+	return migrationStorage{
+		s.storageCommon,
+		db,
+		db.Collection(MigrationsCollection),
+		schemaMigrations,
+		migrationParams{
+			LegacyPriceCurrency: s.legacyCurrency,
+		},
+	}
+}
+
+// StartTransaction implements Storage interface.
+func (s storage) StartTransaction(ctx context.Context) (Transaction, error) {
+	session, err := s.client.StartSession()
+	if err != nil {
+		return nil, fmt.Errorf("starting session: %w", err)
+	}
+
+	txOpts := options.Transaction().
+		SetReadConcern(readconcern.Snapshot()).
+		SetWriteConcern(writeconcern.New(writeconcern.WMajority()))
+	if err := session.StartTransaction(txOpts); err != nil {
+		session.EndSession(ctx)
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+
+	return transaction{session: session}, nil
+}
+
+// WithTransaction implements Storage interface.
+func (s storage) WithTransaction(ctx context.Context, fn func(txCtx context.Context) error) error {
+	for attempt := 1; ; attempt++ {
+		tx, err := s.StartTransaction(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err = fn(tx.Context(ctx)); err == nil {
+			err = tx.Commit(ctx)
+		} else if abortErr := tx.Abort(ctx); abortErr != nil {
+			s.logger.Warnf("Transaction: %v", abortErr)
+		}
+
+		if err == nil || attempt >= transactionMaxAttempts || !isTransientTransactionError(err) {
+			return err
+		}
+		s.logger.Warnf("Transaction: attempt %d: transient error, retrying: %v", attempt, err)
+	}
+}
+
+// SupportsTransactions implements Storage interface.
+func (s storage) SupportsTransactions(ctx context.Context) (bool, error) {
+	var isMaster struct {
+		SetName                      string `bson:"setName"`
+		Msg                          string `bson:"msg"`
+		LogicalSessionTimeoutMinutes *int64 `bson:"logicalSessionTimeoutMinutes"`
+	}
+	if err := s.client.Database("admin").RunCommand(ctx, bson.D{{"isMaster", 1}}).Decode(&isMaster); err != nil { // nolint:govet
+		return false, fmt.Errorf("isMaster: %w", err)
+	}
+
+	// standalone servers report neither a replica set name nor the mongos message
+	isCluster := isMaster.SetName != "" || isMaster.Msg == "isdbgrid"
+
+	return isCluster && isMaster.LogicalSessionTimeoutMinutes != nil, nil
+}
+
 // Option specifies functional argument used by NewStorage function.
 type Option func(storage *storage) error
 
@@ -86,10 +220,23 @@ func WithDatabase(db string) Option {
 	}
 }
 
+// WithLegacyPriceCurrency sets ISO-4217 currency code the schema migration sets for legacy prices stored without one.
+func WithLegacyPriceCurrency(currency string) Option {
+	return func(storage *storage) error {
+		if !model.IsValidCurrency(currency) {
+			return fmt.Errorf("legacyPriceCurrency option: invalid ISO-4217 code (%s)", currency)
+		}
+		storage.legacyCurrency = currency
+
+		return nil
+	}
+}
+
 // NewStorage creates a new configured Storage object.
 func NewStorage(options ...Option) (Storage, error) {
 	s := &storage{
-		db: DefaultDB,
+		db:             DefaultDB,
+		legacyCurrency: model.NewDefaultCSVDialect().Currency,
 	}
 	for _, option := range options {
 		if err := option(s); err != nil {
@@ -0,0 +1,42 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+var _ Transaction = (*transaction)(nil)
+
+// transaction implements Transaction interface using a MongoDB session.
+type transaction struct {
+	session mongo.Session
+}
+
+// Context implements Transaction interface.
+func (t transaction) Context(ctx context.Context) context.Context {
+	return mongo.NewSessionContext(ctx, t.session)
+}
+
+// Commit implements Transaction interface.
+func (t transaction) Commit(ctx context.Context) error {
+	defer t.session.EndSession(ctx)
+
+	if err := t.session.CommitTransaction(ctx); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Abort implements Transaction interface.
+func (t transaction) Abort(ctx context.Context) error {
+	defer t.session.EndSession(ctx)
+
+	if err := t.session.AbortTransaction(ctx); err != nil {
+		return fmt.Errorf("aborting transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiky/mdb-tutorial/pkg/model"
+	"github.com/itiky/mdb-tutorial/pkg/testutils"
+	"github.com/itiky/mdb-tutorial/pkg/testutils/fixtures"
+)
+
+func (s *StorageTestSuite) TestStorage_Transaction() {
+	t := s.T()
+	ctx := context.Background()
+
+	client := testutils.PrepareMongoDBFixtures(ctx, s.r, fixtures.NewEmptyMongoDBFixtures())
+	storage, err := NewStorage(
+		WithDatabase(testutils.TestMongoDBDatabase),
+		WithMongoDBClient(client),
+	)
+	require.NoError(t, err)
+
+	timestamp := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	importPrices := func(txCtx context.Context, productName string) error {
+		ids, err := storage.Product().BulkUpsertByNames(txCtx, []string{productName})
+		if err != nil {
+			return err
+		}
+
+		_, err = storage.PriceImport().BulkUpsertByProductIDAndTimestamp(txCtx, []model.PricesImport{
+			{ProductID: ids[productName], Timestamp: timestamp, Prices: []model.Price{model.NewPrice(model.MustParseMoney("1", "USD"))}},
+		})
+
+		return err
+	}
+
+	// check SupportsTransactions: single node replica set
+	{
+		supported, err := storage.SupportsTransactions(ctx)
+		require.NoError(t, err)
+		require.True(t, supported)
+	}
+
+	// check WithTransaction: commit
+	{
+		err := storage.WithTransaction(ctx, func(txCtx context.Context) error {
+			return importPrices(txCtx, "P1")
+		})
+		require.NoError(t, err)
+
+		product, err := storage.Product().GetByName(ctx, "P1")
+		require.NoError(t, err)
+
+		imports, err := storage.PriceImport().GetAll(ctx, timestamp, product.ID.Hex())
+		require.NoError(t, err)
+		require.Len(t, imports, 1)
+	}
+
+	// check WithTransaction: abort on fn error
+	{
+		err := storage.WithTransaction(ctx, func(txCtx context.Context) error {
+			if err := importPrices(txCtx, "P2"); err != nil {
+				return err
+			}
+			return fmt.Errorf("fn failed")
+		})
+		require.Error(t, err)
+
+		_, err = storage.Product().GetByName(ctx, "P2")
+		require.Error(t, err)
+	}
+
+	// check StartTransaction: changes are not visible outside until commit
+	{
+		tx, err := storage.StartTransaction(ctx)
+		require.NoError(t, err)
+		require.NoError(t, importPrices(tx.Context(ctx), "P3"))
+
+		_, err = storage.Product().GetByName(ctx, "P3")
+		require.Error(t, err)
+		_, err = storage.Product().GetByName(tx.Context(ctx), "P3")
+		require.NoError(t, err)
+
+		require.NoError(t, tx.Commit(ctx))
+		_, err = storage.Product().GetByName(ctx, "P3")
+		require.NoError(t, err)
+	}
+
+	// check StartTransaction: abort
+	{
+		tx, err := storage.StartTransaction(ctx)
+		require.NoError(t, err)
+		require.NoError(t, importPrices(tx.Context(ctx), "P4"))
+		require.NoError(t, tx.Abort(ctx))
+
+		_, err = storage.Product().GetByName(ctx, "P4")
+		require.Error(t, err)
+
+		products, err := storage.Product().GetAll(ctx)
+		require.NoError(t, err)
+		require.Len(t, products, 2)
+	}
+}
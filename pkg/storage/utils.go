@@ -9,6 +9,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 
 	"github.com/itiky/mdb-tutorial/pkg/common"
+	"github.com/itiky/mdb-tutorial/pkg/model"
 )
 
 // singleResultDecode checks for mongo.SingleResult errors and decodes a result object.
@@ -45,6 +46,18 @@ func cursorIterateAndDecode(ctx context.Context, cursor *mongo.Cursor, handler f
 	return nil
 }
 
+// addMatchAggregationStage adds match stage to the aggregation pipeline if needed.
+// nolint:govet
+func addMatchAggregationStage(pipeline mongo.Pipeline, match bson.M) mongo.Pipeline {
+	if len(match) == 0 {
+		return pipeline
+	}
+
+	return append(pipeline, bson.D{
+		{"$match", match},
+	})
+}
+
 // addSortAggregationStage adds sort stage to the aggregation pipeline if needed.
 // nolint:govet
 func addSortAggregationStage(pipeline mongo.Pipeline, opt common.SortOptions) mongo.Pipeline {
@@ -68,3 +81,70 @@ func addPaginationAggregationStage(pipeline mongo.Pipeline, opt common.Paginatio
 
 	return append(pipeline, pageStages...)
 }
+
+// duplicateKeyErrorCodes are MongoDB unique index violation error codes.
+var duplicateKeyErrorCodes = map[int]bool{11000: true, 11001: true, 12582: true}
+
+// isDuplicateKeyError checks if a write operation failed with a unique index violation.
+func isDuplicateKeyError(err error) bool {
+	var writeErr mongo.WriteException
+	if errors.As(err, &writeErr) {
+		for _, e := range writeErr.WriteErrors {
+			if duplicateKeyErrorCodes[e.Code] {
+				return true
+			}
+		}
+		if writeErr.WriteConcernError != nil && duplicateKeyErrorCodes[writeErr.WriteConcernError.Code] {
+			return true
+		}
+	}
+
+	var bulkErr mongo.BulkWriteException
+	if errors.As(err, &bulkErr) {
+		for _, e := range bulkErr.WriteErrors {
+			if duplicateKeyErrorCodes[e.Code] {
+				return true
+			}
+		}
+	}
+
+	var cmdErr mongo.CommandError
+	if errors.As(err, &cmdErr) {
+		return duplicateKeyErrorCodes[int(cmdErr.Code)]
+	}
+
+	return false
+}
+
+// isTransientTransactionError checks if a transaction failed with an error that could be fixed by the transaction retry.
+func isTransientTransactionError(err error) bool {
+	const label = "TransientTransactionError"
+
+	var cmdErr mongo.CommandError
+	if errors.As(err, &cmdErr) && cmdErr.HasErrorLabel(label) {
+		return true
+	}
+
+	var writeErr mongo.WriteException
+	if errors.As(err, &writeErr) && writeErr.HasErrorLabel(label) {
+		return true
+	}
+
+	var bulkErr mongo.BulkWriteException
+	if errors.As(err, &bulkErr) && bulkErr.HasErrorLabel(label) {
+		return true
+	}
+
+	return false
+}
+
+// hasPriceRows checks if all the prices have source CSV-file rows set.
+func hasPriceRows(prices []model.Price) bool {
+	for _, price := range prices {
+		if price.Row <= 0 {
+			return false
+		}
+	}
+
+	return true
+}
@@ -11,6 +11,8 @@ import (
 
 const (
 	ContainerPortMongoDB = "27017/tcp"
+	// MongoDB is started as a single node replica set as transactions are not supported by standalone servers
+	ContainerMongoDBReplicaSet = "rs0"
 )
 
 type MongoDBContainer struct {
@@ -26,6 +28,7 @@ type Container struct{}
 func (c Container) MongoDBRequest(dbName string) tc.ContainerRequest {
 	return tc.ContainerRequest{
 		Image:        "mongo:4.4",
+		Cmd:          []string{"--replSet", ContainerMongoDBReplicaSet, "--bind_ip_all"},
 		ExposedPorts: []string{ContainerPortMongoDB},
 		Env: map[string]string{
 			"MONGO_INITDB_DATABASE": dbName,
@@ -0,0 +1,38 @@
+package fixtures
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/itiky/mdb-tutorial/pkg/model"
+)
+
+// MongoDBImportAudit keeps "import_audit" collection fixtures.
+type MongoDBImportAudit struct {
+	Entries []model.ImportAuditEntry
+}
+
+// GetCollection implements MongoDBCollection interface.
+func (f MongoDBImportAudit) GetCollection() string {
+	return "import_audit"
+}
+
+// GetBSONObjects implements MongoDBCollection interface.
+func (f MongoDBImportAudit) GetBSONObjects() []interface{} {
+	output := make([]interface{}, 0, len(f.Entries))
+	for _, entry := range f.Entries {
+		output = append(output, bson.M{
+			"_id":                   entry.ID,
+			"action":                entry.Action,
+			"actor":                 entry.Actor,
+			"client_addr":           entry.ClientAddr,
+			"reason":                entry.Reason,
+			"job_id":                entry.JobID,
+			"timestamps":            entry.Timestamps,
+			"deleted_price_imports": entry.DeletedPriceImports,
+			"deleted_products":      entry.DeletedProducts,
+			"created_at":            entry.CreatedAt,
+		})
+	}
+
+	return output
+}
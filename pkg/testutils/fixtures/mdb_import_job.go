@@ -0,0 +1,37 @@
+package fixtures
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/itiky/mdb-tutorial/pkg/model"
+)
+
+type MongoDBImportJob struct {
+	Jobs []model.ImportJob
+}
+
+// GetCollection implements MongoDBCollection interface.
+func (f MongoDBImportJob) GetCollection() string {
+	return "import_jobs"
+}
+
+// GetBSONObjects implements MongoDBCollection interface.
+func (f MongoDBImportJob) GetBSONObjects() []interface{} {
+	output := make([]interface{}, 0, len(f.Jobs))
+	for _, job := range f.Jobs {
+		output = append(output, bson.M{
+			"_id":              job.ID,
+			"url":              job.URL,
+			"state":            job.State,
+			"owner":            job.Owner,
+			"import_timestamp": job.ImportTimestamp,
+			"progress":         job.Progress,
+			"chunk_errors":     job.ChunkErrors,
+			"error":            job.Error,
+			"created_at":       job.CreatedAt,
+			"updated_at":       job.UpdatedAt,
+		})
+	}
+
+	return output
+}
@@ -0,0 +1,34 @@
+package fixtures
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/itiky/mdb-tutorial/pkg/model"
+)
+
+type MongoDBImportLedger struct {
+	Entries []model.ImportLedgerEntry
+}
+
+// GetCollection implements MongoDBCollection interface.
+func (f MongoDBImportLedger) GetCollection() string {
+	return "import_ledger"
+}
+
+// GetBSONObjects implements MongoDBCollection interface.
+func (f MongoDBImportLedger) GetBSONObjects() []interface{} {
+	output := make([]interface{}, 0, len(f.Entries))
+	for _, entry := range f.Entries {
+		output = append(output, bson.M{
+			"_id":        entry.ID,
+			"source":     entry.Source,
+			"timestamp":  entry.Timestamp,
+			"job_id":     entry.JobID,
+			"forced":     entry.Forced,
+			"pending":    entry.Pending,
+			"created_at": entry.CreatedAt,
+		})
+	}
+
+	return output
+}
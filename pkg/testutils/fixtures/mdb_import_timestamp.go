@@ -0,0 +1,31 @@
+package fixtures
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/itiky/mdb-tutorial/pkg/model"
+)
+
+// MongoDBImportTimestamp keeps "import_timestamps" collection fixtures.
+type MongoDBImportTimestamp struct {
+	Reservations []model.ImportTimestamp
+}
+
+// GetCollection implements MongoDBCollection interface.
+func (f MongoDBImportTimestamp) GetCollection() string {
+	return "import_timestamps"
+}
+
+// GetBSONObjects implements MongoDBCollection interface.
+func (f MongoDBImportTimestamp) GetBSONObjects() []interface{} {
+	output := make([]interface{}, 0, len(f.Reservations))
+	for _, reservation := range f.Reservations {
+		output = append(output, bson.M{
+			"_id":        reservation.Timestamp,
+			"job_id":     reservation.JobID,
+			"created_at": reservation.CreatedAt,
+		})
+	}
+
+	return output
+}
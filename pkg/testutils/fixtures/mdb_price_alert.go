@@ -0,0 +1,39 @@
+package fixtures
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/itiky/mdb-tutorial/pkg/model"
+)
+
+// MongoDBPriceAlert keeps "price_alerts" collection fixtures.
+type MongoDBPriceAlert struct {
+	Rules []model.PriceAlertRule
+}
+
+// GetCollection implements MongoDBCollection interface.
+func (f MongoDBPriceAlert) GetCollection() string {
+	return "price_alerts"
+}
+
+// GetBSONObjects implements MongoDBCollection interface.
+func (f MongoDBPriceAlert) GetBSONObjects() []interface{} {
+	output := make([]interface{}, 0, len(f.Rules))
+	for _, rule := range f.Rules {
+		output = append(output, bson.M{
+			"_id":               rule.ID,
+			"name":              rule.Name,
+			"product_name":      rule.ProductName,
+			"currency":          rule.Currency,
+			"condition":         rule.Condition,
+			"threshold":         rule.Threshold,
+			"change_percent":    rule.ChangePercent,
+			"enabled":           rule.Enabled,
+			"last_triggered_at": rule.LastTriggeredAt,
+			"created_at":        rule.CreatedAt,
+			"updated_at":        rule.UpdatedAt,
+		})
+	}
+
+	return output
+}
@@ -15,8 +15,8 @@ var PriceImports = []model.PricesImport{
 		ProductID: Products[0].ID,
 		Timestamp: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
 		Prices: []model.Price{
-			{Value: 50},
-			{Value: 100},
+			model.NewPrice(model.MustParseMoney("50", "USD")),
+			model.NewPrice(model.MustParseMoney("100", "USD")),
 		},
 	},
 	{
@@ -24,9 +24,9 @@ var PriceImports = []model.PricesImport{
 		ProductID: Products[1].ID,
 		Timestamp: time.Date(2000, 1, 31, 0, 0, 0, 0, time.UTC),
 		Prices: []model.Price{
-			{Value: 75},
-			{Value: 100},
-			{Value: 150},
+			model.NewPrice(model.MustParseMoney("75.50", "USD")),
+			model.NewPrice(model.MustParseMoney("100", "USD")),
+			model.NewPrice(model.MustParseMoney("150", "USD")),
 		},
 	},
 }
@@ -47,7 +47,8 @@ func (f MongoDBPriceImport) GetBSONObjects() []interface{} {
 		mPrices := make([]bson.M, 0, len(priceImport.Prices))
 		for _, price := range priceImport.Prices {
 			mPrices = append(mPrices, bson.M{
-				"value": price.Value,
+				"value":    price.Value,
+				"currency": price.Currency,
 			})
 		}
 
@@ -25,6 +25,21 @@ func NewDefaultMongoDBFixtures() MongoDB {
 			MongoDBPriceImport{
 				Imports: PriceImports,
 			},
+			MongoDBImportJob{
+				Jobs: []model.ImportJob{},
+			},
+			MongoDBImportLedger{
+				Entries: []model.ImportLedgerEntry{},
+			},
+			MongoDBImportAudit{
+				Entries: []model.ImportAuditEntry{},
+			},
+			MongoDBImportTimestamp{
+				Reservations: []model.ImportTimestamp{},
+			},
+			MongoDBPriceAlert{
+				Rules: []model.PriceAlertRule{},
+			},
 		},
 	}
 }
@@ -39,6 +54,21 @@ func NewEmptyMongoDBFixtures() MongoDB {
 			MongoDBPriceImport{
 				Imports: []model.PricesImport{},
 			},
+			MongoDBImportJob{
+				Jobs: []model.ImportJob{},
+			},
+			MongoDBImportLedger{
+				Entries: []model.ImportLedgerEntry{},
+			},
+			MongoDBImportAudit{
+				Entries: []model.ImportAuditEntry{},
+			},
+			MongoDBImportTimestamp{
+				Reservations: []model.ImportTimestamp{},
+			},
+			MongoDBPriceAlert{
+				Rules: []model.PriceAlertRule{},
+			},
 		},
 	}
 }
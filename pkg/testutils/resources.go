@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 
 	"github.com/itiky/mdb-tutorial/pkg/mongodb"
@@ -13,7 +15,8 @@ import (
 )
 
 const (
-	TestMongoDBDatabase = "db"
+	TestMongoDBDatabase   = "db"
+	mongoDBPrimaryTimeout = 30 * time.Second
 )
 
 // Resources keeps test environment data.
@@ -42,18 +45,51 @@ func (r *Resources) MongoDB(ctx context.Context) (*MongoDBContainer, *mongo.Clie
 		r.mdbCont = cont
 
 		client, err := mongodb.Connect(mongodb.Configuration{
-			Url:  "localhost",
-			Port: r.mdbCont.Port.Port(),
+			Url:    "localhost",
+			Port:   r.mdbCont.Port.Port(),
+			Direct: true,
 		})
 		if err != nil {
 			r.crash(ctx, fmt.Errorf("connecting to MongoDB container: %w", err))
 		}
 		r.mdbClient = client
+
+		if err := initMongoDBReplicaSet(ctx, client); err != nil {
+			r.crash(ctx, fmt.Errorf("MongoDB container: %w", err))
+		}
 	})
 
 	return r.mdbCont, r.mdbClient
 }
 
+// initMongoDBReplicaSet initiates the single node replica set and waits for the node to become the primary.
+// nolint:govet
+func initMongoDBReplicaSet(ctx context.Context, client *mongo.Client) error {
+	adminDB := client.Database("admin")
+
+	initCmd := bson.D{{"replSetInitiate", bson.D{
+		{"_id", ContainerMongoDBReplicaSet},
+		{"members", bson.A{bson.D{{"_id", 0}, {"host", "localhost:27017"}}}},
+	}}}
+	if err := adminDB.RunCommand(ctx, initCmd).Err(); err != nil {
+		return fmt.Errorf("replica set init: %w", err)
+	}
+
+	for deadline := time.Now().Add(mongoDBPrimaryTimeout); time.Now().Before(deadline); time.Sleep(100 * time.Millisecond) {
+		var isMaster struct {
+			IsMaster bool `bson:"ismaster"`
+		}
+		if err := adminDB.RunCommand(ctx, bson.D{{"isMaster", 1}}).Decode(&isMaster); err != nil {
+			return fmt.Errorf("replica set state: %w", err)
+		}
+		if isMaster.IsMaster {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("replica set: primary is not elected within %v", mongoDBPrimaryTimeout)
+}
+
 // Shutdown shutdowns all test container.
 func (r *Resources) Shutdown(ctx context.Context) {
 	r.Lock()